@@ -0,0 +1,93 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// actionTimeout bounds how long a shell command or webhook may take.
+const actionTimeout = 30 * time.Second
+
+// Dispatch runs the actions configured on the event's rule: ringing the terminal bell on out,
+// running the shell command, and posting the event as JSON to the webhook. Every action is
+// attempted; failures are joined into the returned error.
+func Dispatch(ev Event, out io.Writer) error {
+	var errs []error
+	if ev.Rule.Bell {
+		fmt.Fprint(out, "\a")
+	}
+	if ev.Rule.Command != "" {
+		if err := runCommand(ev); err != nil {
+			errs = append(errs, fmt.Errorf("alert %d command: %w", ev.Rule.ID, err))
+		}
+	}
+	if ev.Rule.Webhook != "" {
+		if err := postWebhook(ev); err != nil {
+			errs = append(errs, fmt.Errorf("alert %d webhook: %w", ev.Rule.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runCommand runs the rule's command through the platform shell with the event details in
+// TRADIER_ALERT_* environment variables, so any notifier (notify-send, osascript, ...) can be used.
+func runCommand(ev Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	cmd := exec.CommandContext(ctx, shell, flag, ev.Rule.Command)
+	cmd.Env = append(os.Environ(),
+		"TRADIER_ALERT_ID="+strconv.Itoa(ev.Rule.ID),
+		"TRADIER_ALERT_SYMBOL="+ev.Rule.Symbol,
+		"TRADIER_ALERT_TYPE="+string(ev.Rule.Kind),
+		"TRADIER_ALERT_LEVEL="+strconv.FormatFloat(ev.Rule.Level, 'f', -1, 64),
+		"TRADIER_ALERT_VALUE="+strconv.FormatFloat(ev.Value, 'f', -1, 64),
+		"TRADIER_ALERT_MESSAGE="+ev.Message,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// postWebhook sends the event as a JSON POST body to the rule's webhook URL.
+func postWebhook(ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ev.Rule.Webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
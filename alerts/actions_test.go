@@ -0,0 +1,78 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package alerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestDispatchWebhook verifies the event is posted as JSON and the bell is rung.
+func TestDispatchWebhook(t *testing.T) {
+	var got Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request = %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	ev := Event{Rule: Rule{ID: 7, Symbol: "AAPL", Kind: KindPrice, Bell: true, Webhook: server.URL}, Value: 251, Message: "hit"}
+	if err := Dispatch(ev, &out); err != nil {
+		t.Fatalf("Dispatch() error: %v", err)
+	}
+	if out.String() != "\a" {
+		t.Errorf("bell output = %q, want %q", out.String(), "\a")
+	}
+	if got.Rule.ID != 7 || got.Value != 251 || got.Message != "hit" {
+		t.Errorf("webhook body = %+v", got)
+	}
+}
+
+// TestDispatchWebhookError verifies a non-2xx webhook response is reported.
+func TestDispatchWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := Dispatch(Event{Rule: Rule{ID: 2, Webhook: server.URL}}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "alert 2 webhook: HTTP 500") {
+		t.Errorf("Dispatch() error = %v, want HTTP 500", err)
+	}
+}
+
+// TestDispatchCommand verifies the command runs with the event in its environment.
+func TestDispatchCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	path := filepath.Join(t.TempDir(), "out")
+	ev := Event{
+		Rule:    Rule{ID: 3, Symbol: "SPY", Kind: KindChange, Command: `echo "$TRADIER_ALERT_SYMBOL $TRADIER_ALERT_VALUE $TRADIER_ALERT_MESSAGE" > ` + path},
+		Value:   -3.5,
+		Message: "SPY change below -3%",
+	}
+	if err := Dispatch(ev, &bytes.Buffer{}); err != nil {
+		t.Fatalf("Dispatch() error: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if got := strings.TrimSpace(string(data)); got != "SPY -3.5 SPY change below -3%" {
+		t.Errorf("command output = %q", got)
+	}
+
+	ev.Rule.Command = "echo boom >&2; exit 3"
+	if err := Dispatch(ev, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Dispatch(failing command) error = %v, want output included", err)
+	}
+}
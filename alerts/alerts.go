@@ -0,0 +1,202 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package alerts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kind is the quantity an alert watches.
+type Kind string
+
+const (
+	// KindPrice fires when the last trade price crosses a level.
+	KindPrice Kind = "price"
+
+	// KindChange fires when the percent change from the previous close passes a threshold.
+	KindChange Kind = "change"
+
+	// KindIV fires when an option's mid implied volatility, in percent, passes a threshold.
+	KindIV Kind = "iv"
+
+	// KindSpread fires when the bid/ask spread, as a percent of the midpoint, passes a threshold.
+	KindSpread Kind = "spread"
+
+	// KindPnL fires when a position's unrealized profit or loss, in dollars, passes a threshold.
+	KindPnL Kind = "pnl"
+)
+
+// Kinds lists every supported alert kind.
+var Kinds = []Kind{KindPrice, KindChange, KindIV, KindSpread, KindPnL}
+
+// Rule is a stored alert: a condition on one symbol and the actions to run when it fires.
+type Rule struct {
+	ID      int       `json:"id"`
+	Symbol  string    `json:"symbol"`
+	Kind    Kind      `json:"kind"`
+	Above   bool      `json:"above"`
+	Level   float64   `json:"level"`
+	Bell    bool      `json:"bell,omitempty"`
+	Command string    `json:"command,omitempty"`
+	Webhook string    `json:"webhook,omitempty"`
+	Once    bool      `json:"once,omitempty"`
+	Note    string    `json:"note,omitempty"`
+	Created time.Time `json:"created"`
+	FiredAt time.Time `json:"fired_at,omitzero"`
+}
+
+// Snapshot is the latest market state for one symbol. Zero fields are treated as unknown.
+type Snapshot struct {
+	Last      float64
+	PrevClose float64
+	Bid       float64
+	Ask       float64
+	IV        float64
+	PnL       float64
+	HasPnL    bool
+}
+
+// Event is a rule firing, as printed and sent to webhooks.
+type Event struct {
+	Rule    Rule      `json:"rule"`
+	Value   float64   `json:"value"`
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+// Validate checks that the rule names a symbol, a known kind, and at least one action.
+func (r Rule) Validate() error {
+	if r.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	known := false
+	for _, k := range Kinds {
+		known = known || r.Kind == k
+	}
+	if !known {
+		return fmt.Errorf("unknown alert type %q (supported: price, change, iv, spread, pnl)", r.Kind)
+	}
+	if !r.Bell && r.Command == "" && r.Webhook == "" {
+		return fmt.Errorf("alert %s has no actions", r.Symbol)
+	}
+	if r.Webhook != "" && !strings.HasPrefix(r.Webhook, "http://") && !strings.HasPrefix(r.Webhook, "https://") {
+		return fmt.Errorf("webhook must be an http or https URL")
+	}
+	return nil
+}
+
+// Done reports whether the rule fired once already and should not be evaluated again.
+func (r Rule) Done() bool {
+	return r.Once && !r.FiredAt.IsZero()
+}
+
+// Condition describes the rule's trigger, e.g. "crosses above 250.00" or "change below -3%".
+func (r Rule) Condition() string {
+	dir := "below"
+	if r.Above {
+		dir = "above"
+	}
+	switch r.Kind {
+	case KindPrice:
+		return fmt.Sprintf("crosses %s %.2f", dir, r.Level)
+	case KindPnL:
+		return fmt.Sprintf("P&L %s %s", dir, dollars(r.Level))
+	}
+	return fmt.Sprintf("%s %s %g%%", r.Kind, dir, r.Level)
+}
+
+// Value returns the quantity the rule's kind watches from a snapshot, or false when the
+// snapshot lacks the fields needed to compute it.
+func Value(kind Kind, s Snapshot) (float64, bool) {
+	switch kind {
+	case KindPrice:
+		return s.Last, s.Last > 0
+	case KindChange:
+		if s.Last <= 0 || s.PrevClose <= 0 {
+			return 0, false
+		}
+		return (s.Last - s.PrevClose) / s.PrevClose * 100, true
+	case KindIV:
+		return s.IV * 100, s.IV > 0
+	case KindSpread:
+		if s.Bid <= 0 || s.Ask <= 0 {
+			return 0, false
+		}
+		return (s.Ask - s.Bid) / ((s.Ask + s.Bid) / 2) * 100, true
+	case KindPnL:
+		return s.PnL, s.HasPnL
+	}
+	return 0, false
+}
+
+// Engine evaluates rules against successive snapshots. Alerts are edge triggered: a rule
+// fires when its condition turns true and re-arms only after the condition turns false again,
+// so a price sitting above a level does not fire on every tick.
+type Engine struct {
+	state map[int]bool
+}
+
+// NewEngine returns an engine with no observations.
+func NewEngine() *Engine {
+	return &Engine{state: map[int]bool{}}
+}
+
+// Check evaluates every active rule against the snapshot for its symbol and returns the rules
+// that fired. Price rules need a previous observation to detect a cross, so the first snapshot
+// only records which side of the level the price is on; threshold kinds fire immediately.
+func (e *Engine) Check(rules []Rule, snaps map[string]Snapshot, now time.Time) []Event {
+	var events []Event
+	for _, r := range rules {
+		if r.Done() {
+			continue
+		}
+		s, ok := snaps[r.Symbol]
+		if !ok {
+			continue
+		}
+		v, ok := Value(r.Kind, s)
+		if !ok {
+			continue
+		}
+
+		met := v < r.Level
+		if r.Above {
+			met = v >= r.Level
+		}
+		prev, seen := e.state[r.ID]
+		e.state[r.ID] = met
+		if !met || prev || (!seen && r.Kind == KindPrice) {
+			continue
+		}
+		events = append(events, Event{Rule: r, Value: v, Time: now, Message: message(r, v)})
+	}
+	return events
+}
+
+// message formats a human-readable description of a rule firing at value v.
+func message(r Rule, v float64) string {
+	var msg string
+	switch r.Kind {
+	case KindPrice:
+		msg = fmt.Sprintf("%s %s (last %.2f)", r.Symbol, r.Condition(), v)
+	case KindPnL:
+		msg = fmt.Sprintf("%s %s (now %s)", r.Symbol, r.Condition(), dollars(v))
+	default:
+		msg = fmt.Sprintf("%s %s (now %.2f%%)", r.Symbol, r.Condition(), v)
+	}
+	if r.Note != "" {
+		msg += ": " + r.Note
+	}
+	return msg
+}
+
+// dollars formats an amount with the sign ahead of the dollar sign, e.g. -$500.00.
+func dollars(v float64) string {
+	if v < 0 {
+		return fmt.Sprintf("-$%.2f", -v)
+	}
+	return fmt.Sprintf("$%.2f", v)
+}
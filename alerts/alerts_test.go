@@ -0,0 +1,150 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package alerts
+
+import (
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// at returns a fixed time offset by the given number of minutes.
+func at(minute int) time.Time {
+	return time.Date(2026, 10, 16, 10, minute, 0, 0, time.UTC)
+}
+
+// TestValue verifies each kind's derived quantity and the unknown cases.
+func TestValue(t *testing.T) {
+	s := Snapshot{Last: 105, PrevClose: 100, Bid: 9.9, Ask: 10.1, IV: 0.42, PnL: -250, HasPnL: true}
+	tests := map[Kind]float64{KindPrice: 105, KindChange: 5, KindIV: 42, KindSpread: 2, KindPnL: -250}
+	for kind, want := range tests {
+		if got, ok := Value(kind, s); !ok || math.Abs(got-want) > 1e-9 {
+			t.Errorf("Value(%s) = %v, %v, want %v", kind, got, ok, want)
+		}
+	}
+	for _, kind := range Kinds {
+		if _, ok := Value(kind, Snapshot{}); ok {
+			t.Errorf("Value(%s, empty) ok = true, want false", kind)
+		}
+	}
+}
+
+// TestEngineCrossing verifies price alerts need a cross and re-arm after falling back.
+func TestEngineCrossing(t *testing.T) {
+	rules := []Rule{{ID: 1, Symbol: "AAPL", Kind: KindPrice, Above: true, Level: 250}}
+	e := NewEngine()
+	for i, tc := range []struct {
+		last  float64
+		fired bool
+	}{
+		{255, false}, // already above on the first look: no cross
+		{248, false},
+		{251, true},
+		{252, false}, // still above: no repeat
+		{249, false},
+		{250, true},
+	} {
+		events := e.Check(rules, map[string]Snapshot{"AAPL": {Last: tc.last}}, at(i))
+		if (len(events) == 1) != tc.fired {
+			t.Errorf("Check(last %v) fired = %v, want %v", tc.last, len(events) == 1, tc.fired)
+		}
+	}
+}
+
+// TestEngineThreshold verifies threshold kinds fire on the first matching snapshot.
+func TestEngineThreshold(t *testing.T) {
+	rules := []Rule{
+		{ID: 1, Symbol: "AAPL", Kind: KindChange, Above: false, Level: -3},
+		{ID: 2, Symbol: "AAPL", Kind: KindSpread, Above: true, Level: 1, Note: "thin book"},
+		{ID: 3, Symbol: "MSFT", Kind: KindPnL, Above: true, Level: 0},
+	}
+	snaps := map[string]Snapshot{"AAPL": {Last: 96, PrevClose: 100, Bid: 95, Ask: 97}}
+	events := NewEngine().Check(rules, snaps, at(0))
+	if len(events) != 2 {
+		t.Fatalf("Check() fired %d rules, want 2", len(events))
+	}
+	if events[0].Message != "AAPL change below -3% (now -4.00%)" {
+		t.Errorf("Message = %q", events[0].Message)
+	}
+	if !strings.HasSuffix(events[1].Message, ": thin book") || events[1].Time != at(0) {
+		t.Errorf("Event = %+v", events[1])
+	}
+}
+
+// TestEngineOnce verifies one-shot rules that already fired are skipped.
+func TestEngineOnce(t *testing.T) {
+	rules := []Rule{{ID: 4, Symbol: "SPY", Kind: KindChange, Above: true, Level: 1, Once: true}}
+	snaps := map[string]Snapshot{"SPY": {Last: 102, PrevClose: 100}}
+	events := NewEngine().Check(rules, snaps, at(0))
+	rules = MarkFired(rules, events)
+	if !rules[0].Done() {
+		t.Fatalf("Done() = false after firing")
+	}
+	if events := NewEngine().Check(rules, snaps, at(1)); len(events) != 0 {
+		t.Errorf("Check(done rule) fired %d, want 0", len(events))
+	}
+}
+
+// TestValidate verifies required fields and action checks.
+func TestValidate(t *testing.T) {
+	ok := Rule{Symbol: "AAPL", Kind: KindPrice, Bell: true}
+	if err := ok.Validate(); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+	bad := []Rule{
+		{Kind: KindPrice, Bell: true},
+		{Symbol: "AAPL", Kind: "volume", Bell: true},
+		{Symbol: "AAPL", Kind: KindIV},
+		{Symbol: "AAPL", Kind: KindIV, Webhook: "ftp://example.com"},
+	}
+	for _, r := range bad {
+		if err := r.Validate(); err == nil {
+			t.Errorf("Validate(%+v) error = nil, want error", r)
+		}
+	}
+}
+
+// TestStore verifies rules round-trip through the file and IDs keep increasing.
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.json")
+	rules, err := Load(path)
+	if err != nil || len(rules) != 0 {
+		t.Fatalf("Load(missing) = %v, %v, want empty", rules, err)
+	}
+
+	rules, first := Add(rules, Rule{Symbol: "AAPL", Kind: KindPrice, Level: 250, Bell: true})
+	rules, second := Add(rules, Rule{ID: 99, Symbol: "SPY", Kind: KindChange, Level: 2, Bell: true})
+	rules, _ = Remove(rules, first.ID)
+	rules, third := Add(rules, Rule{Symbol: "QQQ", Kind: KindIV, Level: 30, Bell: true})
+	if first.ID != 1 || second.ID != 2 || third.ID != 3 {
+		t.Errorf("IDs = %d, %d, %d, want 1, 2, 3", first.ID, second.ID, third.ID)
+	}
+	if _, found := Remove(rules, 42); found {
+		t.Errorf("Remove(42) found = true, want false")
+	}
+
+	if err := Save(path, rules); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil || len(loaded) != 2 || loaded[0].Symbol != "SPY" || loaded[1].Kind != KindIV {
+		t.Errorf("Load() = %+v, %v", loaded, err)
+	}
+}
+
+// TestCondition verifies the rule descriptions shown in listings.
+func TestCondition(t *testing.T) {
+	tests := map[string]Rule{
+		"crosses above 250.00": {Kind: KindPrice, Above: true, Level: 250},
+		"iv above 45%":         {Kind: KindIV, Above: true, Level: 45},
+		"P&L below -$500.00":   {Kind: KindPnL, Level: -500},
+	}
+	for want, r := range tests {
+		if got := r.Condition(); got != want {
+			t.Errorf("Condition() = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package alerts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudmanic/tradier/config"
+)
+
+// alertsFile is the file name for stored alert rules in the tradier config directory.
+const alertsFile = "alerts.json"
+
+// DefaultPath returns the path of the alert rules file in the tradier config directory.
+func DefaultPath() (string, error) {
+	dir, err := config.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, alertsFile), nil
+}
+
+// Load reads the rules stored at path. A missing file holds no rules.
+func Load(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read alerts file: %w", err)
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("unable to parse alerts file: %w", err)
+	}
+	return rules, nil
+}
+
+// Save writes the rules to path, replacing the file atomically so a running daemon never
+// reads a partial write.
+func Save(path string, rules []Rule) error {
+	if rules == nil {
+		rules = []Rule{}
+	}
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal alerts: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create config directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write alerts file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("unable to write alerts file: %w", err)
+	}
+	return nil
+}
+
+// Add appends the rule with the next unused ID and returns the updated rules and the new rule.
+func Add(rules []Rule, r Rule) ([]Rule, Rule) {
+	r.ID = 0
+	for _, existing := range rules {
+		r.ID = max(r.ID, existing.ID)
+	}
+	r.ID++
+	return append(rules, r), r
+}
+
+// Remove deletes the rule with the given ID, reporting whether it existed.
+func Remove(rules []Rule, id int) ([]Rule, bool) {
+	for i, r := range rules {
+		if r.ID == id {
+			return append(rules[:i:i], rules[i+1:]...), true
+		}
+	}
+	return rules, false
+}
+
+// MarkFired records the time each event's rule fired, so one-shot rules are not evaluated again.
+func MarkFired(rules []Rule, events []Event) []Rule {
+	for _, ev := range events {
+		for i := range rules {
+			if rules[i].ID == ev.Rule.ID {
+				rules[i].FiredAt = ev.Time
+			}
+		}
+	}
+	return rules
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package analytics
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// TradingDaysPerYear is the conventional number of trading sessions used to annualize daily statistics.
+const TradingDaysPerYear = 252.0
+
+// Point is a single observation of a value series, such as an account balance on a given day.
+type Point struct {
+	Date  time.Time
+	Value float64
+}
+
+// CashFlow is an external deposit (positive) or withdrawal (negative) into an account.
+// Flows distort simple returns, so they are removed when computing time-weighted return.
+type CashFlow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// Drawdown describes the largest peak-to-trough decline of a series.
+type Drawdown struct {
+	Depth  float64
+	Peak   int
+	Trough int
+}
+
+// PeriodReturns converts a value series into per-period returns with external cash flows removed.
+// A flow is attributed to the first observation on or after its date, and is assumed to arrive at
+// the end of that period, so it is subtracted from the ending value before computing the return.
+// Points are expected to be sorted by date. Periods that start from a non-positive value (such as an
+// unfunded account) report a zero return so the result always has one entry per period.
+func PeriodReturns(points []Point, flows []CashFlow) []float64 {
+	if len(points) < 2 {
+		return nil
+	}
+
+	sorted := make([]CashFlow, len(flows))
+	copy(sorted, flows)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	// Skip flows on or before the first observation; they are already reflected in the starting value
+	fi := 0
+	for fi < len(sorted) && !sorted[fi].Date.After(points[0].Date) {
+		fi++
+	}
+
+	returns := make([]float64, 0, len(points)-1)
+	for i := 1; i < len(points); i++ {
+		flow := 0.0
+		for fi < len(sorted) && !sorted[fi].Date.After(points[i].Date) {
+			flow += sorted[fi].Amount
+			fi++
+		}
+
+		prev := points[i-1].Value
+		if prev <= 0 {
+			returns = append(returns, 0)
+			continue
+		}
+		returns = append(returns, (points[i].Value-flow)/prev-1)
+	}
+	return returns
+}
+
+// TimeWeightedReturn geometrically links the flow-adjusted period returns of a value series.
+func TimeWeightedReturn(points []Point, flows []CashFlow) float64 {
+	return Compound(PeriodReturns(points, flows))
+}
+
+// Compound geometrically links a sequence of period returns into a single cumulative return.
+func Compound(returns []float64) float64 {
+	growth := 1.0
+	for _, r := range returns {
+		growth *= 1 + r
+	}
+	return growth - 1
+}
+
+// Annualize converts a cumulative return earned over the given number of days into an annual rate.
+// Returns the cumulative return unchanged for periods shorter than a year, where annualizing overstates results.
+func Annualize(cumulative float64, days float64) float64 {
+	if days < 365 || cumulative <= -1 {
+		return cumulative
+	}
+	return math.Pow(1+cumulative, 365.25/days) - 1
+}
+
+// WealthIndex turns period returns into a growth-of-one series starting at 1.0.
+func WealthIndex(returns []float64) []float64 {
+	index := make([]float64, 0, len(returns)+1)
+	index = append(index, 1)
+	for _, r := range returns {
+		index = append(index, index[len(index)-1]*(1+r))
+	}
+	return index
+}
+
+// MaxDrawdown returns the largest fractional decline from a running peak in the series.
+// Depth is reported as a non-positive fraction (e.g. -0.25 for a 25% drawdown).
+func MaxDrawdown(series []float64) Drawdown {
+	var dd Drawdown
+	peak := 0
+	for i, v := range series {
+		if v > series[peak] {
+			peak = i
+		}
+		if series[peak] <= 0 {
+			continue
+		}
+		depth := v/series[peak] - 1
+		if depth < dd.Depth {
+			dd = Drawdown{Depth: depth, Peak: peak, Trough: i}
+		}
+	}
+	return dd
+}
+
+// Mean returns the arithmetic mean of the values, or zero for an empty slice.
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// StdDev returns the sample standard deviation of the values, or zero when fewer than two are given.
+func StdDev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	m := Mean(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)-1))
+}
+
+// Volatility returns the annualized standard deviation of period returns.
+func Volatility(returns []float64, periodsPerYear float64) float64 {
+	return StdDev(returns) * math.Sqrt(periodsPerYear)
+}
+
+// Sharpe returns the annualized Sharpe ratio of period returns against an annual risk-free rate.
+// Returns zero when the returns have no variability.
+func Sharpe(returns []float64, riskFree, periodsPerYear float64) float64 {
+	sd := StdDev(returns)
+	if sd == 0 {
+		return 0
+	}
+	excess := Mean(returns) - riskFree/periodsPerYear
+	return excess / sd * math.Sqrt(periodsPerYear)
+}
+
+// PeriodsPerYear estimates how many observations per year a dated series has from its average spacing.
+// Daily trading series come out near 252, weekly near 52. Falls back to TradingDaysPerYear when undeterminable.
+func PeriodsPerYear(points []Point) float64 {
+	if len(points) < 2 {
+		return TradingDaysPerYear
+	}
+	days := points[len(points)-1].Date.Sub(points[0].Date).Hours() / 24
+	if days <= 0 {
+		return TradingDaysPerYear
+	}
+	perYear := float64(len(points)-1) / days * 365.25
+	// Calendar-day spacing of a daily trading series includes weekends; snap it to trading days
+	if perYear > 200 {
+		return TradingDaysPerYear
+	}
+	return perYear
+}
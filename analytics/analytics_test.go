@@ -0,0 +1,141 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// day returns a UTC date for the given day of January 2026.
+func day(d int) time.Time {
+	return time.Date(2026, time.January, d, 0, 0, 0, 0, time.UTC)
+}
+
+// almostEqual reports whether two floats are within a small tolerance.
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestPeriodReturnsWithoutFlows verifies simple returns are computed between consecutive points.
+func TestPeriodReturnsWithoutFlows(t *testing.T) {
+	points := []Point{{day(1), 100}, {day(2), 110}, {day(3), 99}}
+	got := PeriodReturns(points, nil)
+	want := []float64{0.10, -0.10}
+	if len(got) != len(want) {
+		t.Fatalf("len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !almostEqual(got[i], want[i]) {
+			t.Errorf("returns[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// TestTimeWeightedReturnRemovesDeposits verifies a deposit does not count as investment return.
+func TestTimeWeightedReturnRemovesDeposits(t *testing.T) {
+	// Flat performance, but a 1000 deposit lands on day 2
+	points := []Point{{day(1), 1000}, {day(2), 2000}, {day(3), 2000}}
+	flows := []CashFlow{{day(2), 1000}}
+	if got := TimeWeightedReturn(points, flows); !almostEqual(got, 0) {
+		t.Errorf("TimeWeightedReturn() = %v, want 0", got)
+	}
+
+	// A flow dated before the first observation is already in the starting value
+	early := []CashFlow{{day(1), 500}}
+	if got := TimeWeightedReturn([]Point{{day(1), 100}, {day(2), 105}}, early); !almostEqual(got, 0.05) {
+		t.Errorf("TimeWeightedReturn() = %v, want 0.05", got)
+	}
+}
+
+// TestTimeWeightedReturnLinksPeriods verifies returns compound geometrically across a withdrawal.
+func TestTimeWeightedReturnLinksPeriods(t *testing.T) {
+	// +10% on day 2, then withdraw 110 and earn +10% on the remaining 1000
+	points := []Point{{day(1), 1000}, {day(2), 1100}, {day(3), 1100}}
+	flows := []CashFlow{{day(3), -110}}
+	want := 1.1*((1100.0+110.0)/1100.0) - 1
+	if got := TimeWeightedReturn(points, flows); !almostEqual(got, want) {
+		t.Errorf("TimeWeightedReturn() = %v, want %v", got, want)
+	}
+}
+
+// TestMaxDrawdown verifies the deepest decline and its peak/trough indexes are found.
+func TestMaxDrawdown(t *testing.T) {
+	series := []float64{100, 120, 90, 110, 60, 130}
+	dd := MaxDrawdown(series)
+	if !almostEqual(dd.Depth, -0.5) {
+		t.Errorf("Depth = %v, want -0.5", dd.Depth)
+	}
+	if dd.Peak != 1 || dd.Trough != 4 {
+		t.Errorf("Peak/Trough = %d/%d, want 1/4", dd.Peak, dd.Trough)
+	}
+
+	if dd := MaxDrawdown([]float64{1, 2, 3}); dd.Depth != 0 {
+		t.Errorf("Depth = %v, want 0 for rising series", dd.Depth)
+	}
+}
+
+// TestVolatilityAndSharpe verifies annualization of standard deviation and Sharpe ratio.
+func TestVolatilityAndSharpe(t *testing.T) {
+	returns := []float64{0.01, -0.01, 0.01, -0.01}
+	sd := StdDev(returns)
+	if !almostEqual(Volatility(returns, 252), sd*math.Sqrt(252)) {
+		t.Errorf("Volatility() = %v, want %v", Volatility(returns, 252), sd*math.Sqrt(252))
+	}
+	if got := Sharpe(returns, 0, 252); !almostEqual(got, 0) {
+		t.Errorf("Sharpe() = %v, want 0 for zero mean", got)
+	}
+
+	positive := []float64{0.02, 0.01, 0.03}
+	want := Mean(positive) / StdDev(positive) * math.Sqrt(252)
+	if got := Sharpe(positive, 0, 252); !almostEqual(got, want) {
+		t.Errorf("Sharpe() = %v, want %v", got, want)
+	}
+	if got := Sharpe([]float64{0.01, 0.01}, 0, 252); got != 0 {
+		t.Errorf("Sharpe() = %v, want 0 for constant returns", got)
+	}
+}
+
+// TestAnnualize verifies short periods are left alone and multi-year periods are annualized.
+func TestAnnualize(t *testing.T) {
+	if got := Annualize(0.05, 90); got != 0.05 {
+		t.Errorf("Annualize() = %v, want 0.05", got)
+	}
+	got := Annualize(0.21, 365.25*2)
+	if !almostEqual(got, 0.1) {
+		t.Errorf("Annualize() = %v, want 0.1", got)
+	}
+}
+
+// TestPeriodsPerYear verifies daily series snap to trading days and weekly series stay weekly.
+func TestPeriodsPerYear(t *testing.T) {
+	daily := []Point{}
+	for i := 1; i <= 31; i++ {
+		d := day(i)
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			continue
+		}
+		daily = append(daily, Point{Date: d})
+	}
+	if got := PeriodsPerYear(daily); got != TradingDaysPerYear {
+		t.Errorf("PeriodsPerYear(daily) = %v, want %v", got, TradingDaysPerYear)
+	}
+
+	weekly := []Point{{Date: day(1)}, {Date: day(8)}, {Date: day(15)}}
+	if got := PeriodsPerYear(weekly); math.Abs(got-52.18) > 0.01 {
+		t.Errorf("PeriodsPerYear(weekly) = %v, want ~52.18", got)
+	}
+}
+
+// TestWealthIndex verifies returns are chained into a growth-of-one series.
+func TestWealthIndex(t *testing.T) {
+	got := WealthIndex([]float64{0.1, -0.5})
+	want := []float64{1, 1.1, 0.55}
+	for i := range want {
+		if !almostEqual(got[i], want[i]) {
+			t.Errorf("index[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
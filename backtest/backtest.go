@@ -0,0 +1,379 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+// Package backtest replays historical bars through a strategy and a simulated account.
+//
+// Strategies place orders as Tradier order parameters through a paper.Engine held in memory,
+// so an order is built exactly as it would be for client.Client.PlaceOrder, and fills,
+// commissions, positions, and option expiration follow the paper account's rules.
+//
+// A strategy only sees a bar once it has closed, and its orders can fill from the next bar on.
+// Each bar is walked from its open through the nearer of its high and low, then the other, to
+// its close, stopping at every working order's limit or stop price on the way: limit orders fill
+// at their price, stops trigger where they are crossed, and orders fill at the open when the
+// price gaps through them.
+package backtest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// steps is the number of points each bar is walked through: open, two extremes, and close.
+const steps = 4
+
+// Strategy decides what to trade as bars close.
+type Strategy interface {
+	// OnBar is called for each symbol as its bar closes, after working orders have had the
+	// chance to fill during the bar.
+	OnBar(ctx *Context, symbol string, bar bars.Bar) error
+}
+
+// Config describes a backtest.
+type Config struct {
+	// Symbols are the symbols replayed, in the order the strategy sees their bars.
+	Symbols []string
+
+	// Bars are each symbol's bars in time order. Bars before Start are history the strategy
+	// can see but is never called for.
+	Bars map[string][]bars.Bar
+
+	// Start and End bound the replayed bars. A zero End replays every bar after Start.
+	Start, End time.Time
+
+	// Interval is the width of intraday bars, or zero for daily bars.
+	Interval time.Duration
+
+	// Cash is the starting cash, and Commission the fees charged on fills.
+	Cash       float64
+	Commission paper.Commission
+
+	// Fetch loads the bars of an instrument a strategy trades that is not in Bars, such as an
+	// option contract. When nil, orders for such instruments can't be priced.
+	Fetch func(symbol string) ([]bars.Bar, error)
+}
+
+// LogEntry is a message recorded during a backtest, such as a rejected order.
+type LogEntry struct {
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+// Result is the outcome of a backtest.
+type Result struct {
+	Start  time.Time        `json:"start"`
+	End    time.Time        `json:"end"`
+	Stats  Stats            `json:"stats"`
+	Trades []Trade          `json:"trades"`
+	Equity []EquityPoint    `json:"equity"`
+	Fills  []paper.Event    `json:"fills"`
+	Orders []paper.Order    `json:"orders"`
+	Log    []LogEntry       `json:"log,omitempty"`
+	Open   []paper.Position `json:"open_positions,omitempty"`
+}
+
+// replay is the state of a running backtest.
+type replay struct {
+	cfg    Config
+	state  *paper.State
+	engine *paper.Engine
+	series map[string][]bars.Bar
+	now    time.Time
+	open   bool
+	step   map[string]paper.Quote
+	log    []LogEntry
+}
+
+// Run replays the configured bars through the strategy and reports the result.
+func Run(cfg Config, strategy Strategy) (*Result, error) {
+	if len(cfg.Symbols) == 0 {
+		return nil, fmt.Errorf("no symbols to backtest")
+	}
+	r := &replay{cfg: cfg, series: map[string][]bars.Bar{}}
+	for _, symbol := range cfg.Symbols {
+		r.series[symbol] = cfg.Bars[symbol]
+	}
+	times := r.timeline()
+	if len(times) == 0 {
+		return nil, fmt.Errorf("no bars between the start and end dates")
+	}
+
+	r.now = r.barOpen(times[0])
+	r.state = paper.NewState(cfg.Cash, cfg.Commission, r.now)
+	r.engine = paper.NewMemory(r.state, r.quotes, func(time.Time) (bool, error) { return r.open, nil }, func() time.Time { return r.now })
+	ctx := &Context{r: r}
+
+	var equity []EquityPoint
+	for _, t := range times {
+		if err := r.fillBar(t); err != nil {
+			return nil, err
+		}
+
+		// The strategy trades after the close, so its orders wait for the next bar
+		r.now, r.open, r.step = r.barClose(t), false, nil
+		if _, err := r.engine.Process(); err != nil {
+			return nil, err
+		}
+		equity = append(equity, r.mark())
+		for _, symbol := range r.cfg.Symbols {
+			if bar, ok := r.barAt(symbol, t); ok {
+				if err := strategy.OnBar(ctx, symbol, bar); err != nil {
+					return nil, fmt.Errorf("strategy failed on %s at %s: %w", symbol, r.now.Format("2006-01-02 15:04"), err)
+				}
+			}
+		}
+	}
+
+	res := &Result{
+		Start:  r.barOpen(times[0]),
+		End:    r.barClose(times[len(times)-1]),
+		Equity: equity,
+		Fills:  r.state.History,
+		Orders: r.state.Orders,
+		Log:    r.log,
+		Open:   r.state.Positions,
+	}
+	res.Trades = buildTrades(r.state, r.markPrice)
+	res.Stats = summarize(res, cfg, r.periodsPerYear(), r.buyAndHold(times))
+	return res, nil
+}
+
+// timeline returns the distinct times of the replayed symbols' bars between Start and End.
+func (r *replay) timeline() []time.Time {
+	seen := map[time.Time]bool{}
+	var out []time.Time
+	for _, symbol := range r.cfg.Symbols {
+		for _, b := range r.series[symbol] {
+			if b.Time.Before(r.cfg.Start) || (!r.cfg.End.IsZero() && b.Time.After(r.cfg.End)) || seen[b.Time] {
+				continue
+			}
+			seen[b.Time] = true
+			out = append(out, b.Time)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out
+}
+
+// barOpen returns when a bar starting at t opens: 9:30 a.m. Eastern for daily bars.
+func (r *replay) barOpen(t time.Time) time.Time {
+	if r.cfg.Interval > 0 {
+		return t
+	}
+	t = t.In(pricing.Eastern())
+	return time.Date(t.Year(), t.Month(), t.Day(), 9, 30, 0, 0, pricing.Eastern())
+}
+
+// barClose returns when a bar starting at t closes: 4 p.m. Eastern for daily bars.
+func (r *replay) barClose(t time.Time) time.Time {
+	if r.cfg.Interval > 0 {
+		return t.Add(r.cfg.Interval)
+	}
+	t = t.In(pricing.Eastern())
+	return time.Date(t.Year(), t.Month(), t.Day(), 16, 0, 0, 0, pricing.Eastern())
+}
+
+// barAt returns a symbol's bar starting at t.
+func (r *replay) barAt(symbol string, t time.Time) (bars.Bar, bool) {
+	b := r.series[symbol]
+	i := sort.Search(len(b), func(i int) bool { return !b[i].Time.Before(t) })
+	if i < len(b) && b[i].Time.Equal(t) {
+		return b[i], true
+	}
+	return bars.Bar{}, false
+}
+
+// closed returns the number of a symbol's bars that have closed by now.
+func (r *replay) closed(symbol string) int {
+	b := r.series[symbol]
+	return sort.Search(len(b), func(i int) bool { return r.barClose(b[i].Time).After(r.now) })
+}
+
+// load returns a symbol's bars, fetching an instrument that isn't replayed the first time it's needed.
+func (r *replay) load(symbol string) ([]bars.Bar, error) {
+	if b, ok := r.series[symbol]; ok {
+		return b, nil
+	}
+	if r.cfg.Fetch == nil {
+		return nil, fmt.Errorf("no price data for %s", symbol)
+	}
+	b, err := r.cfg.Fetch(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bars for %s: %w", symbol, err)
+	}
+	r.series[symbol] = b
+	return b, nil
+}
+
+// quotes is the engine's quote source. While a bar is being filled it returns the point of the
+// bar being walked; otherwise it returns each symbol's last close.
+func (r *replay) quotes(symbols []string) (map[string]paper.Quote, error) {
+	out := map[string]paper.Quote{}
+	for _, symbol := range symbols {
+		if r.step != nil {
+			if q, ok := r.step[symbol]; ok {
+				out[symbol] = q
+			}
+			continue
+		}
+		if _, err := r.load(symbol); err != nil {
+			return nil, err
+		}
+		if n := r.closed(symbol); n > 0 {
+			out[symbol] = quote(symbol, r.series[symbol][n-1].Close)
+		}
+	}
+	return out, nil
+}
+
+// quote returns a quote trading at price with unlimited size.
+func quote(symbol string, price float64) paper.Quote {
+	return paper.Quote{Symbol: symbol, Type: "stock", Bid: price, Ask: price, Last: price}
+}
+
+// path returns the prices a bar is walked through: the open, the extreme nearer the open, the
+// other extreme, and the close.
+func path(b bars.Bar) [steps]float64 {
+	if b.High-b.Open < b.Open-b.Low {
+		return [steps]float64{b.Open, b.High, b.Low, b.Close}
+	}
+	if b.High-b.Open > b.Open-b.Low {
+		return [steps]float64{b.Open, b.Low, b.High, b.Close}
+	}
+	// Equally near: assume the bar moved against its direction first
+	if b.Close >= b.Open {
+		return [steps]float64{b.Open, b.Low, b.High, b.Close}
+	}
+	return [steps]float64{b.Open, b.High, b.Low, b.Close}
+}
+
+// fillBar walks every instrument's bar starting at t, filling working orders along the way.
+func (r *replay) fillBar(t time.Time) error {
+	paths := map[string][steps]float64{}
+	var symbols []string
+	for symbol := range r.series {
+		if b, ok := r.barAt(symbol, t); ok {
+			paths[symbol] = path(b)
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	open, close := r.barOpen(t), r.barClose(t)
+	r.open = true
+	for k := 0; k < steps; k++ {
+		r.now = open.Add(close.Sub(open) * time.Duration(k) / steps)
+
+		// Stop at each order price crossed since the last point, in the order the price meets them
+		if k > 0 {
+			for _, symbol := range symbols {
+				from, to := paths[symbol][k-1], paths[symbol][k]
+				for _, price := range r.triggers(symbol, from, to) {
+					r.step = map[string]paper.Quote{symbol: quote(symbol, price)}
+					if _, err := r.engine.Process(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		r.step = map[string]paper.Quote{}
+		for _, symbol := range symbols {
+			r.step[symbol] = quote(symbol, paths[symbol][k])
+		}
+		if _, err := r.engine.Process(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triggers returns the limit and stop prices of working single-leg orders for a symbol that lie
+// strictly between from and to, ordered from from toward to.
+func (r *replay) triggers(symbol string, from, to float64) []float64 {
+	lo, hi := min(from, to), max(from, to)
+	var out []float64
+	for _, o := range r.state.Orders {
+		if !o.Working() || o.Class == "multileg" || len(o.Legs) != 1 || o.Legs[0].Instrument() != symbol {
+			continue
+		}
+		for _, price := range []float64{o.Price, o.Stop} {
+			if price > lo && price < hi {
+				out = append(out, price)
+			}
+		}
+	}
+	sort.Float64s(out)
+	if to < from {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
+	return out
+}
+
+// markPrice returns the last close of a symbol by now, or 0 when it has none.
+func (r *replay) markPrice(symbol string) float64 {
+	if n := r.closed(symbol); n > 0 {
+		return r.series[symbol][n-1].Close
+	}
+	return 0
+}
+
+// mark values the account at the last close of every position.
+func (r *replay) mark() EquityPoint {
+	p := EquityPoint{Time: r.now, Cash: r.state.Cash, Equity: r.state.Cash, Positions: len(r.state.Positions)}
+	for _, pos := range r.state.Positions {
+		p.Equity += pos.Quantity * r.markPrice(pos.Symbol) * multiplier(pos.Symbol)
+	}
+	return p
+}
+
+// multiplier returns the contract multiplier of a symbol: 100 for options, 1 otherwise.
+func multiplier(symbol string) float64 {
+	if occ.IsOption(symbol) {
+		return 100
+	}
+	return 1
+}
+
+// periodsPerYear returns how many bars make up a trading year at the backtest's interval.
+func (r *replay) periodsPerYear() float64 {
+	if r.cfg.Interval <= 0 {
+		return 252
+	}
+	return 252 * (390 * time.Minute).Minutes() / r.cfg.Interval.Minutes()
+}
+
+// buyAndHold returns each replayed symbol's return from its first replayed open to its last close.
+func (r *replay) buyAndHold(times []time.Time) map[string]float64 {
+	out := map[string]float64{}
+	first, last := times[0], times[len(times)-1]
+	for _, symbol := range r.cfg.Symbols {
+		var start, end float64
+		for _, b := range r.series[symbol] {
+			if b.Time.Before(first) || b.Time.After(last) {
+				continue
+			}
+			if start == 0 {
+				start = b.Open
+			}
+			end = b.Close
+		}
+		if start > 0 {
+			out[symbol] = end/start - 1
+		}
+	}
+	return out
+}
+
+// logf records a message at the current replay time.
+func (r *replay) logf(format string, args ...interface{}) {
+	r.log = append(r.log, LogEntry{Time: r.now, Message: fmt.Sprintf(format, args...)})
+}
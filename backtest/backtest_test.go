@@ -0,0 +1,240 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package backtest
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// strategyFunc adapts a function to Strategy.
+type strategyFunc func(ctx *Context, symbol string, bar bars.Bar) error
+
+// OnBar implements Strategy.
+func (f strategyFunc) OnBar(ctx *Context, symbol string, bar bars.Bar) error {
+	return f(ctx, symbol, bar)
+}
+
+// day returns midnight Eastern on a day of October 2026.
+func day(d int) time.Time {
+	return time.Date(2026, 10, d, 0, 0, 0, 0, pricing.Eastern())
+}
+
+// bar returns a daily bar.
+func bar(d int, open, high, low, close float64) bars.Bar {
+	return bars.Bar{Time: day(d), Open: open, High: high, Low: low, Close: close, Volume: 1000}
+}
+
+// near reports whether two floats are within a cent.
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// TestPath verifies a bar is walked through the nearer extreme first.
+func TestPath(t *testing.T) {
+	tests := []struct {
+		b    bars.Bar
+		want [steps]float64
+	}{
+		{bar(5, 100, 105, 99, 104), [steps]float64{100, 99, 105, 104}},
+		{bar(5, 100, 101, 95, 96), [steps]float64{100, 101, 95, 96}},
+		{bar(5, 100, 102, 98, 101), [steps]float64{100, 98, 102, 101}},
+		{bar(5, 100, 102, 98, 99), [steps]float64{100, 102, 98, 99}},
+	}
+	for _, tt := range tests {
+		if got := path(tt.b); got != tt.want {
+			t.Errorf("path(%+v) = %v, want %v", tt.b, got, tt.want)
+		}
+	}
+}
+
+// TestRun verifies orders fill from the next bar at the open, at limit prices, and through gaps,
+// and that trades and statistics follow from the fills.
+func TestRun(t *testing.T) {
+	cfg := Config{
+		Symbols: []string{"AAPL"},
+		Bars: map[string][]bars.Bar{"AAPL": {
+			bar(2, 99, 100, 98, 99),
+			bar(5, 100, 101, 99, 100),
+			bar(6, 102, 104, 101, 103),
+			bar(7, 103, 108, 102, 107),
+			bar(8, 107, 109, 106, 108),
+			bar(9, 100, 101, 98, 99),
+		}},
+		Start: day(5),
+		Cash:  100000,
+	}
+	orders := map[int]map[string]string{
+		5: {"side": "buy", "type": "market", "duration": "day"},
+		6: {"side": "sell", "type": "limit", "price": "106", "duration": "gtc"},
+		7: {"side": "buy", "type": "market", "duration": "day"},
+		8: {"side": "sell", "type": "stop", "stop": "105", "duration": "gtc"},
+	}
+	var seen []int
+	strategy := strategyFunc(func(ctx *Context, symbol string, b bars.Bar) error {
+		d := b.Time.Day()
+		seen = append(seen, len(ctx.Bars(symbol)))
+		if !ctx.Time().Equal(time.Date(2026, 10, d, 16, 0, 0, 0, pricing.Eastern())) {
+			t.Errorf("Time() = %v on day %d, want the close", ctx.Time(), d)
+		}
+		if p, ok := orders[d]; ok {
+			params := map[string]string{"class": "equity", "symbol": symbol, "quantity": "10"}
+			for k, v := range p {
+				params[k] = v
+			}
+			if _, err := ctx.PlaceOrder(params); err != nil {
+				t.Fatalf("PlaceOrder() on day %d error: %v", d, err)
+			}
+		}
+		return nil
+	})
+
+	res, err := Run(cfg, strategy)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if len(seen) != 5 || seen[0] != 2 || seen[4] != 6 {
+		t.Errorf("bars seen by the strategy = %v, want 2 through 6", seen)
+	}
+
+	wantFills := []float64{102, 106, 107, 100}
+	if len(res.Fills) != len(wantFills) {
+		t.Fatalf("Fills = %+v, want %d", res.Fills, len(wantFills))
+	}
+	for i, f := range res.Fills {
+		if !near(f.Price, wantFills[i]) {
+			t.Errorf("fill %d price = %v, want %v", i, f.Price, wantFills[i])
+		}
+	}
+	if want := time.Date(2026, 10, 6, 9, 30, 0, 0, pricing.Eastern()); !res.Fills[0].Date.Equal(want) {
+		t.Errorf("first fill at %v, want %v", res.Fills[0].Date, want)
+	}
+
+	if len(res.Trades) != 2 || !near(res.Trades[0].PnL, 40) || !near(res.Trades[1].PnL, -70) {
+		t.Fatalf("Trades = %+v, want +40 and -70", res.Trades)
+	}
+	if tr := res.Trades[0]; tr.Side != "long" || tr.Quantity != 10 || !near(tr.EntryPrice, 102) || !near(tr.ExitPrice, 106) || tr.Open {
+		t.Errorf("Trades[0] = %+v", tr)
+	}
+
+	st := res.Stats
+	if !near(st.FinalEquity, 99970) || st.Trades != 2 || st.Wins != 1 || st.Losses != 1 || !near(st.ProfitFactor, 40.0/70) {
+		t.Errorf("Stats = %+v", st)
+	}
+	if want := 99970.0/100050 - 1; math.Abs(st.MaxDrawdown-want) > 1e-9 || !st.DrawdownTrough.Equal(res.Equity[4].Time) {
+		t.Errorf("MaxDrawdown = %v at %v, want %v", st.MaxDrawdown, st.DrawdownTrough, want)
+	}
+	if !near(st.Exposure, 0.4) || !near(st.BuyAndHold["AAPL"], 99.0/100-1) {
+		t.Errorf("Exposure = %v, BuyAndHold = %v", st.Exposure, st.BuyAndHold)
+	}
+	if len(res.Equity) != 5 || !near(res.Equity[1].Equity, 100010) || res.Equity[4].Drawdown >= 0 {
+		t.Errorf("Equity = %+v", res.Equity)
+	}
+}
+
+// TestRunOpenTradeAndCommission verifies commissions are charged and a position left open is
+// valued at the last close.
+func TestRunOpenTradeAndCommission(t *testing.T) {
+	cfg := Config{
+		Symbols:    []string{"SPY"},
+		Bars:       map[string][]bars.Bar{"SPY": {bar(5, 600, 601, 599, 600), bar(6, 602, 605, 601, 604)}},
+		Cash:       10000,
+		Commission: paper.Commission{PerOrder: 1},
+	}
+	strategy := strategyFunc(func(ctx *Context, symbol string, b bars.Bar) error {
+		if ctx.Position(symbol) == 0 && len(ctx.Orders()) == 0 {
+			_, err := ctx.PlaceOrder(map[string]string{"class": "equity", "symbol": symbol, "side": "buy", "quantity": "100", "type": "market", "duration": "day"})
+			if err == nil {
+				t.Errorf("PlaceOrder() beyond the cash error = nil, want rejection")
+			}
+			ctx.Logf("rejected")
+			_, err = ctx.PlaceOrder(map[string]string{"class": "equity", "symbol": symbol, "side": "buy", "quantity": "10", "type": "market", "duration": "day"})
+			return err
+		}
+		return nil
+	})
+
+	res, err := Run(cfg, strategy)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if len(res.Trades) != 1 || !res.Trades[0].Open || !near(res.Trades[0].PnL, 20-1) || !near(res.Trades[0].ExitPrice, 604) {
+		t.Errorf("Trades = %+v, want one open trade up 19", res.Trades)
+	}
+	if st := res.Stats; st.Trades != 0 || !near(st.Commission, 1) || !near(st.FinalEquity, 10019) {
+		t.Errorf("Stats = %+v", st)
+	}
+	if len(res.Log) != 1 || len(res.Open) != 1 {
+		t.Errorf("Log = %+v, Open = %+v", res.Log, res.Open)
+	}
+}
+
+// TestRunIntraday verifies intraday bars close at the end of their interval and a day order
+// placed at the close carries to the next session.
+func TestRunIntraday(t *testing.T) {
+	at := func(d, h, m int) time.Time { return time.Date(2026, 10, d, h, m, 0, 0, pricing.Eastern()) }
+	cfg := Config{
+		Symbols: []string{"QQQ"},
+		Bars: map[string][]bars.Bar{"QQQ": {
+			{Time: at(5, 15, 55), Open: 500, High: 501, Low: 499, Close: 500},
+			{Time: at(6, 9, 30), Open: 495, High: 496, Low: 494, Close: 495},
+		}},
+		Interval: 5 * time.Minute,
+		Cash:     100000,
+	}
+	strategy := strategyFunc(func(ctx *Context, symbol string, b bars.Bar) error {
+		if !ctx.Time().Equal(b.Time.Add(5 * time.Minute)) {
+			t.Errorf("Time() = %v, want %v", ctx.Time(), b.Time.Add(5*time.Minute))
+		}
+		if ctx.Position(symbol) == 0 && len(ctx.Orders()) == 0 {
+			_, err := ctx.PlaceOrder(map[string]string{"class": "equity", "symbol": symbol, "side": "buy", "quantity": "1", "type": "limit", "price": "497", "duration": "day"})
+			return err
+		}
+		return nil
+	})
+	res, err := Run(cfg, strategy)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if len(res.Fills) != 1 || !near(res.Fills[0].Price, 495) || !res.Fills[0].Date.Equal(at(6, 9, 30)) {
+		t.Errorf("Fills = %+v, want one at the 495 open", res.Fills)
+	}
+}
+
+// TestRunFetch verifies instruments outside the replayed symbols are loaded on demand.
+func TestRunFetch(t *testing.T) {
+	option := "SPY261120C00600000"
+	fetched := 0
+	cfg := Config{
+		Symbols: []string{"SPY"},
+		Bars:    map[string][]bars.Bar{"SPY": {bar(5, 600, 601, 599, 600), bar(6, 602, 605, 601, 604)}},
+		Cash:    10000,
+		Fetch: func(symbol string) ([]bars.Bar, error) {
+			fetched++
+			return []bars.Bar{bar(5, 10, 11, 9, 10), bar(6, 12, 13, 11, 12.5)}, nil
+		},
+	}
+	strategy := strategyFunc(func(ctx *Context, symbol string, b bars.Bar) error {
+		if ctx.Position(option) == 0 && len(ctx.Orders()) == 0 {
+			_, err := ctx.PlaceOrder(map[string]string{"class": "option", "symbol": symbol, "option_symbol": option, "side": "buy_to_open", "quantity": "1", "type": "market", "duration": "day"})
+			return err
+		}
+		return nil
+	})
+	res, err := Run(cfg, strategy)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if fetched != 1 || len(res.Fills) != 1 || !near(res.Fills[0].Price, 12) {
+		t.Fatalf("fetched %d, Fills = %+v", fetched, res.Fills)
+	}
+	if !near(res.Stats.FinalEquity, 10000+50) {
+		t.Errorf("FinalEquity = %v, want 10050", res.Stats.FinalEquity)
+	}
+}
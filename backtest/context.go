@@ -0,0 +1,95 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package backtest
+
+import (
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/paper"
+)
+
+// Context is a strategy's view of the backtest: the replay clock, the bars closed so far, and
+// the simulated account it trades.
+type Context struct {
+	r *replay
+}
+
+// Time returns the current replay time, the close of the bar being handled.
+func (c *Context) Time() time.Time {
+	return c.r.now
+}
+
+// Bars returns a symbol's bars that have closed by now, oldest first, including the history
+// before the start date. The slice must not be modified.
+func (c *Context) Bars(symbol string) []bars.Bar {
+	b, err := c.r.load(symbol)
+	if err != nil {
+		return nil
+	}
+	return b[:c.r.closed(symbol)]
+}
+
+// Position returns the signed quantity held of a symbol: negative when short.
+func (c *Context) Position(symbol string) float64 {
+	for _, p := range c.r.state.Positions {
+		if p.Symbol == symbol {
+			return p.Quantity
+		}
+	}
+	return 0
+}
+
+// Positions returns the account's open positions.
+func (c *Context) Positions() []paper.Position {
+	return append([]paper.Position(nil), c.r.state.Positions...)
+}
+
+// Orders returns the account's working orders.
+func (c *Context) Orders() []paper.Order {
+	var out []paper.Order
+	for _, o := range c.r.state.Orders {
+		if o.Working() {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// Cash returns the account's cash balance.
+func (c *Context) Cash() float64 {
+	return c.r.state.Cash
+}
+
+// Equity returns the account's cash plus its positions at their last close.
+func (c *Context) Equity() float64 {
+	return c.r.mark().Equity
+}
+
+// PlaceOrder places an order from Tradier order parameters, as client.Client.PlaceOrder does,
+// and returns the same response.
+func (c *Context) PlaceOrder(params map[string]string) ([]byte, error) {
+	return c.r.engine.PlaceOrder(paper.AccountNumber, params)
+}
+
+// ChangeOrder changes the type, duration, price, or stop of a working order.
+func (c *Context) ChangeOrder(orderID string, params map[string]string) ([]byte, error) {
+	return c.r.engine.ChangeOrder(paper.AccountNumber, orderID, params)
+}
+
+// CancelOrder cancels a working order.
+func (c *Context) CancelOrder(orderID string) ([]byte, error) {
+	return c.r.engine.CancelOrder(paper.AccountNumber, orderID)
+}
+
+// Account returns the simulated account, which answers the account and order calls of
+// client.Client, for code written against that interface.
+func (c *Context) Account() *paper.Engine {
+	return c.r.engine
+}
+
+// Logf records a message in the backtest log at the current replay time.
+func (c *Context) Logf(format string, args ...interface{}) {
+	c.r.logf(format, args...)
+}
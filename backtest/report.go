@@ -0,0 +1,239 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package backtest
+
+import (
+	"math"
+	"time"
+
+	"github.com/cloudmanic/tradier/analytics"
+	"github.com/cloudmanic/tradier/paper"
+)
+
+// EquityPoint is the account's value at the close of one bar. Drawdown is the fractional
+// decline from the highest equity so far, zero or negative.
+type EquityPoint struct {
+	Time      time.Time `json:"time"`
+	Equity    float64   `json:"equity"`
+	Cash      float64   `json:"cash"`
+	Drawdown  float64   `json:"drawdown"`
+	Positions int       `json:"positions"`
+}
+
+// Trade is a round trip in one instrument, from opening a position to closing it. Prices are
+// average fill prices; PnL includes commissions, and Return is PnL as a fraction of the cost of
+// opening. A trade still open at the end is valued at the last close.
+type Trade struct {
+	Symbol     string    `json:"symbol"`
+	Side       string    `json:"side"`
+	Quantity   float64   `json:"quantity"`
+	EntryTime  time.Time `json:"entry_time"`
+	ExitTime   time.Time `json:"exit_time,omitzero"`
+	EntryPrice float64   `json:"entry_price"`
+	ExitPrice  float64   `json:"exit_price"`
+	PnL        float64   `json:"pnl"`
+	Return     float64   `json:"return"`
+	Commission float64   `json:"commission"`
+	Tag        string    `json:"tag,omitempty"`
+	Open       bool      `json:"open,omitempty"`
+}
+
+// Stats summarizes a backtest. Returns and drawdowns are fractions (0.05 = 5%). Trade
+// statistics count closed trades only, and ProfitFactor is zero when no trade lost money.
+type Stats struct {
+	StartingCash     float64            `json:"starting_cash"`
+	FinalEquity      float64            `json:"final_equity"`
+	NetProfit        float64            `json:"net_profit"`
+	TotalReturn      float64            `json:"total_return"`
+	AnnualizedReturn float64            `json:"annualized_return"`
+	MaxDrawdown      float64            `json:"max_drawdown"`
+	DrawdownPeak     time.Time          `json:"drawdown_peak,omitzero"`
+	DrawdownTrough   time.Time          `json:"drawdown_trough,omitzero"`
+	Volatility       float64            `json:"volatility"`
+	Sharpe           float64            `json:"sharpe"`
+	Trades           int                `json:"trades"`
+	Wins             int                `json:"wins"`
+	Losses           int                `json:"losses"`
+	WinRate          float64            `json:"win_rate"`
+	ProfitFactor     float64            `json:"profit_factor"`
+	AverageWin       float64            `json:"average_win"`
+	AverageLoss      float64            `json:"average_loss"`
+	LargestWin       float64            `json:"largest_win"`
+	LargestLoss      float64            `json:"largest_loss"`
+	AverageHoldDays  float64            `json:"average_hold_days"`
+	Exposure         float64            `json:"exposure"`
+	Commission       float64            `json:"commission"`
+	BuyAndHold       map[string]float64 `json:"buy_and_hold"`
+}
+
+// openTrade accumulates a round trip while its position is open.
+type openTrade struct {
+	trade      Trade
+	held       float64
+	entryQty   float64
+	entryValue float64
+	exitQty    float64
+	exitValue  float64
+}
+
+// buildTrades pairs the account's fills and option settlements into round trips, valuing
+// positions still open with mark.
+func buildTrades(s *paper.State, mark func(symbol string) float64) []Trade {
+	tags := map[int]string{}
+	for _, o := range s.Orders {
+		tags[o.ID] = o.Tag
+	}
+
+	var out []Trade
+	open := map[string]*openTrade{}
+	var order []string
+	for _, ev := range s.History {
+		if (ev.Type != "trade" && ev.Type != "option") || ev.Quantity == 0 {
+			continue
+		}
+		t := open[ev.Symbol]
+		if t == nil {
+			side := "long"
+			if ev.Quantity < 0 {
+				side = "short"
+			}
+			t = &openTrade{trade: Trade{Symbol: ev.Symbol, Side: side, EntryTime: ev.Date, Tag: tags[ev.OrderID]}}
+			open[ev.Symbol] = t
+			order = append(order, ev.Symbol)
+		}
+
+		mult := multiplier(ev.Symbol)
+		qty := math.Abs(ev.Quantity)
+		if t.held == 0 || (t.held > 0) == (ev.Quantity > 0) {
+			t.entryQty += qty
+			t.entryValue += qty * ev.Price * mult
+		} else {
+			t.exitQty += qty
+			t.exitValue += qty * ev.Price * mult
+		}
+		t.held += ev.Quantity
+		t.trade.Quantity = math.Max(t.trade.Quantity, math.Abs(t.held))
+		t.trade.PnL += ev.Amount
+		t.trade.Commission += ev.Commission
+
+		if math.Abs(t.held) < 1e-9 {
+			t.trade.ExitTime = ev.Date
+			out = append(out, t.finish())
+			delete(open, ev.Symbol)
+		}
+	}
+
+	for _, symbol := range order {
+		t, ok := open[symbol]
+		if !ok {
+			continue
+		}
+		delete(open, symbol)
+		price := mark(symbol)
+		mult := multiplier(symbol)
+		t.trade.PnL += t.held * price * mult
+		t.exitQty += math.Abs(t.held)
+		t.exitValue += math.Abs(t.held) * price * mult
+		t.trade.Open = true
+		out = append(out, t.finish())
+	}
+	return out
+}
+
+// finish computes a round trip's average prices and return.
+func (t *openTrade) finish() Trade {
+	mult := multiplier(t.trade.Symbol)
+	if t.entryQty > 0 {
+		t.trade.EntryPrice = t.entryValue / t.entryQty / mult
+	}
+	if t.exitQty > 0 {
+		t.trade.ExitPrice = t.exitValue / t.exitQty / mult
+	}
+	if t.entryValue > 0 {
+		t.trade.Return = t.trade.PnL / t.entryValue
+	}
+	return t.trade
+}
+
+// summarize computes the statistics of a backtest result, whose equity curve has periodsPerYear
+// points a year, and fills in each point's drawdown.
+func summarize(res *Result, cfg Config, periodsPerYear float64, buyAndHold map[string]float64) Stats {
+	st := Stats{StartingCash: cfg.Cash, FinalEquity: cfg.Cash, BuyAndHold: buyAndHold}
+
+	// The curve starts with the starting cash at the first open
+	values := []float64{cfg.Cash}
+	times := []time.Time{res.Start}
+	invested := 0
+	for _, p := range res.Equity {
+		values = append(values, p.Equity)
+		times = append(times, p.Time)
+		if p.Positions > 0 {
+			invested++
+		}
+	}
+	peak := values[0]
+	for i := range res.Equity {
+		peak = math.Max(peak, values[i+1])
+		if peak > 0 {
+			res.Equity[i].Drawdown = values[i+1]/peak - 1
+		}
+	}
+
+	st.FinalEquity = values[len(values)-1]
+	st.NetProfit = st.FinalEquity - cfg.Cash
+	if cfg.Cash > 0 {
+		st.TotalReturn = st.FinalEquity/cfg.Cash - 1
+	}
+	st.AnnualizedReturn = analytics.Annualize(st.TotalReturn, res.End.Sub(res.Start).Hours()/24)
+	dd := analytics.MaxDrawdown(values)
+	st.MaxDrawdown = dd.Depth
+	if dd.Depth < 0 {
+		st.DrawdownPeak, st.DrawdownTrough = times[dd.Peak], times[dd.Trough]
+	}
+
+	var returns []float64
+	for i := 1; i < len(values); i++ {
+		if values[i-1] > 0 {
+			returns = append(returns, values[i]/values[i-1]-1)
+		}
+	}
+	st.Volatility = analytics.Volatility(returns, periodsPerYear)
+	st.Sharpe = analytics.Sharpe(returns, 0, periodsPerYear)
+	if len(res.Equity) > 0 {
+		st.Exposure = float64(invested) / float64(len(res.Equity))
+	}
+	for _, f := range res.Fills {
+		st.Commission += f.Commission
+	}
+
+	var gains, losses, held float64
+	for _, t := range res.Trades {
+		if t.Open {
+			continue
+		}
+		st.Trades++
+		held += t.ExitTime.Sub(t.EntryTime).Hours() / 24
+		if t.PnL > 0 {
+			st.Wins++
+			gains += t.PnL
+			st.LargestWin = math.Max(st.LargestWin, t.PnL)
+		} else if t.PnL < 0 {
+			st.Losses++
+			losses -= t.PnL
+			st.LargestLoss = math.Min(st.LargestLoss, t.PnL)
+		}
+	}
+	if st.Trades > 0 {
+		st.WinRate = float64(st.Wins) / float64(st.Trades)
+		st.AverageHoldDays = held / float64(st.Trades)
+	}
+	if st.Wins > 0 {
+		st.AverageWin = gains / float64(st.Wins)
+	}
+	if st.Losses > 0 {
+		st.AverageLoss = -losses / float64(st.Losses)
+		st.ProfitFactor = gains / losses
+	}
+	return st
+}
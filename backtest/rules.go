@@ -0,0 +1,431 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package backtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/expr"
+	"github.com/cloudmanic/tradier/indicators"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/scheduler"
+	"gopkg.in/yaml.v3"
+)
+
+// Warmup is implemented by strategies that need history before the start date, such as for
+// their indicators to settle. Lookback returns the number of bars needed.
+type Warmup interface {
+	Lookback() int
+}
+
+// barFields are the price fields of the current bar available to rule expressions.
+var barFields = map[string]func(b bars.Bar) float64{
+	"open":   func(b bars.Bar) float64 { return b.Open },
+	"high":   func(b bars.Bar) float64 { return b.High },
+	"low":    func(b bars.Bar) float64 { return b.Low },
+	"close":  func(b bars.Bar) float64 { return b.Close },
+	"volume": func(b bars.Bar) float64 { return b.Volume },
+}
+
+// accountFields are the account and clock fields available to rule expressions.
+var accountFields = map[string]bool{
+	"position": true, "avg_price": true, "open_orders": true, "cash": true, "equity": true, "time": true, "symbol": true,
+}
+
+// computedParams are the order parameters whose values are expressions, with or without a leg index.
+var computedParams = regexp.MustCompile(`^(quantity|price|stop)(\[\d+\])?$`)
+
+// tagChars matches the characters not allowed in an order tag.
+var tagChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Rules is a rule-based strategy: on every closed bar of every symbol, each rule whose condition
+// holds places its order.
+type Rules struct {
+	// Name, Interval, Cash, and Commission are the file's settings. Interval is empty, and Cash
+	// and Commission are zero and nil, when the file leaves them to the command line.
+	Name       string
+	Interval   string
+	Cash       float64
+	Commission *paper.Commission
+
+	Rules []Rule
+
+	// columns caches indicator values computed over each symbol's whole series.
+	columns map[string]map[string][]float64
+}
+
+// Rule is one condition and the order placed when it holds.
+type Rule struct {
+	Name    string
+	When    *expr.Expr
+	Symbols []string
+
+	// Order holds the fixed order parameters and Values the ones computed from expressions.
+	Order  map[string]string
+	Values map[string]*expr.Expr
+}
+
+// rulesFile is the YAML layout of a rules file.
+type rulesFile struct {
+	Name       string  `yaml:"name"`
+	Interval   string  `yaml:"interval"`
+	Cash       float64 `yaml:"cash"`
+	Commission *struct {
+		PerOrder    float64 `yaml:"per_order"`
+		PerContract float64 `yaml:"per_contract"`
+	} `yaml:"commission"`
+	Rules []struct {
+		Name    string                 `yaml:"name"`
+		When    string                 `yaml:"when"`
+		Symbols []string               `yaml:"symbols"`
+		Order   map[string]interface{} `yaml:"order"`
+	} `yaml:"rules"`
+}
+
+// ParseRules decodes a YAML rules file:
+//
+//	name: sma-cross
+//	interval: daily          # daily, 1min, 5min, or 15min
+//	cash: 25000
+//	commission:
+//	  per_order: 0
+//	  per_contract: 0.35
+//	rules:
+//	  - name: enter
+//	    when: close > sma_50 and prev_close <= prev_sma_50 and position == 0 and open_orders == 0
+//	    order:
+//	      side: buy
+//	      quantity: floor(cash * 0.95 / close)
+//	      type: market
+//	      duration: day
+//	  - name: exit
+//	    when: position > 0 and close < sma_50
+//	    order:
+//	      side: sell
+//	      quantity: position
+//	      type: market
+//	      duration: day
+//
+// Conditions use the expression syntax of markets scan over the bar's open, high, low, close,
+// and volume; indicator columns such as sma_50, rsi_14, or bb_lower_20_2; prev_ versions of
+// each for the bar before; position (signed), avg_price, and open_orders for the symbol; the
+// account's cash and equity; time as HHMM Eastern; and symbol. Fields of another replayed symbol
+// are written with its name first, as in spy.close or spy.sma_200.
+//
+// An order takes the fields of an order file. Class defaults to equity and symbol to the bar's
+// symbol, and the tag to the rule name. Quantity, price, and stop may be expressions: quantity
+// is rounded down to a whole number, and the order is skipped when it comes to zero.
+func ParseRules(data []byte) (*Rules, error) {
+	var raw rulesFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("unable to parse strategy file: %w", err)
+	}
+	if len(raw.Rules) == 0 {
+		return nil, fmt.Errorf("strategy file must list at least one rule under rules")
+	}
+
+	r := &Rules{Name: raw.Name, Interval: raw.Interval, Cash: raw.Cash, columns: map[string]map[string][]float64{}}
+	if raw.Commission != nil {
+		r.Commission = &paper.Commission{PerOrder: raw.Commission.PerOrder, PerContract: raw.Commission.PerContract}
+	}
+	for i, rr := range raw.Rules {
+		name := rr.Name
+		if name == "" {
+			name = fmt.Sprintf("rule-%d", i+1)
+		}
+		rule, err := parseRule(name, rr.When, rr.Symbols, rr.Order)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", name, err)
+		}
+		r.Rules = append(r.Rules, rule)
+	}
+	return r, nil
+}
+
+// LoadRules reads and parses a rules file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read strategy file: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// parseRule compiles one rule's condition and order.
+func parseRule(name, when string, symbols []string, order map[string]interface{}) (Rule, error) {
+	rule := Rule{Name: name, Values: map[string]*expr.Expr{}}
+	if strings.TrimSpace(when) == "" {
+		return rule, fmt.Errorf("when is required")
+	}
+	var err error
+	if rule.When, err = expr.Parse(when); err != nil {
+		return rule, fmt.Errorf("invalid when: %w", err)
+	}
+	if err := checkFields(rule.When); err != nil {
+		return rule, fmt.Errorf("invalid when: %w", err)
+	}
+	for _, s := range symbols {
+		rule.Symbols = append(rule.Symbols, strings.ToUpper(s))
+	}
+
+	if len(order) == 0 {
+		return rule, fmt.Errorf("order is required")
+	}
+	if _, ok := order["class"]; !ok {
+		order["class"] = "equity"
+	}
+	data, err := yaml.Marshal(order)
+	if err != nil {
+		return rule, err
+	}
+	if rule.Order, err = scheduler.ParseOrder(data); err != nil {
+		return rule, err
+	}
+	for key, val := range rule.Order {
+		if !computedParams.MatchString(key) {
+			continue
+		}
+		e, err := expr.Parse(val)
+		if err != nil {
+			return rule, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		if err := checkFields(e); err != nil {
+			return rule, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		rule.Values[key] = e
+		delete(rule.Order, key)
+	}
+	if rule.Order["tag"] == "" {
+		if tag := strings.Trim(tagChars.ReplaceAllString(strings.ToLower(name), "-"), "-"); tag != "" {
+			rule.Order["tag"] = tag
+		}
+	}
+	return rule, nil
+}
+
+// checkFields reports the first field an expression uses that rules don't provide.
+func checkFields(e *expr.Expr) error {
+	for _, name := range e.Idents() {
+		if i := strings.Index(name, "."); i > 0 {
+			name = name[i+1:]
+		}
+		field := strings.TrimPrefix(name, "prev_")
+		if accountFields[name] || barFields[field] != nil {
+			continue
+		}
+		if _, ok := indicators.SpecForColumn(field); ok {
+			continue
+		}
+		return fmt.Errorf("unknown field %q", name)
+	}
+	return nil
+}
+
+// Lookback returns the number of bars the rules' indicators need before their values settle.
+func (r *Rules) Lookback() int {
+	n := 0
+	for _, rule := range r.Rules {
+		exprs := []*expr.Expr{rule.When}
+		for _, e := range rule.Values {
+			exprs = append(exprs, e)
+		}
+		for _, e := range exprs {
+			for _, name := range e.Idents() {
+				if i := strings.Index(name, "."); i > 0 {
+					name = name[i+1:]
+				}
+				if spec, ok := indicators.SpecForColumn(strings.TrimPrefix(name, "prev_")); ok {
+					n = max(n, indicators.Lookback(spec)+1)
+				}
+			}
+		}
+	}
+	return n
+}
+
+// OnBar implements Strategy. Rejected orders are recorded in the log rather than stopping the run.
+func (r *Rules) OnBar(ctx *Context, symbol string, bar bars.Bar) error {
+	env := r.env(ctx, symbol)
+	for _, rule := range r.Rules {
+		if len(rule.Symbols) > 0 && !contains(rule.Symbols, symbol) {
+			continue
+		}
+		ok, err := rule.When.Match(env)
+		if err != nil {
+			return fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if !ok {
+			continue
+		}
+		params, err := rule.params(env, symbol)
+		if err != nil {
+			return fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if params == nil {
+			continue
+		}
+		if _, err := ctx.PlaceOrder(params); err != nil {
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) {
+				return err
+			}
+			ctx.Logf("%s: rule %s order rejected: %s", symbol, rule.Name, rejection(apiErr))
+		}
+	}
+	return nil
+}
+
+// params returns the rule's order for a symbol, or nil when its quantity comes to zero.
+func (rule Rule) params(env expr.Env, symbol string) (map[string]string, error) {
+	params := make(map[string]string, len(rule.Order)+len(rule.Values)+1)
+	for k, v := range rule.Order {
+		params[k] = v
+	}
+	if params["symbol"] == "" {
+		params["symbol"] = symbol
+	}
+	for key, e := range rule.Values {
+		v, err := e.Eval(env)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if v.IsStr {
+			return nil, fmt.Errorf("%s must be a number", key)
+		}
+		n := v.Num
+		if strings.HasPrefix(key, "quantity") {
+			n = math.Floor(math.Abs(n))
+		} else {
+			n = math.Round(n*100) / 100
+		}
+		if math.IsNaN(n) || n <= 0 {
+			return nil, nil
+		}
+		params[key] = strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return params, nil
+}
+
+// env resolves the fields of rule expressions for a symbol.
+func (r *Rules) env(ctx *Context, symbol string) expr.Env {
+	return func(name string) (expr.Value, bool) {
+		if i := strings.Index(name, "."); i > 0 {
+			return r.field(ctx, strings.ToUpper(name[:i]), name[i+1:])
+		}
+		return r.field(ctx, symbol, name)
+	}
+}
+
+// field returns one field of a symbol. Values not yet available, such as indicators still
+// warming up, are NaN so comparisons against them fail.
+func (r *Rules) field(ctx *Context, symbol, name string) (expr.Value, bool) {
+	switch name {
+	case "symbol":
+		return expr.String(symbol), true
+	case "cash":
+		return expr.Number(ctx.Cash()), true
+	case "equity":
+		return expr.Number(ctx.Equity()), true
+	case "time":
+		t := ctx.Time().In(pricing.Eastern())
+		return expr.Number(float64(t.Hour()*100 + t.Minute())), true
+	case "position":
+		return expr.Number(ctx.Position(symbol)), true
+	case "avg_price":
+		for _, p := range ctx.Positions() {
+			if p.Symbol == symbol && p.Quantity != 0 {
+				return expr.Number(math.Abs(p.CostBasis / p.Quantity / multiplier(symbol))), true
+			}
+		}
+		return expr.Number(math.NaN()), true
+	case "open_orders":
+		n := 0
+		for _, o := range ctx.Orders() {
+			if o.Symbol == symbol || o.Legs[0].Instrument() == symbol {
+				n++
+			}
+		}
+		return expr.Number(float64(n)), true
+	}
+
+	b := ctx.Bars(symbol)
+	i := len(b) - 1
+	if strings.HasPrefix(name, "prev_") {
+		name = strings.TrimPrefix(name, "prev_")
+		i--
+	}
+	value := func(values []float64) (expr.Value, bool) {
+		if i < 0 || i >= len(values) {
+			return expr.Number(math.NaN()), true
+		}
+		return expr.Number(values[i]), true
+	}
+
+	if f, ok := barFields[name]; ok {
+		if i < 0 {
+			return expr.Number(math.NaN()), true
+		}
+		return expr.Number(f(b[i])), true
+	}
+	spec, ok := indicators.SpecForColumn(name)
+	if !ok {
+		return expr.Value{}, false
+	}
+	return value(r.column(ctx, symbol, name, spec))
+}
+
+// column returns an indicator column over a symbol's whole series. Indicators only look back,
+// so the value at a bar is the same as if it had been computed from the bars up to it.
+func (r *Rules) column(ctx *Context, symbol, name string, spec indicators.Spec) []float64 {
+	if r.columns == nil {
+		r.columns = map[string]map[string][]float64{}
+	}
+	if values, ok := r.columns[symbol][name]; ok {
+		return values
+	}
+	if r.columns[symbol] == nil {
+		r.columns[symbol] = map[string][]float64{}
+	}
+	series, _ := ctx.r.load(symbol)
+	for _, col := range indicators.Compute(spec, series) {
+		r.columns[symbol][col.Name] = col.Values
+	}
+	return r.columns[symbol][name]
+}
+
+// rejection returns the message of an order rejection.
+func rejection(err *client.APIError) string {
+	var body struct {
+		Errors struct {
+			Error []string `json:"error"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal([]byte(err.Body), &body) == nil && len(body.Errors.Error) > 0 {
+		return strings.Join(body.Errors.Error, "; ")
+	}
+	return err.Body
+}
+
+// contains reports whether list holds s.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package backtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudmanic/tradier/bars"
+)
+
+// crossRules buys when the close crosses above its 2-bar average and sells when it falls below.
+const crossRules = `
+name: cross
+interval: daily
+cash: 5000
+commission:
+  per_order: 1
+rules:
+  - name: Enter Long
+    when: close > sma_2 and prev_close <= prev_sma_2 and position == 0 and open_orders == 0
+    order:
+      side: buy
+      quantity: floor(cash / 2 / close)
+      type: market
+      duration: day
+  - name: exit
+    when: position > 0 and close < sma_2
+    order:
+      side: sell
+      quantity: position
+      type: limit
+      price: close * 1.001
+      duration: gtc
+`
+
+// TestParseRules verifies settings, defaults, and computed order fields are read from a rules file.
+func TestParseRules(t *testing.T) {
+	r, err := ParseRules([]byte(crossRules))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+	if r.Name != "cross" || r.Interval != "daily" || r.Cash != 5000 || r.Commission == nil || r.Commission.PerOrder != 1 {
+		t.Errorf("settings = %+v", r)
+	}
+	if len(r.Rules) != 2 {
+		t.Fatalf("Rules = %d, want 2", len(r.Rules))
+	}
+	enter := r.Rules[0]
+	if enter.Order["class"] != "equity" || enter.Order["tag"] != "enter-long" || enter.Order["side"] != "buy" || enter.Values["quantity"] == nil {
+		t.Errorf("enter rule = %+v", enter)
+	}
+	if _, ok := enter.Order["quantity"]; ok {
+		t.Errorf("quantity left in fixed params: %+v", enter.Order)
+	}
+	if got := r.Lookback(); got != 3 {
+		t.Errorf("Lookback() = %d, want 3", got)
+	}
+}
+
+// TestParseRulesErrors verifies invalid rules files are rejected with the offending rule named.
+func TestParseRulesErrors(t *testing.T) {
+	tests := map[string]string{
+		"rules: []":                                             "at least one rule",
+		"rules:\n  - order: {side: buy}":                        "when is required",
+		"rules:\n  - when: close > 1":                           "order is required",
+		"rules:\n  - when: close > foo\n    order: {side: buy}": `unknown field "foo"`,
+		"rules:\n  - when: close > 1\n    order: {side: buy, quantity: 'cash >'}": "invalid quantity",
+		"rule:\n  - when: close > 1": "field rule not found",
+	}
+	for input, want := range tests {
+		if _, err := ParseRules([]byte(input)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseRules(%q) error = %v, want %q", input, err, want)
+		}
+	}
+}
+
+// TestRulesRun verifies rules trade on crosses, size orders from expressions, and log rejections.
+func TestRulesRun(t *testing.T) {
+	r, err := ParseRules([]byte(crossRules))
+	if err != nil {
+		t.Fatalf("ParseRules() error: %v", err)
+	}
+	cfg := Config{
+		Symbols: []string{"AAPL"},
+		Bars: map[string][]bars.Bar{"AAPL": {
+			bar(1, 100, 100, 100, 100),
+			bar(2, 100, 100, 98, 98),
+			bar(5, 98, 101, 98, 101),   // close crosses above the average: buy
+			bar(6, 100, 102, 99, 102),  // bought at the 100 open
+			bar(7, 102, 102, 95, 96),   // below the average: sell limit at 96.10
+			bar(8, 96, 97, 95.5, 96.5), // sold at 96.10
+		}},
+		Start:      day(2),
+		Cash:       r.Cash,
+		Commission: *r.Commission,
+	}
+	res, err := Run(cfg, r)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if len(res.Fills) != 2 || !near(res.Fills[0].Price, 100) || res.Fills[0].Quantity != 24 || !near(res.Fills[1].Price, 96.1) {
+		t.Fatalf("Fills = %+v, want buy 24 at 100 and sell at 96.10", res.Fills)
+	}
+	if len(res.Trades) != 1 || res.Trades[0].Tag != "enter-long" || !near(res.Trades[0].PnL, 24*(96.1-100)-2) {
+		t.Errorf("Trades = %+v", res.Trades)
+	}
+
+	// A rule limited to other symbols never fires
+	r.Rules[0].Symbols = []string{"MSFT"}
+	res, err = Run(cfg, r)
+	if err != nil || len(res.Fills) != 0 {
+		t.Errorf("Run() with the entry limited to MSFT = %+v, %v, want no fills", res.Fills, err)
+	}
+}
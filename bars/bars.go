@@ -0,0 +1,149 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package bars
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// Bar is one OHLCV price bar. VWAP is only set when the source provides it.
+type Bar struct {
+	Time   time.Time `json:"time"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume float64   `json:"volume"`
+	VWAP   float64   `json:"vwap,omitempty"`
+}
+
+// historyDay is a daily, weekly, or monthly bar as returned by the history endpoint.
+type historyDay struct {
+	Date   string  `json:"date"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+}
+
+// timeSalesPoint is an intraday bar or tick as returned by the timesales endpoint.
+// Tick data only carries a price and volume.
+type timeSalesPoint struct {
+	Time   string  `json:"time"`
+	Price  float64 `json:"price"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+	VWAP   float64 `json:"vwap"`
+}
+
+// ParseHistory decodes a historical pricing response into bars dated at midnight Eastern.
+func ParseHistory(data []byte) ([]Bar, error) {
+	var resp struct {
+		History *struct {
+			Day json.RawMessage `json:"day"`
+		} `json:"history"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse historical pricing: %w", err)
+	}
+	if resp.History == nil {
+		return nil, nil
+	}
+
+	var days []historyDay
+	if err := unmarshalList(resp.History.Day, &days); err != nil {
+		return nil, fmt.Errorf("unable to parse historical pricing: %w", err)
+	}
+
+	out := make([]Bar, 0, len(days))
+	for _, d := range days {
+		t, err := time.ParseInLocation("2006-01-02", d.Date, pricing.Eastern())
+		if err != nil {
+			return nil, fmt.Errorf("invalid bar date %q", d.Date)
+		}
+		out = append(out, Bar{Time: t, Open: d.Open, High: d.High, Low: d.Low, Close: d.Close, Volume: d.Volume})
+	}
+	return out, nil
+}
+
+// ParseTimeSales decodes a time and sales response into bars timestamped in Eastern time.
+// Ticks become bars whose open, high, low, and close are all the trade price.
+func ParseTimeSales(data []byte) ([]Bar, error) {
+	var resp struct {
+		Series *struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"series"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse time and sales: %w", err)
+	}
+	if resp.Series == nil {
+		return nil, nil
+	}
+
+	var points []timeSalesPoint
+	if err := unmarshalList(resp.Series.Data, &points); err != nil {
+		return nil, fmt.Errorf("unable to parse time and sales: %w", err)
+	}
+
+	out := make([]Bar, 0, len(points))
+	for _, p := range points {
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", p.Time, pricing.Eastern())
+		if err != nil {
+			return nil, fmt.Errorf("invalid bar time %q", p.Time)
+		}
+		b := Bar{Time: t, Open: p.Open, High: p.High, Low: p.Low, Close: p.Close, Volume: p.Volume, VWAP: p.VWAP}
+		if b.Close == 0 && p.Price != 0 {
+			b.Open, b.High, b.Low, b.Close = p.Price, p.Price, p.Price, p.Price
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// Closes returns the closing price of every bar.
+func Closes(bars []Bar) []float64 {
+	out := make([]float64, len(bars))
+	for i, b := range bars {
+		out[i] = b.Close
+	}
+	return out
+}
+
+// Intraday reports whether the bars are finer than one per day.
+func Intraday(bars []Bar) bool {
+	for i := 1; i < len(bars); i++ {
+		if sameDay(bars[i-1].Time, bars[i].Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameDay reports whether two times fall on the same calendar date.
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// unmarshalList decodes a JSON array, a single object, or null into a slice.
+// Tradier returns a bare object instead of an array when there is exactly one element.
+func unmarshalList(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	if raw[0] != '[' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package bars
+
+import (
+	"testing"
+)
+
+// TestParseHistory verifies daily bars, a single-object response, and a null history.
+func TestParseHistory(t *testing.T) {
+	got, err := ParseHistory([]byte(`{"history":{"day":[
+		{"date":"2026-10-15","open":100,"high":102,"low":99,"close":101,"volume":1000},
+		{"date":"2026-10-16","open":101,"high":103,"low":100.5,"close":102.5,"volume":1200}]}}`))
+	if err != nil {
+		t.Fatalf("ParseHistory() error: %v", err)
+	}
+	if len(got) != 2 || got[1].Close != 102.5 || got[1].Time.Day() != 16 || got[0].Volume != 1000 {
+		t.Errorf("ParseHistory() = %+v", got)
+	}
+
+	single, err := ParseHistory([]byte(`{"history":{"day":{"date":"2026-10-15","open":1,"high":1,"low":1,"close":1,"volume":5}}}`))
+	if err != nil || len(single) != 1 {
+		t.Errorf("ParseHistory(single) = %v, %v", single, err)
+	}
+	empty, err := ParseHistory([]byte(`{"history":null}`))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("ParseHistory(null) = %v, %v", empty, err)
+	}
+}
+
+// TestParseTimeSales verifies intraday bars keep their VWAP and ticks fill OHLC from the price.
+func TestParseTimeSales(t *testing.T) {
+	got, err := ParseTimeSales([]byte(`{"series":{"data":[
+		{"time":"2026-10-16T09:30:00","timestamp":1760621400,"price":100.1,"open":100,"high":100.5,"low":99.8,"close":100.2,"volume":5000,"vwap":100.15},
+		{"time":"2026-10-16T09:31:00","timestamp":1760621460,"price":100.4,"volume":300}]}}`))
+	if err != nil {
+		t.Fatalf("ParseTimeSales() error: %v", err)
+	}
+	if len(got) != 2 || got[0].VWAP != 100.15 || got[0].Time.Hour() != 9 || got[0].Time.Minute() != 30 {
+		t.Errorf("bar = %+v", got[0])
+	}
+	if tick := got[1]; tick.Open != 100.4 || tick.High != 100.4 || tick.Low != 100.4 || tick.Close != 100.4 {
+		t.Errorf("tick = %+v", tick)
+	}
+	if !Intraday(got) {
+		t.Error("Intraday() = false, want true")
+	}
+	if _, err := ParseTimeSales([]byte(`{"series":{"data":{"time":"bad"}}}`)); err == nil {
+		t.Error("expected error for invalid time")
+	}
+}
+
+// TestCloses verifies closing prices are extracted in order.
+func TestCloses(t *testing.T) {
+	got := Closes([]Bar{{Close: 1}, {Close: 2}})
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Closes() = %v", got)
+	}
+}
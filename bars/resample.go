@@ -0,0 +1,105 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package bars
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/calendar"
+)
+
+// ParseInterval parses a resampling interval such as "2min", "30min", "1h", "4h", or any Go
+// duration like "90m".
+func ParseInterval(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	var d time.Duration
+	var err error
+	switch {
+	case strings.HasSuffix(s, "min"):
+		var n int
+		n, err = strconv.Atoi(strings.TrimSuffix(s, "min"))
+		d = time.Duration(n) * time.Minute
+	case strings.HasSuffix(s, "hour"):
+		var n int
+		n, err = strconv.Atoi(strings.TrimSuffix(s, "hour"))
+		d = time.Duration(n) * time.Hour
+	default:
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval %q (e.g. 2min, 30min, 1h, 4h)", s)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid interval %q: must be positive", s)
+	}
+	return d, nil
+}
+
+// Resample aggregates bars into buckets of the given width. Buckets are aligned to the start of
+// the session containing each bar and cut off at the session end, so 1h bars start at 09:30 and
+// an early close ends the last bucket early. Bars outside every session align to midnight Eastern.
+//
+// Each bucket takes the first open, highest high, lowest low, last close, and summed volume of its
+// bars, timestamped at the bucket start. VWAP is volume-weighted from each source bar's VWAP, or its
+// typical price when the source has none. Empty buckets are skipped.
+func Resample(b []Bar, width time.Duration, sessions []calendar.Session) []Bar {
+	if width <= 0 {
+		return b
+	}
+
+	var out []Bar
+	var pv float64
+	var bucketEnd time.Time
+	si := 0
+	for _, bar := range b {
+		if len(out) > 0 && bar.Time.Before(bucketEnd) && !bar.Time.Before(out[len(out)-1].Time) {
+			cur := &out[len(out)-1]
+			if bar.High > cur.High {
+				cur.High = bar.High
+			}
+			if bar.Low < cur.Low {
+				cur.Low = bar.Low
+			}
+			cur.Close = bar.Close
+			cur.Volume += bar.Volume
+			pv += barPrice(bar) * bar.Volume
+			if cur.Volume > 0 {
+				cur.VWAP = pv / cur.Volume
+			}
+			continue
+		}
+
+		// Find the session containing the bar, assuming both are in time order
+		for si < len(sessions) && !bar.Time.Before(sessions[si].End) {
+			si++
+		}
+		anchor := calendar.Date(bar.Time)
+		limit := anchor.AddDate(0, 0, 1)
+		if si < len(sessions) && !bar.Time.Before(sessions[si].Start) {
+			anchor, limit = sessions[si].Start, sessions[si].End
+		}
+
+		start := anchor.Add(bar.Time.Sub(anchor) / width * width)
+		bucketEnd = start.Add(width)
+		if bucketEnd.After(limit) {
+			bucketEnd = limit
+		}
+		pv = barPrice(bar) * bar.Volume
+		next := Bar{Time: start, Open: bar.Open, High: bar.High, Low: bar.Low, Close: bar.Close, Volume: bar.Volume, VWAP: barPrice(bar)}
+		out = append(out, next)
+	}
+	return out
+}
+
+// barPrice returns the price used to weight a bar in VWAP: its own VWAP if present, otherwise
+// the typical price (high+low+close)/3.
+func barPrice(b Bar) float64 {
+	if b.VWAP > 0 {
+		return b.VWAP
+	}
+	return (b.High + b.Low + b.Close) / 3
+}
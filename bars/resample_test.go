@@ -0,0 +1,95 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package bars
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// calendarJSON has a regular day, an early close, and a holiday.
+const calendarJSON = `{"calendar":{"month":11,"year":2026,"days":{"day":[
+	{"date":"2026-11-25","status":"open","premarket":{"start":"07:00","end":"09:24"},"open":{"start":"09:30","end":"16:00"},"postmarket":{"start":"16:00","end":"19:55"}},
+	{"date":"2026-11-26","status":"closed","description":"Market is closed for Thanksgiving Day"},
+	{"date":"2026-11-27","status":"open","open":{"start":"09:30","end":"13:00"}}]}}}`
+
+// at returns the Eastern time on the given November 2026 day.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 11, day, hour, minute, 0, 0, pricing.Eastern())
+}
+
+// TestParseInterval verifies the supported interval spellings.
+func TestParseInterval(t *testing.T) {
+	tests := map[string]time.Duration{
+		"2min":  2 * time.Minute,
+		"30min": 30 * time.Minute,
+		"1h":    time.Hour,
+		"4hour": 4 * time.Hour,
+		"90m":   90 * time.Minute,
+	}
+	for in, want := range tests {
+		if got, err := ParseInterval(in); err != nil || got != want {
+			t.Errorf("ParseInterval(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "xmin", "0min", "-1h", "daily"} {
+		if _, err := ParseInterval(bad); err == nil {
+			t.Errorf("ParseInterval(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+// TestResample verifies OHLCV aggregation, VWAP weighting, and session-aligned buckets.
+func TestResample(t *testing.T) {
+	days, _ := calendar.ParseMonth([]byte(calendarJSON))
+	var sessions []calendar.Session
+	for _, d := range days {
+		sessions = append(sessions, d.Sessions()...)
+	}
+	b := []Bar{
+		{Time: at(25, 9, 30), Open: 10, High: 11, Low: 9, Close: 10.5, Volume: 100, VWAP: 10},
+		{Time: at(25, 10, 15), Open: 10.5, High: 12, Low: 10, Close: 11, Volume: 300, VWAP: 11},
+		{Time: at(25, 10, 30), Open: 11, High: 11.5, Low: 10.8, Close: 11.2, Volume: 50, VWAP: 11.1},
+		{Time: at(27, 12, 45), Open: 20, High: 21, Low: 19, Close: 20, Volume: 10},
+	}
+	got := Resample(b, time.Hour, sessions)
+	if len(got) != 3 {
+		t.Fatalf("Resample() = %d bars, want 3: %+v", len(got), got)
+	}
+
+	// 09:30 and 10:15 share the 09:30-10:30 bucket; 10:30 starts the next
+	first := got[0]
+	if !first.Time.Equal(at(25, 9, 30)) || first.Open != 10 || first.High != 12 || first.Low != 9 ||
+		first.Close != 11 || first.Volume != 400 {
+		t.Errorf("first bucket = %+v", first)
+	}
+	if math.Abs(first.VWAP-10.75) > 1e-9 {
+		t.Errorf("first bucket VWAP = %v, want 10.75", first.VWAP)
+	}
+	if !got[1].Time.Equal(at(25, 10, 30)) {
+		t.Errorf("second bucket time = %v, want 10:30", got[1].Time)
+	}
+
+	// Without a VWAP the typical price is used
+	if !got[2].Time.Equal(at(27, 12, 30)) || got[2].VWAP != 20 {
+		t.Errorf("early close bucket = %+v", got[2])
+	}
+}
+
+// TestResampleWithoutSessions verifies bars align to midnight when no calendar is available.
+func TestResampleWithoutSessions(t *testing.T) {
+	b := []Bar{
+		{Time: at(25, 9, 30), Open: 1, High: 1, Low: 1, Close: 1, Volume: 1},
+		{Time: at(25, 9, 31), Open: 2, High: 2, Low: 2, Close: 2, Volume: 1},
+		{Time: at(25, 9, 32), Open: 3, High: 3, Low: 3, Close: 3, Volume: 1},
+	}
+	got := Resample(b, 2*time.Minute, nil)
+	if len(got) != 2 || !got[0].Time.Equal(at(25, 9, 30)) || got[0].Close != 2 || got[1].Open != 3 {
+		t.Errorf("Resample(no sessions) = %+v", got)
+	}
+}
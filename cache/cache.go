@@ -0,0 +1,355 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cache
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+const (
+	// cacheDir is the directory name under the user cache directory for tradier data.
+	cacheDir = "tradier"
+
+	// dayLayout is the date format used for coverage ranges and calendar files.
+	dayLayout = "2006-01-02"
+
+	// settleHour is the Eastern hour after which a day's bars, including post-market, are final.
+	settleHour = 20
+
+	// calendarTTL is how long the calendar for the current or a future month is trusted.
+	calendarTTL = 24 * time.Hour
+)
+
+// Store is an on-disk cache of price bars and market calendars. Bars are kept in one CSV file
+// per symbol and interval, alongside a JSON file recording which days have been fetched.
+type Store struct {
+	Dir string
+}
+
+// Key identifies one cached bar series. Filter is the timesales session filter, if any.
+type Key struct {
+	Symbol   string
+	Interval string
+	Filter   string
+}
+
+// Fetcher retrieves the bars for every day from one date through another, inclusive.
+type Fetcher func(from, to time.Time) ([]bars.Bar, error)
+
+// TradingDays reports whether the market is open on any day from one date through another.
+type TradingDays func(from, to time.Time) (bool, error)
+
+// dayRange is an inclusive range of dates.
+type dayRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// coverage is the on-disk record of the day ranges already fetched for a series.
+type coverage struct {
+	Ranges [][2]string `json:"ranges"`
+}
+
+// DefaultDir returns the tradier directory under the user cache directory, which honours
+// XDG_CACHE_HOME on Linux.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine cache directory: %w", err)
+	}
+	return filepath.Join(dir, cacheDir), nil
+}
+
+// New returns a store rooted at dir.
+func New(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// Day returns the date of t as midnight Eastern.
+func Day(t time.Time) time.Time {
+	t = t.In(pricing.Eastern())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, pricing.Eastern())
+}
+
+// Bars returns the cached bars for key from one date through another, first fetching any days not
+// yet in the cache. Missing ranges without a trading day are never fetched. Days are only recorded
+// as fetched once they have settled, so today's partial data is fetched again on the next call.
+func (s *Store) Bars(key Key, from, to, now time.Time, fetch Fetcher, open TradingDays) ([]bars.Bar, error) {
+	from, to = Day(from), Day(to)
+	if today := Day(now); to.After(today) {
+		to = today
+	}
+	if to.Before(from) {
+		return nil, nil
+	}
+
+	cached, err := s.load(key)
+	if err != nil {
+		return nil, err
+	}
+	covered, err := s.loadCoverage(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anything on or after the last unsettled day is always refetched
+	settled := Day(now)
+	if now.In(pricing.Eastern()).Hour() < settleHour {
+		settled = settled.AddDate(0, 0, -1)
+	}
+
+	changed := false
+	for _, gap := range subtract(dayRange{from, to}, covered) {
+		if open != nil {
+			trading, err := open(gap.From, gap.To)
+			if err != nil {
+				return nil, err
+			}
+			if !trading {
+				covered = addRange(covered, gap, settled)
+				changed = true
+				continue
+			}
+		}
+
+		fetched, err := fetch(gap.From, gap.To)
+		if err != nil {
+			return nil, err
+		}
+		cached = merge(cached, fetched)
+		covered = addRange(covered, gap, settled)
+		changed = true
+	}
+
+	if changed {
+		if err := s.save(key, cached, covered); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []bars.Bar
+	for _, b := range cached {
+		if d := Day(b.Time); !d.Before(from) && !d.After(to) {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+// Calendar returns the raw market calendar response for a month, fetching it when it is not
+// cached. Past months never change; the current and future months are refreshed daily.
+func (s *Store) Calendar(year int, month time.Month, now time.Time, fetch func() ([]byte, error)) ([]byte, error) {
+	path := filepath.Join(s.Dir, "calendar", fmt.Sprintf("%04d-%02d.json", year, month))
+	if info, err := os.Stat(path); err == nil {
+		past := time.Date(year, month+1, 1, 0, 0, 0, 0, pricing.Eastern()).Before(now)
+		if past || now.Sub(info.ModTime()) < calendarTTL {
+			if data, err := os.ReadFile(path); err == nil {
+				return data, nil
+			}
+		}
+	}
+
+	data, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(path, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Clear removes every cached file.
+func (s *Store) Clear() error {
+	return os.RemoveAll(s.Dir)
+}
+
+// seriesPath returns the path to a series file with the given extension.
+func (s *Store) seriesPath(key Key, ext string) string {
+	name := key.Interval
+	if key.Filter != "" {
+		name += "-" + key.Filter
+	}
+	return filepath.Join(s.Dir, "bars", strings.ToUpper(key.Symbol), name+ext)
+}
+
+// load reads the cached bars for a series, returning none if it has not been cached.
+func (s *Store) load(key Key) ([]bars.Bar, error) {
+	f, err := os.Open(s.seriesPath(key, ".csv"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cache: %w", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cache file %s: %w", f.Name(), err)
+	}
+	out := make([]bars.Bar, 0, len(records))
+	for i, r := range records {
+		if i == 0 || len(r) != 7 {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, r[0])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse cache file %s: %w", f.Name(), err)
+		}
+		b := bars.Bar{Time: t.In(pricing.Eastern())}
+		for j, dst := range []*float64{&b.Open, &b.High, &b.Low, &b.Close, &b.Volume, &b.VWAP} {
+			*dst, _ = strconv.ParseFloat(r[j+1], 64)
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// loadCoverage reads the fetched day ranges for a series.
+func (s *Store) loadCoverage(key Key) ([]dayRange, error) {
+	data, err := os.ReadFile(s.seriesPath(key, ".json"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cache: %w", err)
+	}
+	var c coverage
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("unable to parse cache coverage: %w", err)
+	}
+	out := make([]dayRange, 0, len(c.Ranges))
+	for _, r := range c.Ranges {
+		from, err1 := time.ParseInLocation(dayLayout, r[0], pricing.Eastern())
+		to, err2 := time.ParseInLocation(dayLayout, r[1], pricing.Eastern())
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid cache coverage range %v", r)
+		}
+		out = append(out, dayRange{from, to})
+	}
+	return out, nil
+}
+
+// save writes the bars and coverage for a series.
+func (s *Store) save(key Key, b []bars.Bar, covered []dayRange) error {
+	var buf strings.Builder
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"time", "open", "high", "low", "close", "volume", "vwap"})
+	for _, bar := range b {
+		record := []string{bar.Time.Format(time.RFC3339)}
+		for _, v := range []float64{bar.Open, bar.High, bar.Low, bar.Close, bar.Volume, bar.VWAP} {
+			record = append(record, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	if err := writeFile(s.seriesPath(key, ".csv"), []byte(buf.String())); err != nil {
+		return err
+	}
+
+	var c coverage
+	for _, r := range covered {
+		c.Ranges = append(c.Ranges, [2]string{r.From.Format(dayLayout), r.To.Format(dayLayout)})
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(s.seriesPath(key, ".json"), data)
+}
+
+// writeFile writes data atomically by renaming a temporary file into place.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create cache directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("unable to write cache file: %w", err)
+	}
+	return nil
+}
+
+// merge combines cached and newly fetched bars in time order. Fetched bars replace cached bars
+// with the same timestamp, so a refetched partial day is overwritten.
+func merge(cached, fetched []bars.Bar) []bars.Bar {
+	byTime := make(map[int64]bars.Bar, len(cached)+len(fetched))
+	for _, b := range cached {
+		byTime[b.Time.Unix()] = b
+	}
+	for _, b := range fetched {
+		byTime[b.Time.Unix()] = b
+	}
+	out := make([]bars.Bar, 0, len(byTime))
+	for _, b := range byTime {
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out
+}
+
+// subtract returns the parts of r not covered by any of the ranges.
+func subtract(r dayRange, covered []dayRange) []dayRange {
+	gaps := []dayRange{r}
+	for _, c := range covered {
+		var next []dayRange
+		for _, g := range gaps {
+			if c.To.Before(g.From) || c.From.After(g.To) {
+				next = append(next, g)
+				continue
+			}
+			if c.From.After(g.From) {
+				next = append(next, dayRange{g.From, c.From.AddDate(0, 0, -1)})
+			}
+			if c.To.Before(g.To) {
+				next = append(next, dayRange{c.To.AddDate(0, 0, 1), g.To})
+			}
+		}
+		gaps = next
+	}
+	return gaps
+}
+
+// addRange records r as covered up to the last settled day, merging overlapping and adjacent ranges.
+func addRange(covered []dayRange, r dayRange, settled time.Time) []dayRange {
+	if r.To.After(settled) {
+		r.To = settled
+	}
+	if r.To.Before(r.From) {
+		return covered
+	}
+
+	all := append(append([]dayRange(nil), covered...), r)
+	sort.Slice(all, func(i, j int) bool { return all[i].From.Before(all[j].From) })
+	out := []dayRange{all[0]}
+	for _, c := range all[1:] {
+		last := &out[len(out)-1]
+		if !c.From.After(last.To.AddDate(0, 0, 1)) {
+			if c.To.After(last.To) {
+				last.To = c.To
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
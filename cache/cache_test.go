@@ -0,0 +1,174 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// date returns midnight Eastern on the given October 2026 day.
+func date(day int) time.Time {
+	return time.Date(2026, 10, day, 0, 0, 0, 0, pricing.Eastern())
+}
+
+// fakeFetcher returns one daily bar per weekday in the range and records each call.
+type fakeFetcher struct {
+	calls []string
+}
+
+// fetch implements Fetcher.
+func (f *fakeFetcher) fetch(from, to time.Time) ([]bars.Bar, error) {
+	f.calls = append(f.calls, from.Format(dayLayout)+".."+to.Format(dayLayout))
+	var out []bars.Bar
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			out = append(out, bars.Bar{Time: d, Open: 1, High: 2, Low: 0.5, Close: float64(d.Day()), Volume: 100})
+		}
+	}
+	return out, nil
+}
+
+// weekdays is a TradingDays that treats every weekday as open.
+func weekdays(from, to time.Time) (bool, error) {
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// TestBarsFetchesOnlyMissingRanges verifies cached days are served from disk and only gaps are fetched.
+func TestBarsFetchesOnlyMissingRanges(t *testing.T) {
+	s := New(t.TempDir())
+	key := Key{Symbol: "aapl", Interval: "daily"}
+	now := date(30).Add(22 * time.Hour)
+	f := &fakeFetcher{}
+
+	got, err := s.Bars(key, date(5), date(9), now, f.fetch, weekdays)
+	if err != nil {
+		t.Fatalf("Bars() error: %v", err)
+	}
+	if len(got) != 5 || len(f.calls) != 1 {
+		t.Fatalf("Bars() = %d bars, %v calls", len(got), f.calls)
+	}
+
+	// A fully cached range makes no requests
+	if _, err := s.Bars(key, date(6), date(8), now, f.fetch, weekdays); err != nil || len(f.calls) != 1 {
+		t.Errorf("cached Bars() calls = %v, err = %v", f.calls, err)
+	}
+
+	// An overlapping range only fetches the days on either side
+	got, err = s.Bars(key, date(1), date(14), now, f.fetch, weekdays)
+	if err != nil {
+		t.Fatalf("Bars() error: %v", err)
+	}
+	want := []string{"2026-10-05..2026-10-09", "2026-10-01..2026-10-04", "2026-10-10..2026-10-14"}
+	if fmt.Sprint(f.calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", f.calls, want)
+	}
+	if len(got) != 10 || got[0].Close != 1 || got[9].Close != 14 {
+		t.Errorf("Bars() = %d bars from %v to %v", len(got), got[0].Close, got[len(got)-1].Close)
+	}
+}
+
+// TestBarsSkipsClosedDays verifies a weekend gap is recorded without a request.
+func TestBarsSkipsClosedDays(t *testing.T) {
+	s := New(t.TempDir())
+	key := Key{Symbol: "SPY", Interval: "5min", Filter: "open"}
+	f := &fakeFetcher{}
+	if _, err := s.Bars(key, date(17), date(18), date(30), f.fetch, weekdays); err != nil {
+		t.Fatalf("Bars() error: %v", err)
+	}
+	if _, err := s.Bars(key, date(17), date(18), date(30), f.fetch, weekdays); err != nil {
+		t.Fatalf("Bars() error: %v", err)
+	}
+	if len(f.calls) != 0 {
+		t.Errorf("calls = %v, want none for a weekend", f.calls)
+	}
+}
+
+// TestBarsRefetchesUnsettledDays verifies today is fetched again until the session has settled.
+func TestBarsRefetchesUnsettledDays(t *testing.T) {
+	s := New(t.TempDir())
+	key := Key{Symbol: "AAPL", Interval: "daily"}
+	f := &fakeFetcher{}
+	midday := date(16).Add(12 * time.Hour)
+
+	for i := 0; i < 2; i++ {
+		got, err := s.Bars(key, date(15), date(20), midday, f.fetch, weekdays)
+		if err != nil {
+			t.Fatalf("Bars() error: %v", err)
+		}
+		if len(got) != 2 {
+			t.Errorf("Bars() = %d bars, want 2 (end clamped to today)", len(got))
+		}
+	}
+	want := []string{"2026-10-15..2026-10-16", "2026-10-16..2026-10-16"}
+	if fmt.Sprint(f.calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", f.calls, want)
+	}
+}
+
+// TestBarsRoundTrip verifies bars read back from disk match what was fetched.
+func TestBarsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	key := Key{Symbol: "AAPL", Interval: "1min"}
+	at := date(15).Add(9*time.Hour + 30*time.Minute)
+	fetch := func(from, to time.Time) ([]bars.Bar, error) {
+		return []bars.Bar{{Time: at, Open: 1.25, High: 1.5, Low: 1, Close: 1.375, Volume: 42, VWAP: 1.3}}, nil
+	}
+	if _, err := New(dir).Bars(key, date(15), date(15), date(30), fetch, nil); err != nil {
+		t.Fatalf("Bars() error: %v", err)
+	}
+
+	got, err := New(dir).Bars(key, date(15), date(15), date(30), nil, nil)
+	if err != nil || len(got) != 1 {
+		t.Fatalf("Bars() = %v, %v", got, err)
+	}
+	if b := got[0]; !b.Time.Equal(at) || b.Close != 1.375 || b.VWAP != 1.3 || b.Volume != 42 {
+		t.Errorf("round trip bar = %+v", b)
+	}
+}
+
+// TestCalendar verifies past months are cached and only fetched once.
+func TestCalendar(t *testing.T) {
+	s := New(t.TempDir())
+	calls := 0
+	fetch := func() ([]byte, error) {
+		calls++
+		return []byte(`{"calendar":{}}`), nil
+	}
+	now := date(30)
+	for i := 0; i < 2; i++ {
+		data, err := s.Calendar(2026, time.September, now, fetch)
+		if err != nil || string(data) != `{"calendar":{}}` {
+			t.Fatalf("Calendar() = %s, %v", data, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+// TestSubtractAndAddRange verifies range arithmetic, including merging adjacent ranges.
+func TestSubtractAndAddRange(t *testing.T) {
+	gaps := subtract(dayRange{date(1), date(10)}, []dayRange{{date(3), date(4)}, {date(8), date(12)}})
+	if len(gaps) != 2 || !gaps[0].To.Equal(date(2)) || !gaps[1].From.Equal(date(5)) || !gaps[1].To.Equal(date(7)) {
+		t.Errorf("subtract() = %v", gaps)
+	}
+
+	covered := addRange([]dayRange{{date(1), date(3)}}, dayRange{date(4), date(6)}, date(30))
+	if len(covered) != 1 || !covered[0].To.Equal(date(6)) {
+		t.Errorf("addRange(adjacent) = %v", covered)
+	}
+	if got := addRange(nil, dayRange{date(10), date(12)}, date(9)); len(got) != 0 {
+		t.Errorf("addRange(unsettled) = %v, want none", got)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// maxSearchDays bounds how far NextOpen and NextClose look ahead. The longest US market closure
+// in modern times is a long holiday weekend, so two weeks without a session means the calendar
+// has no data for the period.
+const maxSearchDays = 14
+
+// regularClose is the normal end of the regular session; an earlier close is a half day.
+const regularClose = 16 * time.Hour
+
+// Phase names the part of the trading day a moment falls in.
+type Phase string
+
+const (
+	// PhaseClosed is outside every session, including weekends and holidays.
+	PhaseClosed Phase = "closed"
+
+	// PhasePremarket is the extended-hours session before the open.
+	PhasePremarket Phase = "premarket"
+
+	// PhaseOpen is the regular session.
+	PhaseOpen Phase = "open"
+
+	// PhasePostmarket is the extended-hours session after the close.
+	PhasePostmarket Phase = "postmarket"
+)
+
+// Session is one contiguous trading window, such as the regular session or pre-market on a day.
+type Session struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IsZero reports whether the session is absent.
+func (s Session) IsZero() bool {
+	return s.Start.IsZero()
+}
+
+// Contains reports whether t falls within the session, inclusive of the start only.
+func (s Session) Contains(t time.Time) bool {
+	return !s.IsZero() && !t.Before(s.Start) && t.Before(s.End)
+}
+
+// Day is one date on the market calendar. Sessions are zero on closed days.
+type Day struct {
+	Date        time.Time
+	Open        bool
+	Description string
+	Premarket   Session
+	Regular     Session
+	Postmarket  Session
+}
+
+// Sessions returns the day's pre-market, regular, and post-market sessions that are present.
+func (d Day) Sessions() []Session {
+	var out []Session
+	for _, s := range []Session{d.Premarket, d.Regular, d.Postmarket} {
+		if !s.IsZero() {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// IsHalfDay reports whether the day is open but the regular session closes early.
+func (d Day) IsHalfDay() bool {
+	return d.Open && !d.Regular.IsZero() && d.Regular.End.Sub(d.Date) < regularClose
+}
+
+// Phase returns the part of the day t falls in.
+func (d Day) Phase(t time.Time) Phase {
+	switch {
+	case d.Regular.Contains(t):
+		return PhaseOpen
+	case d.Premarket.Contains(t):
+		return PhasePremarket
+	case d.Postmarket.Contains(t):
+		return PhasePostmarket
+	}
+	return PhaseClosed
+}
+
+// calendarDay is one day of the market calendar response.
+type calendarDay struct {
+	Date        string       `json:"date"`
+	Status      string       `json:"status"`
+	Description string       `json:"description"`
+	Premarket   *sessionTime `json:"premarket"`
+	Open        *sessionTime `json:"open"`
+	Postmarket  *sessionTime `json:"postmarket"`
+}
+
+// sessionTime is a start and end time of day in Eastern time, e.g. "09:30" and "16:00".
+type sessionTime struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ParseMonth decodes a market calendar response (the GetCalendar shape) into days in date order.
+func ParseMonth(data []byte) ([]Day, error) {
+	var resp struct {
+		Calendar *struct {
+			Days *struct {
+				Day json.RawMessage `json:"day"`
+			} `json:"days"`
+		} `json:"calendar"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse market calendar: %w", err)
+	}
+	if resp.Calendar == nil || resp.Calendar.Days == nil || len(resp.Calendar.Days.Day) == 0 {
+		return nil, nil
+	}
+
+	// A month with a single day is returned as an object rather than an array
+	raw := resp.Calendar.Days.Day
+	var days []calendarDay
+	if raw[0] == '{' {
+		var one calendarDay
+		if err := json.Unmarshal(raw, &one); err != nil {
+			return nil, fmt.Errorf("unable to parse market calendar: %w", err)
+		}
+		days = append(days, one)
+	} else if err := json.Unmarshal(raw, &days); err != nil {
+		return nil, fmt.Errorf("unable to parse market calendar: %w", err)
+	}
+
+	out := make([]Day, 0, len(days))
+	for _, d := range days {
+		date, err := time.ParseInLocation("2006-01-02", d.Date, pricing.Eastern())
+		if err != nil {
+			continue
+		}
+		day := Day{Date: date, Open: d.Status == "open", Description: d.Description}
+		if day.Open {
+			day.Premarket = parseSession(d.Date, d.Premarket)
+			day.Regular = parseSession(d.Date, d.Open)
+			day.Postmarket = parseSession(d.Date, d.Postmarket)
+		}
+		out = append(out, day)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out, nil
+}
+
+// parseSession converts a day's start and end times to a session, or a zero session if absent or invalid.
+func parseSession(date string, st *sessionTime) Session {
+	if st == nil {
+		return Session{}
+	}
+	start, err1 := time.ParseInLocation("2006-01-02 15:04", date+" "+st.Start, pricing.Eastern())
+	end, err2 := time.ParseInLocation("2006-01-02 15:04", date+" "+st.End, pricing.Eastern())
+	if err1 != nil || err2 != nil || !end.After(start) {
+		return Session{}
+	}
+	return Session{Start: start, End: end}
+}
+
+// Source returns the raw market calendar response for a month, e.g. from GetCalendar.
+type Source func(year int, month time.Month) ([]byte, error)
+
+// Calendar answers trading-hours questions in America/New_York time. Each month is fetched
+// from the source once and kept in memory, so a Calendar may be queried freely and shared
+// between goroutines.
+type Calendar struct {
+	source Source
+
+	mu     sync.Mutex
+	months map[string]map[string]Day
+}
+
+// New returns a calendar that loads months from source.
+func New(source Source) *Calendar {
+	return &Calendar{source: source, months: map[string]map[string]Day{}}
+}
+
+// Day returns the calendar entry for t's date in Eastern time. Dates missing from the calendar
+// are reported as closed.
+func (c *Calendar) Day(t time.Time) (Day, error) {
+	date := Date(t)
+	key := date.Format("2006-01")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	month, ok := c.months[key]
+	if !ok {
+		data, err := c.source(date.Year(), date.Month())
+		if err != nil {
+			return Day{}, err
+		}
+		days, err := ParseMonth(data)
+		if err != nil {
+			return Day{}, err
+		}
+		month = map[string]Day{}
+		for _, d := range days {
+			month[d.Date.Format("2006-01-02")] = d
+		}
+		c.months[key] = month
+	}
+	if d, ok := month[date.Format("2006-01-02")]; ok {
+		return d, nil
+	}
+	return Day{Date: date}, nil
+}
+
+// Days returns every calendar day from from's date through to's date inclusive.
+func (c *Calendar) Days(from, to time.Time) ([]Day, error) {
+	var out []Day
+	for d := Date(from); !d.After(Date(to)); d = d.AddDate(0, 0, 1) {
+		day, err := c.Day(d)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, day)
+	}
+	return out, nil
+}
+
+// Sessions returns the pre-market, regular, and post-market sessions of every open day from
+// from's date through to's date, in time order.
+func (c *Calendar) Sessions(from, to time.Time) ([]Session, error) {
+	days, err := c.Days(from, to)
+	if err != nil {
+		return nil, err
+	}
+	var out []Session
+	for _, d := range days {
+		out = append(out, d.Sessions()...)
+	}
+	return out, nil
+}
+
+// Phase returns the part of the trading day t falls in.
+func (c *Calendar) Phase(t time.Time) (Phase, error) {
+	day, err := c.Day(t)
+	if err != nil {
+		return PhaseClosed, err
+	}
+	return day.Phase(t), nil
+}
+
+// IsOpen reports whether the regular session is open at t.
+func (c *Calendar) IsOpen(t time.Time) (bool, error) {
+	phase, err := c.Phase(t)
+	return phase == PhaseOpen, err
+}
+
+// IsHalfDay reports whether t's date is an early-close trading day.
+func (c *Calendar) IsHalfDay(t time.Time) (bool, error) {
+	day, err := c.Day(t)
+	return day.IsHalfDay(), err
+}
+
+// NextOpen returns the start of the next regular session after t. During a session this is
+// the following trading day's open.
+func (c *Calendar) NextOpen(t time.Time) (time.Time, error) {
+	return c.next(t, func(s Session) time.Time { return s.Start })
+}
+
+// NextClose returns the end of the regular session in progress at t, or of the next one.
+func (c *Calendar) NextClose(t time.Time) (time.Time, error) {
+	return c.next(t, func(s Session) time.Time { return s.End })
+}
+
+// next returns the first regular-session boundary picked by edge that falls after t.
+func (c *Calendar) next(t time.Time, edge func(Session) time.Time) (time.Time, error) {
+	for i := 0; i <= maxSearchDays; i++ {
+		day, err := c.Day(Date(t).AddDate(0, 0, i))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if day.Open && !day.Regular.IsZero() && edge(day.Regular).After(t) {
+			return edge(day.Regular), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no trading session found in the %d days after %s", maxSearchDays, t.Format("2006-01-02"))
+}
+
+// TradingDaysBetween returns the number of open days from from's date through to's date inclusive.
+func (c *Calendar) TradingDaysBetween(from, to time.Time) (int, error) {
+	days, err := c.Days(from, to)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, d := range days {
+		if d.Open {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Date returns midnight Eastern on t's date in Eastern time.
+func Date(t time.Time) time.Time {
+	t = t.In(pricing.Eastern())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, pricing.Eastern())
+}
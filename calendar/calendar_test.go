@@ -0,0 +1,145 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package calendar
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// novemberJSON has a regular day, Thanksgiving, an early close, and a weekend.
+const novemberJSON = `{"calendar":{"month":11,"year":2026,"days":{"day":[
+	{"date":"2026-11-25","status":"open","description":"Market is open","premarket":{"start":"07:00","end":"09:24"},"open":{"start":"09:30","end":"16:00"},"postmarket":{"start":"16:00","end":"19:55"}},
+	{"date":"2026-11-26","status":"closed","description":"Market is closed for Thanksgiving Day"},
+	{"date":"2026-11-27","status":"open","description":"Market closes early","premarket":{"start":"07:00","end":"09:24"},"open":{"start":"09:30","end":"13:00"}},
+	{"date":"2026-11-28","status":"closed","description":"Market is closed"},
+	{"date":"2026-11-29","status":"closed","description":"Market is closed"},
+	{"date":"2026-11-30","status":"open","open":{"start":"09:30","end":"16:00"}}]}}}`
+
+// decemberJSON has a single day, which the API returns as an object rather than an array.
+const decemberJSON = `{"calendar":{"month":12,"year":2026,"days":{"day":
+	{"date":"2026-12-01","status":"open","open":{"start":"09:30","end":"16:00"}}}}}`
+
+// at returns the Eastern time on the given November 2026 day.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 11, day, hour, minute, 0, 0, pricing.Eastern())
+}
+
+// testCalendar returns a calendar over the sample months and a pointer to its fetch count.
+func testCalendar() (*Calendar, *int) {
+	fetches := 0
+	return New(func(year int, month time.Month) ([]byte, error) {
+		fetches++
+		switch month {
+		case time.November:
+			return []byte(novemberJSON), nil
+		case time.December:
+			return []byte(decemberJSON), nil
+		}
+		return nil, fmt.Errorf("no calendar for %d-%02d", year, month)
+	}), &fetches
+}
+
+// TestParseMonth verifies sessions, statuses, and the single-day object form.
+func TestParseMonth(t *testing.T) {
+	days, err := ParseMonth([]byte(novemberJSON))
+	if err != nil || len(days) != 6 {
+		t.Fatalf("ParseMonth() = %d days, %v, want 6", len(days), err)
+	}
+	if !days[0].Regular.Start.Equal(at(25, 9, 30)) || !days[0].Postmarket.End.Equal(at(25, 19, 55)) {
+		t.Errorf("ParseMonth() day 0 = %+v", days[0])
+	}
+	if days[1].Open || !days[1].Regular.IsZero() || days[1].Description != "Market is closed for Thanksgiving Day" {
+		t.Errorf("ParseMonth() holiday = %+v", days[1])
+	}
+	if len(days[0].Sessions()) != 3 || len(days[2].Sessions()) != 2 {
+		t.Errorf("Sessions() = %d, %d, want 3, 2", len(days[0].Sessions()), len(days[2].Sessions()))
+	}
+
+	single, err := ParseMonth([]byte(decemberJSON))
+	if err != nil || len(single) != 1 || !single[0].Open {
+		t.Errorf("ParseMonth(single day) = %+v, %v", single, err)
+	}
+}
+
+// TestPhase verifies each part of the trading day and the closed gaps between them.
+func TestPhase(t *testing.T) {
+	cal, _ := testCalendar()
+	tests := map[time.Time]Phase{
+		at(25, 6, 59):  PhaseClosed,
+		at(25, 7, 0):   PhasePremarket,
+		at(25, 9, 27):  PhaseClosed,
+		at(25, 9, 30):  PhaseOpen,
+		at(25, 15, 59): PhaseOpen,
+		at(25, 16, 0):  PhasePostmarket,
+		at(25, 20, 0):  PhaseClosed,
+		at(26, 12, 0):  PhaseClosed,
+		at(27, 14, 0):  PhaseClosed,
+	}
+	for when, want := range tests {
+		if got, err := cal.Phase(when); err != nil || got != want {
+			t.Errorf("Phase(%s) = %v, %v, want %v", when.Format("Jan 2 15:04"), got, err, want)
+		}
+	}
+	if open, _ := cal.IsOpen(at(25, 10, 0)); !open {
+		t.Errorf("IsOpen(25th 10:00) = false, want true")
+	}
+
+	// Times in other zones are converted to Eastern first
+	utc := time.Date(2026, 11, 25, 15, 0, 0, 0, time.UTC)
+	if open, _ := cal.IsOpen(utc); !open {
+		t.Errorf("IsOpen(15:00 UTC) = false, want true")
+	}
+}
+
+// TestNextOpenClose verifies the search across holidays, weekends, and month boundaries.
+func TestNextOpenClose(t *testing.T) {
+	cal, fetches := testCalendar()
+	tests := []struct {
+		name      string
+		fn        func(time.Time) (time.Time, error)
+		from, due time.Time
+	}{
+		{"NextOpen before open", cal.NextOpen, at(25, 8, 0), at(25, 9, 30)},
+		{"NextOpen during session", cal.NextOpen, at(25, 10, 0), at(27, 9, 30)},
+		{"NextOpen over weekend", cal.NextOpen, at(27, 10, 0), at(30, 9, 30)},
+		{"NextClose during session", cal.NextClose, at(25, 10, 0), at(25, 16, 0)},
+		{"NextClose half day", cal.NextClose, at(26, 10, 0), at(27, 13, 0)},
+		{"NextOpen into December", cal.NextOpen, at(30, 12, 0), time.Date(2026, 12, 1, 9, 30, 0, 0, pricing.Eastern())},
+	}
+	for _, tc := range tests {
+		got, err := tc.fn(tc.from)
+		if err != nil || !got.Equal(tc.due) {
+			t.Errorf("%s = %v, %v, want %v", tc.name, got, err, tc.due)
+		}
+	}
+	if *fetches != 2 {
+		t.Errorf("source fetched %d times, want 2 (once per month)", *fetches)
+	}
+
+	if _, err := cal.NextOpen(time.Date(2026, 12, 1, 17, 0, 0, 0, pricing.Eastern())); err == nil {
+		t.Errorf("NextOpen(past the data) error = nil, want error")
+	}
+}
+
+// TestHalfDayAndTradingDays verifies early closes and open-day counting.
+func TestHalfDayAndTradingDays(t *testing.T) {
+	cal, _ := testCalendar()
+	for day, want := range map[int]bool{25: false, 26: false, 27: true} {
+		if got, _ := cal.IsHalfDay(at(day, 12, 0)); got != want {
+			t.Errorf("IsHalfDay(%d) = %v, want %v", day, got, want)
+		}
+	}
+	if n, err := cal.TradingDaysBetween(at(25, 18, 0), at(30, 0, 0)); err != nil || n != 3 {
+		t.Errorf("TradingDaysBetween() = %d, %v, want 3", n, err)
+	}
+
+	sessions, err := cal.Sessions(at(26, 0, 0), at(27, 0, 0))
+	if err != nil || len(sessions) != 2 || !sessions[1].End.Equal(at(27, 13, 0)) {
+		t.Errorf("Sessions() = %+v, %v", sessions, err)
+	}
+}
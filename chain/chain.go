@@ -0,0 +1,252 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// Greeks are the ORATS-sourced greeks and implied volatilities Tradier attaches to chain contracts.
+type Greeks struct {
+	Delta  float64 `json:"delta"`
+	Gamma  float64 `json:"gamma"`
+	Theta  float64 `json:"theta"`
+	Vega   float64 `json:"vega"`
+	Rho    float64 `json:"rho"`
+	BidIV  float64 `json:"bid_iv"`
+	MidIV  float64 `json:"mid_iv"`
+	AskIV  float64 `json:"ask_iv"`
+	SmvVol float64 `json:"smv_vol"`
+}
+
+// Contract is a single option from an options chain response.
+// Raw holds the original JSON object so callers can re-emit every field Tradier returned.
+type Contract struct {
+	Symbol         string                 `json:"symbol"`
+	Underlying     string                 `json:"underlying"`
+	OptionType     string                 `json:"option_type"`
+	Strike         float64                `json:"strike"`
+	ExpirationDate string                 `json:"expiration_date"`
+	Bid            float64                `json:"bid"`
+	Ask            float64                `json:"ask"`
+	Last           float64                `json:"last"`
+	Volume         float64                `json:"volume"`
+	OpenInterest   float64                `json:"open_interest"`
+	ContractSize   float64                `json:"contract_size"`
+	Greeks         *Greeks                `json:"greeks"`
+	Raw            map[string]interface{} `json:"-"`
+}
+
+// Filter narrows a chain. Zero values disable each criterion.
+// Delta bounds apply to the absolute delta and exclude contracts without greeks.
+type Filter struct {
+	Type          string
+	MinDelta      float64
+	MaxDelta      float64
+	MinOpenInt    float64
+	MaxSpreadPct  float64
+	StrikesAround int
+	Spot          float64
+}
+
+// Parse decodes an options chain response. Tradier returns a single object instead of an
+// array when the chain has one contract, and null prices for untraded contracts; both are handled.
+func Parse(data []byte) ([]Contract, error) {
+	var resp struct {
+		Options *struct {
+			Option json.RawMessage `json:"option"`
+		} `json:"options"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse options chain: %w", err)
+	}
+	if resp.Options == nil || len(resp.Options.Option) == 0 || string(resp.Options.Option) == "null" {
+		return nil, nil
+	}
+
+	raw := resp.Options.Option
+	if raw[0] != '[' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+
+	var contracts []Contract
+	if err := json.Unmarshal(raw, &contracts); err != nil {
+		return nil, fmt.Errorf("unable to parse options chain: %w", err)
+	}
+	var maps []map[string]interface{}
+	if err := json.Unmarshal(raw, &maps); err != nil {
+		return nil, fmt.Errorf("unable to parse options chain: %w", err)
+	}
+	for i := range contracts {
+		contracts[i].Raw = maps[i]
+	}
+	return contracts, nil
+}
+
+// IsCall reports whether the contract is a call.
+func (c Contract) IsCall() bool {
+	return c.OptionType == "call"
+}
+
+// Mid returns the bid/ask midpoint, or the last price when either side is missing.
+func (c Contract) Mid() float64 {
+	if c.Bid > 0 && c.Ask > 0 {
+		return (c.Bid + c.Ask) / 2
+	}
+	return c.Last
+}
+
+// SpreadPct returns the bid/ask spread as a percentage of the midpoint, or -1 when there is no two-sided market.
+func (c Contract) SpreadPct() float64 {
+	if c.Bid <= 0 || c.Ask <= 0 {
+		return -1
+	}
+	return (c.Ask - c.Bid) / ((c.Ask + c.Bid) / 2) * 100
+}
+
+// Intrinsic returns the value of exercising the contract immediately at the given spot price.
+func (c Contract) Intrinsic(spot float64) float64 {
+	if c.IsCall() {
+		return math.Max(spot-c.Strike, 0)
+	}
+	return math.Max(c.Strike-spot, 0)
+}
+
+// Extrinsic returns the time value embedded in the midpoint price.
+func (c Contract) Extrinsic(spot float64) float64 {
+	return math.Max(c.Mid()-c.Intrinsic(spot), 0)
+}
+
+// Expiration returns the contract's expiration at the 4:00 PM Eastern close.
+func (c Contract) Expiration() (time.Time, error) {
+	d, err := time.Parse("2006-01-02", c.ExpirationDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiration date %q", c.ExpirationDate)
+	}
+	return pricing.Expiry(d), nil
+}
+
+// YearsToExpiry returns the time remaining until expiration in years, floored at zero.
+func (c Contract) YearsToExpiry(now time.Time) float64 {
+	exp, err := c.Expiration()
+	if err != nil {
+		return 0
+	}
+	return pricing.YearsToExpiry(exp, now)
+}
+
+// Params returns pricing inputs for the contract at the given spot, rate, and dividend yield.
+// Volatility is left at zero for the caller to fill in.
+func (c Contract) Params(spot, rate, dividend float64, now time.Time) pricing.Params {
+	return pricing.Params{
+		Spot:     spot,
+		Strike:   c.Strike,
+		Years:    c.YearsToExpiry(now),
+		Rate:     rate,
+		Dividend: dividend,
+		Call:     c.IsCall(),
+	}
+}
+
+// ProbITM estimates the risk-neutral probability the contract expires in the money as N(d2),
+// using the mid implied volatility and a zero rate. Falls back to the absolute delta when
+// implied volatility is unavailable, and returns -1 when neither is known.
+func (c Contract) ProbITM(spot float64, now time.Time) float64 {
+	p := c.Params(spot, 0, 0, now)
+	if c.Greeks != nil && c.Greeks.MidIV > 0 && p.Years > 0 && spot > 0 {
+		p.Vol = c.Greeks.MidIV
+		return pricing.ProbITM(p)
+	}
+	if c.Greeks != nil && c.Greeks.Delta != 0 {
+		return math.Abs(c.Greeks.Delta)
+	}
+	return -1
+}
+
+// Apply returns the contracts that pass every enabled criterion, preserving chain order.
+func (f Filter) Apply(contracts []Contract) []Contract {
+	allowed := map[float64]bool(nil)
+	if f.StrikesAround > 0 && f.Spot > 0 {
+		allowed = map[float64]bool{}
+		for _, s := range StrikesAround(Strikes(contracts), f.Spot, f.StrikesAround) {
+			allowed[s] = true
+		}
+	}
+
+	out := make([]Contract, 0, len(contracts))
+	for _, c := range contracts {
+		if f.Type != "" && c.OptionType != f.Type {
+			continue
+		}
+		if allowed != nil && !allowed[c.Strike] {
+			continue
+		}
+		if f.MinOpenInt > 0 && c.OpenInterest < f.MinOpenInt {
+			continue
+		}
+		if f.MaxSpreadPct > 0 {
+			if sp := c.SpreadPct(); sp < 0 || sp > f.MaxSpreadPct {
+				continue
+			}
+		}
+		if f.MinDelta > 0 || f.MaxDelta > 0 {
+			if c.Greeks == nil {
+				continue
+			}
+			d := math.Abs(c.Greeks.Delta)
+			if d < f.MinDelta || (f.MaxDelta > 0 && d > f.MaxDelta) {
+				continue
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// Strikes returns the distinct strikes in the chain in ascending order.
+func Strikes(contracts []Contract) []float64 {
+	seen := map[float64]bool{}
+	var strikes []float64
+	for _, c := range contracts {
+		if !seen[c.Strike] {
+			seen[c.Strike] = true
+			strikes = append(strikes, c.Strike)
+		}
+	}
+	sort.Float64s(strikes)
+	return strikes
+}
+
+// StrikesAround returns up to n strikes on each side of spot from a sorted strike list.
+// A strike exactly at spot counts as the first strike above it.
+func StrikesAround(strikes []float64, spot float64, n int) []float64 {
+	idx := sort.SearchFloat64s(strikes, spot)
+	lo := idx - n
+	if lo < 0 {
+		lo = 0
+	}
+	hi := idx + n
+	if hi > len(strikes) {
+		hi = len(strikes)
+	}
+	return strikes[lo:hi]
+}
+
+// ATMStrike returns the strike closest to spot, or zero for an empty list.
+func ATMStrike(strikes []float64, spot float64) float64 {
+	best := 0.0
+	bestDist := math.Inf(1)
+	for _, s := range strikes {
+		if d := math.Abs(s - spot); d < bestDist {
+			best, bestDist = s, d
+		}
+	}
+	return best
+}
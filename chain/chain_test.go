@@ -0,0 +1,146 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chain
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// sampleChain is a small chain with calls and puts at three strikes around a $100 underlying.
+const sampleChain = `{"options":{"option":[
+ {"symbol":"XYZ260320C00095000","underlying":"XYZ","option_type":"call","strike":95,"expiration_date":"2026-03-20","bid":6.0,"ask":6.4,"last":6.1,"volume":10,"open_interest":500,"greeks":{"delta":0.72,"mid_iv":0.30}},
+ {"symbol":"XYZ260320P00095000","underlying":"XYZ","option_type":"put","strike":95,"expiration_date":"2026-03-20","bid":1.0,"ask":1.2,"last":null,"volume":5,"open_interest":50,"greeks":{"delta":-0.28,"mid_iv":0.31}},
+ {"symbol":"XYZ260320C00100000","underlying":"XYZ","option_type":"call","strike":100,"expiration_date":"2026-03-20","bid":3.0,"ask":3.2,"last":3.1,"volume":99,"open_interest":900,"greeks":{"delta":0.51,"mid_iv":0.29}},
+ {"symbol":"XYZ260320P00100000","underlying":"XYZ","option_type":"put","strike":100,"expiration_date":"2026-03-20","bid":2.9,"ask":3.1,"last":3.0,"volume":80,"open_interest":800,"greeks":{"delta":-0.49,"mid_iv":0.29}},
+ {"symbol":"XYZ260320C00105000","underlying":"XYZ","option_type":"call","strike":105,"expiration_date":"2026-03-20","bid":0,"ask":1.5,"last":1.1,"volume":0,"open_interest":20,"greeks":null},
+ {"symbol":"XYZ260320P00105000","underlying":"XYZ","option_type":"put","strike":105,"expiration_date":"2026-03-20","bid":5.8,"ask":6.6,"last":6.0,"volume":3,"open_interest":40,"greeks":{"delta":-0.70,"mid_iv":0.33}}
+]}}`
+
+// mustParse parses the sample chain or fails the test.
+func mustParse(t *testing.T) []Contract {
+	t.Helper()
+	contracts, err := Parse([]byte(sampleChain))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	return contracts
+}
+
+// TestParse verifies contracts, nulls, greeks, and raw maps are decoded.
+func TestParse(t *testing.T) {
+	contracts := mustParse(t)
+	if len(contracts) != 6 {
+		t.Fatalf("len = %d, want 6", len(contracts))
+	}
+	if contracts[1].Last != 0 {
+		t.Errorf("null last = %v, want 0", contracts[1].Last)
+	}
+	if contracts[4].Greeks != nil {
+		t.Error("null greeks should decode to nil")
+	}
+	if contracts[0].Raw["symbol"] != "XYZ260320C00095000" {
+		t.Errorf("Raw symbol = %v", contracts[0].Raw["symbol"])
+	}
+}
+
+// TestParseSingleAndEmpty verifies a lone object and a null chain both decode.
+func TestParseSingleAndEmpty(t *testing.T) {
+	single, err := Parse([]byte(`{"options":{"option":{"symbol":"A","strike":1}}}`))
+	if err != nil || len(single) != 1 || single[0].Symbol != "A" {
+		t.Errorf("Parse(single) = %v, %v", single, err)
+	}
+	empty, err := Parse([]byte(`{"options":null}`))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Parse(null) = %v, %v", empty, err)
+	}
+}
+
+// TestComputedValues verifies mid, spread, intrinsic, and extrinsic calculations.
+func TestComputedValues(t *testing.T) {
+	c := mustParse(t)[0]
+	if c.Mid() != 6.2 {
+		t.Errorf("Mid() = %v, want 6.2", c.Mid())
+	}
+	if math.Abs(c.SpreadPct()-0.4/6.2*100) > 1e-9 {
+		t.Errorf("SpreadPct() = %v", c.SpreadPct())
+	}
+	if c.Intrinsic(100) != 5 {
+		t.Errorf("Intrinsic() = %v, want 5", c.Intrinsic(100))
+	}
+	if math.Abs(c.Extrinsic(100)-1.2) > 1e-9 {
+		t.Errorf("Extrinsic() = %v, want 1.2", c.Extrinsic(100))
+	}
+	if one := mustParse(t)[4]; one.SpreadPct() != -1 || one.Mid() != 1.1 {
+		t.Errorf("one-sided market SpreadPct = %v, Mid = %v", one.SpreadPct(), one.Mid())
+	}
+}
+
+// TestProbITM verifies ATM probabilities are near one half and fallbacks are used.
+func TestProbITM(t *testing.T) {
+	contracts := mustParse(t)
+	now := time.Date(2026, 2, 18, 12, 0, 0, 0, time.UTC)
+	call := contracts[2].ProbITM(100, now)
+	put := contracts[3].ProbITM(100, now)
+	if call < 0.45 || call > 0.5 || math.Abs(call+put-1) > 1e-9 {
+		t.Errorf("ProbITM call = %v, put = %v", call, put)
+	}
+	if got := contracts[4].ProbITM(100, now); got != -1 {
+		t.Errorf("ProbITM without greeks = %v, want -1", got)
+	}
+	expired := contracts[0].ProbITM(100, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	if expired != 0.72 {
+		t.Errorf("ProbITM after expiry = %v, want delta fallback 0.72", expired)
+	}
+}
+
+// TestFilter verifies each filter criterion independently and in combination.
+func TestFilter(t *testing.T) {
+	contracts := mustParse(t)
+	symbols := func(cs []Contract) []string {
+		out := []string{}
+		for _, c := range cs {
+			out = append(out, c.Symbol)
+		}
+		return out
+	}
+
+	if got := (Filter{Type: "put"}).Apply(contracts); len(got) != 3 {
+		t.Errorf("Type=put len = %d, want 3", len(got))
+	}
+	delta := Filter{MinDelta: 0.2, MaxDelta: 0.4}.Apply(contracts)
+	if !reflect.DeepEqual(symbols(delta), []string{"XYZ260320P00095000"}) {
+		t.Errorf("delta filter = %v", symbols(delta))
+	}
+	oi := Filter{MinOpenInt: 500}.Apply(contracts)
+	if len(oi) != 3 {
+		t.Errorf("MinOpenInt len = %d, want 3", len(oi))
+	}
+	spread := Filter{MaxSpreadPct: 10}.Apply(contracts)
+	for _, c := range spread {
+		if c.Symbol == "XYZ260320C00105000" || c.Symbol == "XYZ260320P00105000" {
+			t.Errorf("spread filter kept %s", c.Symbol)
+		}
+	}
+	around := Filter{StrikesAround: 1, Spot: 101}.Apply(contracts)
+	if !reflect.DeepEqual(Strikes(around), []float64{100, 105}) {
+		t.Errorf("StrikesAround strikes = %v, want [100 105]", Strikes(around))
+	}
+}
+
+// TestStrikeHelpers verifies strike windows and ATM selection.
+func TestStrikeHelpers(t *testing.T) {
+	strikes := []float64{90, 95, 100, 105, 110}
+	if got := StrikesAround(strikes, 100, 2); !reflect.DeepEqual(got, []float64{90, 95, 100, 105}) {
+		t.Errorf("StrikesAround() = %v", got)
+	}
+	if got := StrikesAround(strikes, 200, 2); !reflect.DeepEqual(got, []float64{105, 110}) {
+		t.Errorf("StrikesAround(beyond) = %v", got)
+	}
+	if got := ATMStrike(strikes, 103); got != 105 {
+		t.Errorf("ATMStrike() = %v, want 105", got)
+	}
+}
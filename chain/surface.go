@@ -0,0 +1,121 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chain
+
+import (
+	"math"
+	"sort"
+)
+
+// SmilePoint is the implied volatility at one strike of an expiration.
+type SmilePoint struct {
+	Strike float64 `json:"strike"`
+	IV     float64 `json:"iv"`
+}
+
+// Smile returns the mid implied volatility at each strike, sorted by strike. Out-of-the-money
+// options are preferred since they are the more liquid side: puts below spot and calls at or above
+// it. The in-the-money option is used when the out-of-the-money one has no implied volatility.
+func Smile(contracts []Contract, spot float64) []SmilePoint {
+	type pair struct{ call, put float64 }
+	byStrike := map[float64]*pair{}
+	for _, c := range contracts {
+		if c.Greeks == nil || c.Greeks.MidIV <= 0 {
+			continue
+		}
+		p := byStrike[c.Strike]
+		if p == nil {
+			p = &pair{}
+			byStrike[c.Strike] = p
+		}
+		if c.IsCall() {
+			p.call = c.Greeks.MidIV
+		} else {
+			p.put = c.Greeks.MidIV
+		}
+	}
+
+	smile := make([]SmilePoint, 0, len(byStrike))
+	for strike, p := range byStrike {
+		otm, itm := p.call, p.put
+		if strike < spot {
+			otm, itm = p.put, p.call
+		}
+		iv := otm
+		if iv == 0 {
+			iv = itm
+		}
+		smile = append(smile, SmilePoint{Strike: strike, IV: iv})
+	}
+	sort.Slice(smile, func(i, j int) bool { return smile[i].Strike < smile[j].Strike })
+	return smile
+}
+
+// ATMIV returns the at-the-money implied volatility, linearly interpolated between the strikes
+// bracketing spot. Returns the nearest strike's value when spot is outside the smile and zero
+// when there is no implied volatility at all.
+func ATMIV(contracts []Contract, spot float64) float64 {
+	smile := Smile(contracts, spot)
+	xs := make([]float64, len(smile))
+	ys := make([]float64, len(smile))
+	for i, p := range smile {
+		xs[i], ys[i] = p.Strike, p.IV
+	}
+	iv, _ := interpolate(xs, ys, spot, true)
+	return iv
+}
+
+// DeltaIV returns the implied volatility at the given absolute delta for calls or puts,
+// interpolated between the two contracts bracketing it. Returns zero when the chain does not
+// span the target delta, since extrapolating skew is unreliable.
+func DeltaIV(contracts []Contract, delta float64, call bool) float64 {
+	type point struct{ delta, iv float64 }
+	var points []point
+	for _, c := range contracts {
+		if c.IsCall() != call || c.Greeks == nil || c.Greeks.MidIV <= 0 || c.Greeks.Delta == 0 {
+			continue
+		}
+		points = append(points, point{math.Abs(c.Greeks.Delta), c.Greeks.MidIV})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].delta < points[j].delta })
+
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	for i, p := range points {
+		xs[i], ys[i] = p.delta, p.iv
+	}
+	iv, ok := interpolate(xs, ys, delta, false)
+	if !ok {
+		return 0
+	}
+	return iv
+}
+
+// interpolate linearly interpolates ys at x over ascending xs. With clamp, values outside the range
+// take the nearest endpoint; otherwise ok is false for them.
+func interpolate(xs, ys []float64, x float64, clamp bool) (float64, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	if x <= xs[0] || x >= xs[len(xs)-1] {
+		switch {
+		case x == xs[0]:
+			return ys[0], true
+		case x == xs[len(xs)-1]:
+			return ys[len(ys)-1], true
+		case !clamp:
+			return 0, false
+		case x < xs[0]:
+			return ys[0], true
+		default:
+			return ys[len(ys)-1], true
+		}
+	}
+	i := sort.SearchFloat64s(xs, x)
+	if xs[i] == x {
+		return ys[i], true
+	}
+	w := (x - xs[i-1]) / (xs[i] - xs[i-1])
+	return ys[i-1] + w*(ys[i]-ys[i-1]), true
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chain
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestSmile verifies out-of-the-money options are preferred with an in-the-money fallback.
+func TestSmile(t *testing.T) {
+	got := Smile(mustParse(t), 101)
+	want := []SmilePoint{{95, 0.31}, {100, 0.29}, {105, 0.33}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Smile() = %v, want %v", got, want)
+	}
+}
+
+// TestATMIV verifies interpolation between bracketing strikes and clamping outside them.
+func TestATMIV(t *testing.T) {
+	contracts := mustParse(t)
+	if got := ATMIV(contracts, 101); math.Abs(got-0.298) > 1e-9 {
+		t.Errorf("ATMIV(101) = %v, want 0.298", got)
+	}
+	if got := ATMIV(contracts, 150); got != 0.33 {
+		t.Errorf("ATMIV(150) = %v, want 0.33", got)
+	}
+	if got := ATMIV(nil, 100); got != 0 {
+		t.Errorf("ATMIV(empty) = %v, want 0", got)
+	}
+}
+
+// TestDeltaIV verifies interpolation by delta and that targets outside the chain return zero.
+func TestDeltaIV(t *testing.T) {
+	contracts := mustParse(t)
+	want := 0.31 + (0.30-0.28)/(0.49-0.28)*(0.29-0.31)
+	if got := DeltaIV(contracts, 0.30, false); math.Abs(got-want) > 1e-9 {
+		t.Errorf("DeltaIV(put 0.30) = %v, want %v", got, want)
+	}
+	if got := DeltaIV(contracts, 0.72, true); got != 0.30 {
+		t.Errorf("DeltaIV(call 0.72) = %v, want 0.30", got)
+	}
+	if got := DeltaIV(contracts, 0.25, true); got != 0 {
+		t.Errorf("DeltaIV(call 0.25) = %v, want 0", got)
+	}
+}
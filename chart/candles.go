@@ -0,0 +1,234 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	// minVolumeHeight is the smallest number of rows given to the volume subplot.
+	minVolumeHeight = 3
+
+	// volumeLabelWidth is the widest compact volume label, such as "999.9M".
+	volumeLabelWidth = 6
+)
+
+// Candle is one OHLCV bar to plot.
+type Candle struct {
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// glyphs are the characters used to draw candles, volume bars, and the close line.
+type glyphs struct {
+	wick, up, down, flat, volume, line rune
+}
+
+// unicodeGlyphs draw hollow-looking up candles and solid down candles with box drawing characters.
+var unicodeGlyphs = glyphs{wick: '│', up: '┃', down: '█', flat: '━', volume: '█', line: '•'}
+
+// asciiGlyphs are the plain ASCII equivalents of unicodeGlyphs.
+var asciiGlyphs = glyphs{wick: '|', up: 'O', down: '#', flat: '-', volume: '#', line: '*'}
+
+// Candles renders OHLC bars as a candlestick chart with a volume subplot beneath it.
+// Overlay series (aligned with the candles) share the price axis. When there are more candles
+// than columns, neighbouring candles are merged so the chart always fits the width.
+func Candles(opts Options, candles []Candle, overlays ...Series) string {
+	return priceChart(opts, candles, overlays, true)
+}
+
+// PriceLine renders the closing prices of the candles as a line chart with a volume subplot,
+// using the same layout and overlays as Candles.
+func PriceLine(opts Options, candles []Candle, overlays ...Series) string {
+	return priceChart(opts, candles, overlays, false)
+}
+
+// priceChart draws the price panel as candles or a close line, then the volume panel and legend.
+func priceChart(opts Options, candles []Candle, overlays []Series, drawCandles bool) string {
+	opts = withDefaults(opts)
+	g := unicodeGlyphs
+	if opts.ASCII {
+		g = asciiGlyphs
+	}
+
+	// Both panels share one label column so their plots line up
+	lo, hi := candleBounds(candles, overlays)
+	if math.IsInf(lo, 0) {
+		return "No data to chart.\n"
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+	labels, labelWidth := axisLabels(lo, hi, opts)
+	volHeight := max(opts.Height/4, minVolumeHeight)
+	if volumeLabels(candles, volHeight) != nil {
+		labelWidth = max(labelWidth, volumeLabelWidth)
+	}
+	maxCols := max(opts.Width-labelWidth-2, 10)
+
+	groups := groupCandles(len(candles), maxCols)
+	merged := make([]Candle, len(groups))
+	for i, grp := range groups {
+		merged[i] = mergeCandles(candles[grp[0]:grp[1]])
+	}
+	volLabels := volumeLabels(merged, volHeight)
+
+	// Space bars out when there is room for a gap column between each one
+	step := 1
+	if 2*len(merged)-1 <= maxCols {
+		step = 2
+	}
+	plotWidth := (len(merged)-1)*step + 1
+
+	grid := newGrid(opts.Height, plotWidth)
+	for i, c := range merged {
+		col := i * step
+		if !drawCandles {
+			grid[rowFor(c.Close, lo, hi, opts.Height)][col] = g.line
+			continue
+		}
+		top, bottom := rowFor(c.High, lo, hi, opts.Height), rowFor(c.Low, lo, hi, opts.Height)
+		for r := top; r <= bottom; r++ {
+			grid[r][col] = g.wick
+		}
+		openRow, closeRow := rowFor(c.Open, lo, hi, opts.Height), rowFor(c.Close, lo, hi, opts.Height)
+		body := g.up
+		switch {
+		case c.Close < c.Open:
+			body = g.down
+		case openRow == closeRow:
+			body = g.flat
+		}
+		for r := min(openRow, closeRow); r <= max(openRow, closeRow); r++ {
+			grid[r][col] = body
+		}
+	}
+
+	// Overlays are drawn over empty cells and wicks, but never over candle bodies
+	for _, s := range overlays {
+		glyph := s.Glyph
+		if glyph == 0 {
+			glyph = '*'
+		}
+		for i, grp := range groups {
+			if grp[1] > len(s.Values) || math.IsNaN(s.Values[grp[1]-1]) {
+				continue
+			}
+			row, col := rowFor(s.Values[grp[1]-1], lo, hi, opts.Height), i*step
+			if cell := grid[row][col]; cell == ' ' || cell == g.wick || !drawCandles {
+				grid[row][col] = glyph
+			}
+		}
+	}
+
+	var b strings.Builder
+	writeGrid(&b, grid, labels, labelWidth, opts)
+	if volLabels != nil {
+		writeGrid(&b, volumeGrid(merged, volHeight, step, plotWidth, g), volLabels, labelWidth, opts)
+	}
+	writeXAxis(&b, labelWidth, plotWidth, opts)
+
+	if len(overlays) > 0 {
+		parts := make([]string, 0, len(overlays))
+		for _, s := range overlays {
+			glyph := s.Glyph
+			if glyph == 0 {
+				glyph = '*'
+			}
+			parts = append(parts, fmt.Sprintf("%c %s", glyph, s.Name))
+		}
+		b.WriteString(strings.Repeat(" ", labelWidth+2))
+		b.WriteString(strings.Join(parts, "   "))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// candleBounds returns the lowest low and highest high across the candles and overlays.
+func candleBounds(candles []Candle, overlays []Series) (float64, float64) {
+	lo, hi := bounds(overlays)
+	for _, c := range candles {
+		lo = math.Min(lo, c.Low)
+		hi = math.Max(hi, c.High)
+	}
+	return lo, hi
+}
+
+// groupCandles splits n candles into at most cols consecutive [start, end) index ranges.
+func groupCandles(n, cols int) [][2]int {
+	size := (n + cols - 1) / cols
+	if size < 1 {
+		size = 1
+	}
+	var out [][2]int
+	for start := 0; start < n; start += size {
+		out = append(out, [2]int{start, min(start+size, n)})
+	}
+	return out
+}
+
+// mergeCandles combines consecutive candles into one spanning their whole range.
+func mergeCandles(candles []Candle) Candle {
+	out := candles[0]
+	for _, c := range candles[1:] {
+		out.High = math.Max(out.High, c.High)
+		out.Low = math.Min(out.Low, c.Low)
+		out.Close = c.Close
+		out.Volume += c.Volume
+	}
+	return out
+}
+
+// volumeLabels returns the y-axis labels for the volume subplot, or nil when there is no volume.
+func volumeLabels(candles []Candle, height int) []string {
+	peak := 0.0
+	for _, c := range candles {
+		peak = math.Max(peak, c.Volume)
+	}
+	if peak == 0 {
+		return nil
+	}
+	labels := make([]string, height)
+	labels[0] = compact(peak)
+	labels[height-1] = "0"
+	return labels
+}
+
+// volumeGrid draws one volume bar per candle, scaled so the largest fills the subplot.
+func volumeGrid(candles []Candle, height, step, width int, g glyphs) [][]rune {
+	peak := 0.0
+	for _, c := range candles {
+		peak = math.Max(peak, c.Volume)
+	}
+	grid := newGrid(height, width)
+	for i, c := range candles {
+		rows := int(math.Round(c.Volume / peak * float64(height)))
+		if rows == 0 && c.Volume > 0 {
+			rows = 1
+		}
+		for r := height - rows; r < height; r++ {
+			grid[r][i*step] = g.volume
+		}
+	}
+	return grid
+}
+
+// compact formats a volume with a K, M, or B suffix.
+func compact(v float64) string {
+	switch {
+	case v >= 1e9:
+		return fmt.Sprintf("%.1fB", v/1e9)
+	case v >= 1e6:
+		return fmt.Sprintf("%.1fM", v/1e6)
+	case v >= 1e3:
+		return fmt.Sprintf("%.1fK", v/1e3)
+	}
+	return fmt.Sprintf("%.0f", v)
+}
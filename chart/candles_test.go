@@ -0,0 +1,96 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"strings"
+	"testing"
+)
+
+// testCandles rises, falls, and closes flat.
+var testCandles = []Candle{
+	{Open: 10, High: 12, Low: 9, Close: 11, Volume: 1000},
+	{Open: 11, High: 11.5, Low: 8, Close: 9, Volume: 3000},
+	{Open: 9, High: 10, Low: 8.5, Close: 9, Volume: 0},
+}
+
+// TestCandles verifies up, down, and flat candles, the volume subplot, and the layout.
+func TestCandles(t *testing.T) {
+	out := Candles(Options{Width: 40, Height: 8}, testCandles)
+	for _, glyph := range []string{"┃", "█", "━", "│", "3.0K"} {
+		if !strings.Contains(out, glyph) {
+			t.Errorf("Candles() missing %q:\n%s", glyph, out)
+		}
+	}
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	// Eight price rows, three volume rows, and the axis
+	if len(lines) != 12 {
+		t.Errorf("Candles() lines = %d, want 12:\n%s", len(lines), out)
+	}
+	// Candles are spaced one column apart when there is room
+	if !strings.Contains(lines[len(lines)-1], "└─────") || strings.Count(lines[len(lines)-1], "─") != 5 {
+		t.Errorf("axis = %q, want 5 columns", lines[len(lines)-1])
+	}
+}
+
+// TestCandlesASCII verifies the ASCII fallback uses no multi-byte characters.
+func TestCandlesASCII(t *testing.T) {
+	out := Candles(Options{Width: 40, Height: 6, ASCII: true, XLabels: []string{"a", "b"}}, testCandles,
+		Series{Name: "SMA", Values: []float64{10, 10, 10}, Glyph: '~'})
+	for _, r := range out {
+		if r > 127 {
+			t.Fatalf("ASCII output contains %q:\n%s", r, out)
+		}
+	}
+	if !strings.Contains(out, "~ SMA") || !strings.Contains(out, "O") || !strings.Contains(out, "#") {
+		t.Errorf("Candles(ASCII) missing glyphs or legend:\n%s", out)
+	}
+}
+
+// TestCandlesMerge verifies more candles than columns are merged to fit the width.
+func TestCandlesMerge(t *testing.T) {
+	many := make([]Candle, 500)
+	for i := range many {
+		many[i] = Candle{Open: float64(i), High: float64(i) + 2, Low: float64(i) - 1, Close: float64(i) + 1, Volume: 10}
+	}
+	out := Candles(Options{Width: 60, Height: 10}, many)
+	for _, line := range strings.Split(out, "\n") {
+		if n := len([]rune(line)); n > 60 {
+			t.Fatalf("line width = %d, want <= 60:\n%s", n, out)
+		}
+	}
+
+	merged := mergeCandles(many[:3])
+	if merged.Open != 0 || merged.Close != 3 || merged.High != 4 || merged.Low != -1 || merged.Volume != 30 {
+		t.Errorf("mergeCandles() = %+v", merged)
+	}
+}
+
+// TestPriceLine verifies the line style plots closes and omits the volume panel without volume.
+func TestPriceLine(t *testing.T) {
+	out := PriceLine(Options{Width: 40, Height: 5}, []Candle{{High: 2, Low: 1, Close: 1}, {High: 3, Low: 2, Close: 3}})
+	if strings.Count(out, "•") != 2 {
+		t.Errorf("PriceLine() points = %d, want 2:\n%s", strings.Count(out, "•"), out)
+	}
+	if lines := strings.Split(strings.TrimRight(out, "\n"), "\n"); len(lines) != 6 {
+		t.Errorf("PriceLine() lines = %d, want 6:\n%s", len(lines), out)
+	}
+	if out := PriceLine(Options{}, nil); !strings.Contains(out, "No data") {
+		t.Errorf("PriceLine(nil) = %q, want placeholder", out)
+	}
+}
+
+// TestUTF8 verifies the locale checks and their precedence.
+func TestUTF8(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	if !UTF8() {
+		t.Error("UTF8() = false for en_US.UTF-8")
+	}
+	t.Setenv("LC_ALL", "C")
+	if UTF8() {
+		t.Error("UTF8() = true with LC_ALL=C")
+	}
+}
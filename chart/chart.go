@@ -0,0 +1,258 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultWidth is the plot width used when neither the caller nor the terminal provides one.
+	defaultWidth = 80
+
+	// defaultHeight is the number of plot rows used when the caller does not set a height.
+	defaultHeight = 15
+)
+
+// Series is a named sequence of values plotted with a single glyph.
+type Series struct {
+	Name   string
+	Values []float64
+	Glyph  rune
+}
+
+// Options controls the size and labelling of a rendered chart.
+// Width is the total line width including the y-axis labels. ASCII restricts the output
+// to plain ASCII characters for terminals that cannot display Unicode box drawing.
+type Options struct {
+	Width   int
+	Height  int
+	YFormat func(float64) string
+	XLabels []string
+	ASCII   bool
+}
+
+// TerminalWidth returns the width of the terminal from the COLUMNS environment variable,
+// falling back to 80 columns when it is unset or invalid.
+func TerminalWidth() int {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 20 {
+		return cols
+	}
+	return defaultWidth
+}
+
+// UTF8 reports whether the terminal locale supports UTF-8, checking LC_ALL, LC_CTYPE, and LANG
+// in the order the C library does. An unset locale is assumed to be UTF-8.
+func UTF8() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return true
+}
+
+// Line renders one or more series as an ASCII line chart sharing a common y-axis.
+// Each series is stretched or sampled to fill the plot width. A legend is appended when
+// more than one series is plotted, and XLabels are spread evenly beneath the plot.
+func Line(opts Options, series ...Series) string {
+	opts = withDefaults(opts)
+
+	lo, hi := bounds(series)
+	if math.IsInf(lo, 0) {
+		return "No data to chart.\n"
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+
+	labels, labelWidth := axisLabels(lo, hi, opts)
+	plotWidth := opts.Width - labelWidth - 2
+	if plotWidth < 10 {
+		plotWidth = 10
+	}
+
+	grid := newGrid(opts.Height, plotWidth)
+	for _, s := range series {
+		glyph := s.Glyph
+		if glyph == 0 {
+			glyph = '*'
+		}
+		for col, v := range sample(s.Values, plotWidth) {
+			if math.IsNaN(v) {
+				continue
+			}
+			grid[rowFor(v, lo, hi, opts.Height)][col] = glyph
+		}
+	}
+
+	var b strings.Builder
+	writeGrid(&b, grid, labels, labelWidth, opts)
+	writeXAxis(&b, labelWidth, plotWidth, opts)
+
+	if len(series) > 1 {
+		parts := make([]string, 0, len(series))
+		for _, s := range series {
+			glyph := s.Glyph
+			if glyph == 0 {
+				glyph = '*'
+			}
+			parts = append(parts, fmt.Sprintf("%c %s", glyph, s.Name))
+		}
+		b.WriteString(strings.Repeat(" ", labelWidth+2))
+		b.WriteString(strings.Join(parts, "   "))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// writeGrid writes each plot row after its right-aligned y-axis label.
+func writeGrid(b *strings.Builder, grid [][]rune, labels []string, labelWidth int, opts Options) {
+	tick := "┤"
+	if opts.ASCII {
+		tick = "|"
+	}
+	for r, row := range grid {
+		fmt.Fprintf(b, "%*s %s%s\n", labelWidth, labels[r], tick, strings.TrimRight(string(row), " "))
+	}
+}
+
+// writeXAxis writes the x-axis line and, when set, the x labels spread beneath it.
+func writeXAxis(b *strings.Builder, labelWidth, plotWidth int, opts Options) {
+	corner, line := "└", "─"
+	if opts.ASCII {
+		corner, line = "+", "-"
+	}
+	fmt.Fprintf(b, "%*s %s%s\n", labelWidth, "", corner, strings.Repeat(line, plotWidth))
+
+	if len(opts.XLabels) > 0 {
+		b.WriteString(strings.Repeat(" ", labelWidth+2))
+		b.WriteString(spread(opts.XLabels, plotWidth))
+		b.WriteString("\n")
+	}
+}
+
+// withDefaults fills in zero-valued options with sensible defaults.
+func withDefaults(opts Options) Options {
+	if opts.Width <= 0 {
+		opts.Width = TerminalWidth()
+	}
+	if opts.Height <= 0 {
+		opts.Height = defaultHeight
+	}
+	if opts.YFormat == nil {
+		opts.YFormat = func(f float64) string { return fmt.Sprintf("%.2f", f) }
+	}
+	return opts
+}
+
+// bounds returns the minimum and maximum finite values across all series.
+func bounds(series []Series) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, s := range series {
+		for _, v := range s.Values {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			lo = math.Min(lo, v)
+			hi = math.Max(hi, v)
+		}
+	}
+	return lo, hi
+}
+
+// axisLabels builds the y-axis label for every row, labelling the top, middle, and bottom rows.
+func axisLabels(lo, hi float64, opts Options) ([]string, int) {
+	labels := make([]string, opts.Height)
+	labels[0] = opts.YFormat(hi)
+	labels[opts.Height-1] = opts.YFormat(lo)
+	if opts.Height > 4 {
+		mid := opts.Height / 2
+		labels[mid] = opts.YFormat(valueFor(mid, lo, hi, opts.Height))
+	}
+
+	width := 0
+	for _, l := range labels {
+		if len(l) > width {
+			width = len(l)
+		}
+	}
+	return labels, width
+}
+
+// newGrid allocates a blank plot area of the given size.
+func newGrid(rows, cols int) [][]rune {
+	grid := make([][]rune, rows)
+	for r := range grid {
+		grid[r] = []rune(strings.Repeat(" ", cols))
+	}
+	return grid
+}
+
+// rowFor maps a value to a grid row, where row zero is the top of the chart.
+func rowFor(v, lo, hi float64, height int) int {
+	frac := (v - lo) / (hi - lo)
+	row := height - 1 - int(math.Round(frac*float64(height-1)))
+	if row < 0 {
+		return 0
+	}
+	if row >= height {
+		return height - 1
+	}
+	return row
+}
+
+// valueFor maps a grid row back to the value at its centre.
+func valueFor(row int, lo, hi float64, height int) float64 {
+	frac := float64(height-1-row) / float64(height-1)
+	return lo + frac*(hi-lo)
+}
+
+// sample stretches or thins values to exactly width columns using nearest-index sampling.
+func sample(values []float64, width int) []float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]float64, width)
+	for i := range out {
+		idx := 0
+		if width > 1 {
+			idx = int(math.Round(float64(i) * float64(len(values)-1) / float64(width-1)))
+		}
+		out[i] = values[idx]
+	}
+	return out
+}
+
+// spread lays out labels evenly across the given width, left-aligning the first and right-aligning the last.
+func spread(labels []string, width int) string {
+	line := []rune(strings.Repeat(" ", width))
+	for i, l := range labels {
+		pos := 0
+		if len(labels) > 1 {
+			pos = i * (width - 1) / (len(labels) - 1)
+		}
+		start := pos - len(l)/2
+		if i == 0 {
+			start = 0
+		} else if i == len(labels)-1 {
+			start = width - len(l)
+		}
+		if start < 0 {
+			start = 0
+		}
+		for j, ch := range l {
+			if start+j < width {
+				line[start+j] = ch
+			}
+		}
+	}
+	return strings.TrimRight(string(line), " ")
+}
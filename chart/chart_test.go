@@ -0,0 +1,72 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestLineDimensions verifies the chart has one line per row plus axis and label lines.
+func TestLineDimensions(t *testing.T) {
+	out := Line(Options{Width: 40, Height: 5, XLabels: []string{"start", "end"}}, Series{Values: []float64{1, 2, 3, 4, 5}})
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("lines = %d, want 7:\n%s", len(lines), out)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[0]), "5.00") {
+		t.Errorf("top label = %q, want 5.00", lines[0])
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[4]), "1.00") {
+		t.Errorf("bottom label = %q, want 1.00", lines[4])
+	}
+	if !strings.Contains(lines[6], "start") || !strings.HasSuffix(lines[6], "end") {
+		t.Errorf("x labels = %q", lines[6])
+	}
+}
+
+// TestLinePlotsExtremes verifies the highest value lands on the top row and the lowest on the bottom row.
+func TestLinePlotsExtremes(t *testing.T) {
+	out := Line(Options{Width: 30, Height: 4}, Series{Values: []float64{10, 0}, Glyph: '#'})
+	lines := strings.Split(out, "\n")
+	top := lines[0][strings.Index(lines[0], "┤"):]
+	bottom := lines[3][strings.Index(lines[3], "┤"):]
+	if !strings.Contains(top, "#") || !strings.Contains(bottom, "#") {
+		t.Errorf("expected glyphs on top and bottom rows:\n%s", out)
+	}
+}
+
+// TestLineLegend verifies a legend is rendered only when multiple series are plotted.
+func TestLineLegend(t *testing.T) {
+	single := Line(Options{Width: 30, Height: 3}, Series{Name: "A", Values: []float64{1, 2}})
+	if strings.Contains(single, "* A") {
+		t.Error("single series should not render a legend")
+	}
+	multi := Line(Options{Width: 30, Height: 3},
+		Series{Name: "Account", Values: []float64{1, 2}, Glyph: '*'},
+		Series{Name: "SPY", Values: []float64{2, 1}, Glyph: '+'},
+	)
+	if !strings.Contains(multi, "* Account") || !strings.Contains(multi, "+ SPY") {
+		t.Errorf("legend missing:\n%s", multi)
+	}
+}
+
+// TestLineEmpty verifies an empty series renders a placeholder instead of panicking.
+func TestLineEmpty(t *testing.T) {
+	if out := Line(Options{}, Series{}); !strings.Contains(out, "No data") {
+		t.Errorf("Line() = %q, want placeholder", out)
+	}
+}
+
+// TestTerminalWidth verifies COLUMNS is honoured and invalid values fall back to the default.
+func TestTerminalWidth(t *testing.T) {
+	t.Setenv("COLUMNS", "120")
+	if got := TerminalWidth(); got != 120 {
+		t.Errorf("TerminalWidth() = %d, want 120", got)
+	}
+	t.Setenv("COLUMNS", "abc")
+	if got := TerminalWidth(); got != defaultWidth {
+		t.Errorf("TerminalWidth() = %d, want %d", got, defaultWidth)
+	}
+}
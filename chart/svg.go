@@ -0,0 +1,98 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+)
+
+const (
+	// svgWidth and svgHeight are the default image size in pixels.
+	svgWidth  = 800
+	svgHeight = 400
+
+	// svgMargin is the space in pixels reserved around the plot for axis labels and the legend.
+	svgMargin = 60
+)
+
+// svgColors are the stroke colors assigned to series in order.
+var svgColors = []string{"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd", "#8c564b"}
+
+// SVG renders the series as a standalone SVG line chart. Width and Height are in pixels.
+// A dashed zero line is drawn when the values cross zero, XLabels are spread along the
+// bottom axis, and a legend lists every named series.
+func SVG(opts Options, series ...Series) string {
+	if opts.Width <= 0 {
+		opts.Width = svgWidth
+	}
+	if opts.Height <= 0 {
+		opts.Height = svgHeight
+	}
+	if opts.YFormat == nil {
+		opts.YFormat = func(f float64) string { return fmt.Sprintf("%.2f", f) }
+	}
+
+	lo, hi := bounds(series)
+	if math.IsInf(lo, 0) {
+		lo, hi = 0, 1
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+
+	left, top := float64(svgMargin), float64(svgMargin/2)
+	plotW := float64(opts.Width - svgMargin*3/2)
+	plotH := float64(opts.Height - svgMargin*3/2)
+	y := func(v float64) float64 { return top + plotH - (v-lo)/(hi-lo)*plotH }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		opts.Width, opts.Height, opts.Width, opts.Height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	fmt.Fprintf(&b, `<path d="M%.1f %.1fV%.1fH%.1f" fill="none" stroke="#333"/>`+"\n", left, top, top+plotH, left+plotW)
+
+	for i := 0; i <= 4; i++ {
+		v := lo + (hi-lo)*float64(i)/4
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n",
+			left-6, y(v), html.EscapeString(opts.YFormat(v)))
+	}
+	if lo < 0 && hi > 0 {
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#999" stroke-dasharray="4 4"/>`+"\n",
+			left, y(0), left+plotW, y(0))
+	}
+	for i, l := range opts.XLabels {
+		x := left
+		if len(opts.XLabels) > 1 {
+			x += plotW * float64(i) / float64(len(opts.XLabels)-1)
+		}
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`+"\n", x, top+plotH+18, html.EscapeString(l))
+	}
+
+	for i, s := range series {
+		color := svgColors[i%len(svgColors)]
+		var points []string
+		for j, v := range s.Values {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			x := left
+			if len(s.Values) > 1 {
+				x += plotW * float64(j) / float64(len(s.Values)-1)
+			}
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x, y(v)))
+		}
+		fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n", strings.Join(points, " "), color)
+		if s.Name != "" {
+			ly := top + float64(i)*16
+			fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="2"/>`+"\n", left+10, ly, left+30, ly, color)
+			fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" dominant-baseline="middle">%s</text>`+"\n", left+36, ly, html.EscapeString(s.Name))
+		}
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package chart
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSVG verifies one polyline per series, a zero line when values cross zero, and escaped labels.
+func TestSVG(t *testing.T) {
+	out := SVG(Options{XLabels: []string{"90", "110"}},
+		Series{Name: "At <expiration>", Values: []float64{-100, 0, 200}},
+		Series{Name: "Today", Values: []float64{-50, 20, 150}},
+	)
+	if !strings.HasPrefix(out, "<svg") || !strings.HasSuffix(out, "</svg>\n") {
+		t.Fatalf("SVG() is not a complete document:\n%s", out)
+	}
+	if got := strings.Count(out, "<polyline"); got != 2 {
+		t.Errorf("polylines = %d, want 2", got)
+	}
+	if !strings.Contains(out, "stroke-dasharray") {
+		t.Error("expected a dashed zero line")
+	}
+	if !strings.Contains(out, "At &lt;expiration&gt;") {
+		t.Error("series names should be escaped")
+	}
+	if !strings.Contains(out, `width="800" height="400"`) {
+		t.Error("expected default dimensions")
+	}
+}
+
+// TestSVGPositive verifies no zero line is drawn when every value is positive.
+func TestSVGPositive(t *testing.T) {
+	if out := SVG(Options{Width: 300, Height: 200}, Series{Values: []float64{1, 2, 3}}); strings.Contains(out, "stroke-dasharray") {
+		t.Error("unexpected zero line for positive values")
+	}
+}
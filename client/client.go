@@ -4,7 +4,9 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,6 +22,23 @@ type Client struct {
 	HTTPClient *http.Client
 }
 
+// APIError is returned when the Tradier API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error formats the status code and response body.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (HTTP %d): %s", e.StatusCode, e.Body)
+}
+
+// IsRateLimited reports whether err is an API error for exceeding Tradier's rate limit.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
 // NewClient creates a new Tradier API client with the given base URL and API key.
 func NewClient(baseURL, apiKey string) *Client {
 	return &Client{
@@ -117,7 +136,7 @@ func (c *Client) executeRequest(req *http.Request) ([]byte, error) {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(body))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	return body, nil
@@ -129,9 +148,13 @@ func PrettyJSON(data []byte) (string, error) {
 	if err := json.Unmarshal(data, &obj); err != nil {
 		return "", err
 	}
-	pretty, err := json.MarshalIndent(obj, "", "  ")
-	if err != nil {
+	// Leave <, >, and & unescaped so strings such as filter expressions read as written
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(obj); err != nil {
 		return "", err
 	}
-	return string(pretty), nil
+	return strings.TrimSuffix(buf.String(), "\n"), nil
 }
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -64,6 +65,24 @@ func TestDoGetErrorStatus(t *testing.T) {
 	}
 }
 
+// TestRateLimitedError verifies a 429 response is reported as a rate-limit API error.
+func TestRateLimitedError(t *testing.T) {
+	server := testServer(t, "GET", "/v1/test", 429, `Quota Violation`)
+	defer server.Close()
+	c := testClient(server)
+
+	_, err := c.doGet("/v1/test", nil)
+	if !IsRateLimited(err) {
+		t.Errorf("IsRateLimited(%v) = false, want true", err)
+	}
+	if err.Error() != "API error (HTTP 429): Quota Violation" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+	if IsRateLimited(fmt.Errorf("other")) {
+		t.Error("IsRateLimited(non-API error) = true, want false")
+	}
+}
+
 // TestPrettyJSON verifies that PrettyJSON formats JSON correctly.
 func TestPrettyJSON(t *testing.T) {
 	input := []byte(`{"key":"value","num":42}`)
@@ -75,4 +94,9 @@ func TestPrettyJSON(t *testing.T) {
 	if result != expected {
 		t.Errorf("PrettyJSON result = %q, want %q", result, expected)
 	}
+
+	result, err = PrettyJSON([]byte(`{"where":"last > 3 && volume < 1M"}`))
+	if err != nil || result != "{\n  \"where\": \"last > 3 && volume < 1M\"\n}" {
+		t.Errorf("PrettyJSON(unescaped) = %q, %v", result, err)
+	}
 }
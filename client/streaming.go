@@ -3,6 +3,17 @@
 
 package client
 
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
 // CreateMarketSession creates a streaming session for real-time market data via WebSocket.
 func (c *Client) CreateMarketSession() ([]byte, error) {
 	return c.doPost("/v1/markets/events/session", nil)
@@ -12,3 +23,52 @@ func (c *Client) CreateMarketSession() ([]byte, error) {
 func (c *Client) CreateAccountSession() ([]byte, error) {
 	return c.doPost("/v1/accounts/events/session", nil)
 }
+
+// StreamMarketEvents connects to Tradier's HTTP market event stream at streamURL using a session
+// from CreateMarketSession, and calls handle with each JSON event (trade, quote, summary, ...)
+// until the stream ends, ctx is canceled, or handle returns an error. Filter is a
+// comma-separated list of event types; empty streams every type.
+func (c *Client) StreamMarketEvents(ctx context.Context, streamURL, sessionID, symbols, filter string, handle func([]byte) error) error {
+	form := url.Values{}
+	form.Set("sessionid", sessionID)
+	form.Set("symbols", symbols)
+	form.Set("linebreak", "true")
+	if filter != "" {
+		form.Set("filter", filter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, streamURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	// The stream stays open indefinitely, so the client's request timeout cannot apply
+	stream := &http.Client{Transport: c.HTTPClient.Transport}
+	resp, err := stream.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := handle(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("stream read failed: %w", err)
+	}
+	return ctx.Err()
+}
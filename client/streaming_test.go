@@ -4,6 +4,8 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -66,3 +68,56 @@ func TestCreateAccountSession(t *testing.T) {
 		t.Errorf("CreateAccountSession() = %s, want %s", result, body)
 	}
 }
+
+// TestStreamMarketEvents verifies the stream request form and that each event line is handled.
+func TestStreamMarketEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		r.ParseForm()
+		if r.FormValue("sessionid") != "sess-1" || r.FormValue("symbols") != "SPY,AAPL" || r.FormValue("filter") != "trade,quote" {
+			t.Errorf("form = %v", r.Form)
+		}
+		w.Write([]byte(`{"type":"trade","symbol":"SPY","price":"580.12"}` + "\n\n"))
+		w.Write([]byte(`{"type":"quote","symbol":"AAPL","bid":250.1,"ask":250.2}` + "\n"))
+	}))
+	defer server.Close()
+	c := testClient(server)
+
+	var events []string
+	err := c.StreamMarketEvents(context.Background(), server.URL+"/v1/markets/events", "sess-1", "SPY,AAPL", "trade,quote", func(ev []byte) error {
+		events = append(events, string(ev))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamMarketEvents() error: %v", err)
+	}
+	if len(events) != 2 || events[0] != `{"type":"trade","symbol":"SPY","price":"580.12"}` {
+		t.Errorf("events = %q", events)
+	}
+
+	stop := errors.New("stop")
+	err = c.StreamMarketEvents(context.Background(), server.URL, "sess-1", "SPY,AAPL", "trade,quote", func(ev []byte) error {
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("StreamMarketEvents(handler error) = %v, want %v", err, stop)
+	}
+}
+
+// TestStreamMarketEventsError verifies a rejected session returns an APIError.
+func TestStreamMarketEventsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		w.Write([]byte("Session not found"))
+	}))
+	defer server.Close()
+	c := testClient(server)
+
+	err := c.StreamMarketEvents(context.Background(), server.URL, "bad", "SPY", "", func([]byte) error { return nil })
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+		t.Errorf("StreamMarketEvents() error = %v, want APIError 400", err)
+	}
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -25,6 +26,14 @@ var balanceCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if allAccounts, _ := cmd.Flags().GetBool("all-accounts"); allAccounts {
+			data, err := aggregateBalances(c)
+			if err != nil {
+				return err
+			}
+			printResult(data, displayAggregateBalances)
+			return nil
+		}
 		accountID, err := requireAccountID(cmd, cfg)
 		if err != nil {
 			return err
@@ -145,6 +154,13 @@ var orderCmd = &cobra.Command{
 var ordersCmd = &cobra.Command{
 	Use:   "orders",
 	Short: "Get all orders for an account",
+	Long: `Get the orders for an account. With --tag-prefix, every page of orders is fetched and only
+orders whose tag starts with the prefix are shown, with their tags.
+
+Examples:
+  tradier accounts orders
+  tradier accounts orders --include-tags true
+  tradier accounts orders --tag-prefix momentum-`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, cfg, err := loadClientFromConfig()
 		if err != nil {
@@ -157,6 +173,26 @@ var ordersCmd = &cobra.Command{
 		page, _ := cmd.Flags().GetString("page")
 		limit, _ := cmd.Flags().GetString("limit")
 		includeTags, _ := cmd.Flags().GetString("include-tags")
+		tagPrefix, _ := cmd.Flags().GetString("tag-prefix")
+
+		// Filtering by tag needs every page, with tags included
+		if tagPrefix != "" {
+			orders, err := fetchAllOrders(c, accountID)
+			if err != nil {
+				return err
+			}
+			var list interface{}
+			if matched := filterOrdersByTag(orders, tagPrefix); len(matched) > 0 {
+				list = map[string]interface{}{"order": matched}
+			}
+			data, err := json.Marshal(map[string]interface{}{"orders": list})
+			if err != nil {
+				return err
+			}
+			printResult(data, displayOrders)
+			return nil
+		}
+
 		data, err := c.GetOrders(accountID, page, limit, includeTags)
 		if err != nil {
 			return err
@@ -175,6 +211,14 @@ var positionsCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if allAccounts, _ := cmd.Flags().GetBool("all-accounts"); allAccounts {
+			data, err := aggregatePositions(c)
+			if err != nil {
+				return err
+			}
+			printResult(data, displayAggregatePositions)
+			return nil
+		}
 		accountID, err := requireAccountID(cmd, cfg)
 		if err != nil {
 			return err
@@ -311,6 +355,10 @@ func init() {
 		cmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
 	}
 
+	// Multi-account aggregation flags (accounts are discovered from the user profile)
+	balanceCmd.Flags().Bool("all-accounts", false, "Show every account on the profile with combined totals")
+	positionsCmd.Flags().Bool("all-accounts", false, "Show positions for every account on the profile, combined by symbol")
+
 	// Gainloss-specific flags
 	gainlossCmd.Flags().String("page", "", "Page number for pagination")
 	gainlossCmd.Flags().String("limit", "", "Number of results to return")
@@ -335,6 +383,7 @@ func init() {
 	ordersCmd.Flags().String("page", "", "Page number for pagination")
 	ordersCmd.Flags().String("limit", "", "Number of orders to return")
 	ordersCmd.Flags().String("include-tags", "", "Include user-defined tags: true/false")
+	ordersCmd.Flags().String("tag-prefix", "", "Only show orders whose tag starts with this prefix (fetches all pages)")
 
 	// Position group specific flags
 	createPositionGroupCmd.Flags().String("label", "", "Position group label (required)")
@@ -0,0 +1,276 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+)
+
+// maxConcurrentRequests caps how many API requests are in flight at once when fanning out
+// across accounts, symbols, or expirations, keeping us well inside Tradier's rate limits.
+const maxConcurrentRequests = 4
+
+// rateLimitRetries is how many times a request rejected by the rate limiter is retried.
+const rateLimitRetries = 3
+
+// rateLimitBackoff is the initial wait before retrying a rate-limited request.
+const rateLimitBackoff = time.Second
+
+// accountResponse is the raw API response for a single account in a multi-account view.
+type accountResponse struct {
+	AccountID string          `json:"account_id"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// aggregateResponse combines per-account responses with totals computed across all of them.
+type aggregateResponse struct {
+	Accounts []accountResponse    `json:"accounts"`
+	Totals   map[string]float64   `json:"totals,omitempty"`
+	Combined []aggregatedPosition `json:"combined,omitempty"`
+}
+
+// aggregatedPosition is a position summed across every account that holds the symbol.
+type aggregatedPosition struct {
+	Symbol    string   `json:"symbol"`
+	Quantity  float64  `json:"quantity"`
+	CostBasis float64  `json:"cost_basis"`
+	Accounts  []string `json:"accounts"`
+}
+
+// balanceTotalFields are the balance fields summed into the combined household totals.
+var balanceTotalFields = []string{
+	"total_equity", "total_cash", "market_value", "open_pl", "close_pl",
+	"stock_long_value", "option_long_value", "option_short_value", "short_market_value",
+}
+
+// profileAccountIDs returns every account number listed on the authenticated user's profile.
+func profileAccountIDs(c *client.Client) ([]string, error) {
+	data, err := c.GetProfile()
+	if err != nil {
+		return nil, err
+	}
+	p := nested(parseJSON(data), "profile")
+	if p == nil {
+		return nil, fmt.Errorf("unable to read accounts from user profile")
+	}
+
+	var ids []string
+	for _, a := range toSlice(p["account"]) {
+		if id := str(a, "account_number"); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no accounts found on user profile")
+	}
+	return ids, nil
+}
+
+// fetchForAccounts calls fetch for every account concurrently and returns results in the input order.
+// A failure for one account is recorded on its response rather than aborting the others.
+func fetchForAccounts(ids []string, fetch func(accountID string) ([]byte, error)) []accountResponse {
+	data, errs := fetchConcurrently(ids, fetch)
+	results := make([]accountResponse, len(ids))
+	for i, id := range ids {
+		results[i].AccountID = id
+		if errs[i] != nil {
+			results[i].Error = errs[i].Error()
+			continue
+		}
+		results[i].Data = data[i]
+	}
+	return results
+}
+
+// fetchConcurrently calls fetch for every key with at most maxConcurrentRequests in flight and
+// returns the responses and errors in input order. Rate-limited requests are retried with backoff.
+func fetchConcurrently(keys []string, fetch func(key string) ([]byte, error)) ([][]byte, []error) {
+	data := make([][]byte, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, maxConcurrentRequests)
+	var wg sync.WaitGroup
+
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			data[i], errs[i] = fetchWithRetry(key, fetch)
+		}(i, key)
+	}
+	wg.Wait()
+	return data, errs
+}
+
+// fetchWithRetry calls fetch, waiting and retrying while Tradier reports the rate limit was exceeded.
+// The wait doubles after each attempt, starting at rateLimitBackoff.
+func fetchWithRetry(key string, fetch func(key string) ([]byte, error)) ([]byte, error) {
+	wait := rateLimitBackoff
+	for attempt := 0; ; attempt++ {
+		data, err := fetch(key)
+		if err == nil || !client.IsRateLimited(err) || attempt == rateLimitRetries {
+			return data, err
+		}
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// aggregateBalances fetches balances for every profile account and sums the household totals.
+func aggregateBalances(c *client.Client) ([]byte, error) {
+	ids, err := profileAccountIDs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := aggregateResponse{
+		Accounts: fetchForAccounts(ids, c.GetBalances),
+		Totals:   map[string]float64{},
+	}
+	for _, a := range resp.Accounts {
+		b := nested(parseJSON(a.Data), "balances")
+		for _, field := range balanceTotalFields {
+			resp.Totals[field] += num(b, field)
+		}
+	}
+	warnAccountErrors(resp.Accounts)
+	return json.Marshal(resp)
+}
+
+// aggregatePositions fetches positions for every profile account and combines holdings by symbol.
+func aggregatePositions(c *client.Client) ([]byte, error) {
+	ids, err := profileAccountIDs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := aggregateResponse{Accounts: fetchForAccounts(ids, c.GetPositions)}
+	bySymbol := map[string]*aggregatedPosition{}
+	for _, a := range resp.Accounts {
+		for _, pos := range toSlice(nested(parseJSON(a.Data), "positions")["position"]) {
+			sym := str(pos, "symbol")
+			agg, ok := bySymbol[sym]
+			if !ok {
+				agg = &aggregatedPosition{Symbol: sym}
+				bySymbol[sym] = agg
+			}
+			agg.Quantity += num(pos, "quantity")
+			agg.CostBasis += num(pos, "cost_basis")
+			agg.Accounts = append(agg.Accounts, a.AccountID)
+		}
+	}
+
+	for _, agg := range bySymbol {
+		resp.Combined = append(resp.Combined, *agg)
+	}
+	sort.Slice(resp.Combined, func(i, j int) bool { return resp.Combined[i].Symbol < resp.Combined[j].Symbol })
+
+	warnAccountErrors(resp.Accounts)
+	return json.Marshal(resp)
+}
+
+// warnAccountErrors reports accounts that could not be fetched on stderr so stdout stays parseable.
+func warnAccountErrors(accounts []accountResponse) {
+	for _, a := range accounts {
+		if a.Error != "" {
+			fmt.Fprintf(os.Stderr, "Warning: account %s: %s\n", a.AccountID, a.Error)
+		}
+	}
+}
+
+// displayAggregateBalances renders one balance row per account followed by a combined total row.
+func displayAggregateBalances(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	headers := []string{"ACCOUNT", "TYPE", "TOTAL EQUITY", "TOTAL CASH", "MARKET VALUE", "OPEN P/L", "CLOSE P/L"}
+	var rows [][]string
+	for _, a := range toSlice(root["accounts"]) {
+		b := nested(a, "data", "balances")
+		if b == nil {
+			rows = append(rows, []string{str(a, "account_id"), "error", "", "", "", "", ""})
+			continue
+		}
+		rows = append(rows, []string{
+			str(a, "account_id"),
+			str(b, "account_type"),
+			money(num(b, "total_equity")),
+			money(num(b, "total_cash")),
+			money(num(b, "market_value")),
+			money(num(b, "open_pl")),
+			money(num(b, "close_pl")),
+		})
+	}
+
+	t := nested(root, "totals")
+	rows = append(rows, []string{
+		"TOTAL",
+		"",
+		money(num(t, "total_equity")),
+		money(num(t, "total_cash")),
+		money(num(t, "market_value")),
+		money(num(t, "open_pl")),
+		money(num(t, "close_pl")),
+	})
+	printTable(headers, rows)
+}
+
+// displayAggregatePositions renders positions per account, then holdings combined across accounts.
+func displayAggregatePositions(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	headers := []string{"ACCOUNT", "SYMBOL", "QTY", "COST BASIS", "DATE ACQUIRED"}
+	var rows [][]string
+	for _, a := range toSlice(root["accounts"]) {
+		for _, pos := range toSlice(nested(a, "data", "positions")["position"]) {
+			rows = append(rows, []string{
+				str(a, "account_id"),
+				formatOptionSymbol(str(pos, "symbol")),
+				str(pos, "quantity"),
+				money(num(pos, "cost_basis")),
+				shortDate(str(pos, "date_acquired")),
+			})
+		}
+	}
+	fmt.Println("By Account:")
+	printTable(headers, rows)
+
+	combined := toSlice(root["combined"])
+	if len(combined) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Combined:")
+	totalCost := 0.0
+	combinedRows := make([][]string, 0, len(combined)+1)
+	for _, p := range combined {
+		totalCost += num(p, "cost_basis")
+		combinedRows = append(combinedRows, []string{
+			formatOptionSymbol(str(p, "symbol")),
+			str(p, "quantity"),
+			money(num(p, "cost_basis")),
+			strings.Join(toStringSlice(p["accounts"]), ", "),
+		})
+	}
+	combinedRows = append(combinedRows, []string{"TOTAL", "", money(totalCost), ""})
+	printTable([]string{"SYMBOL", "QTY", "COST BASIS", "ACCOUNTS"}, combinedRows)
+}
@@ -0,0 +1,536 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloudmanic/tradier/alerts"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/filelock"
+	"github.com/spf13/cobra"
+)
+
+// alertsCmd is the parent command for alert rule management and the alert daemon.
+var alertsCmd = &cobra.Command{
+	Use:   "alerts",
+	Short: "Price and condition alerts",
+	Long: `Commands for storing alert rules and running a daemon that fires them.
+
+Rules are kept in ~/.config/tradier/alerts.json. Each watches one symbol:
+
+  price    Last trade price crosses a level
+  change   Percent change from the previous close
+  iv       Option mid implied volatility, in percent
+  spread   Bid/ask spread as a percent of the midpoint
+  pnl      Unrealized position P&L in dollars
+
+When a rule fires it can ring the terminal bell, run a shell command (with the details in
+TRADIER_ALERT_* environment variables), and POST the event as JSON to a webhook.`,
+}
+
+// addAlertCmd stores a new alert rule.
+var addAlertCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add an alert rule",
+	Long: `Add an alert rule. Give exactly one of --above or --below. Without --command or
+--webhook the rule rings the terminal bell.
+
+Examples:
+  tradier alerts add --symbol AAPL --type price --above 250
+  tradier alerts add --symbol SPY --type change --below -2 --webhook https://hooks.example.com/spy
+  tradier alerts add --symbol SPY251219P00550000 --type iv --above 30 --once
+  tradier alerts add --symbol TSLA --type spread --above 0.5 --note "book thinning"
+  tradier alerts add --symbol NVDA --type pnl --below -1000 --command 'notify-send "$TRADIER_ALERT_MESSAGE"'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		symbol, _ := cmd.Flags().GetString("symbol")
+		kind, _ := cmd.Flags().GetString("type")
+		bell, _ := cmd.Flags().GetBool("bell")
+		command, _ := cmd.Flags().GetString("command")
+		webhook, _ := cmd.Flags().GetString("webhook")
+		once, _ := cmd.Flags().GetBool("once")
+		note, _ := cmd.Flags().GetString("note")
+
+		above, below := cmd.Flags().Changed("above"), cmd.Flags().Changed("below")
+		if above == below {
+			return fmt.Errorf("exactly one of --above or --below is required")
+		}
+		level, _ := cmd.Flags().GetFloat64("below")
+		if above {
+			level, _ = cmd.Flags().GetFloat64("above")
+		}
+
+		rule := alerts.Rule{
+			Symbol:  strings.ToUpper(strings.TrimSpace(symbol)),
+			Kind:    alerts.Kind(strings.ToLower(kind)),
+			Above:   above,
+			Level:   level,
+			Bell:    bell || (command == "" && webhook == ""),
+			Command: command,
+			Webhook: webhook,
+			Once:    once,
+			Note:    note,
+			Created: time.Now(),
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		path, err := alerts.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		rules, err := alerts.Load(path)
+		if err != nil {
+			return err
+		}
+		rules, rule = alerts.Add(rules, rule)
+		if err := alerts.Save(path, rules); err != nil {
+			return err
+		}
+		return printAlerts([]alerts.Rule{rule})
+	},
+}
+
+// listAlertsCmd prints the stored alert rules.
+var listAlertsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List alert rules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := alerts.DefaultPath()
+		if err != nil {
+			return err
+		}
+		rules, err := alerts.Load(path)
+		if err != nil {
+			return err
+		}
+		return printAlerts(rules)
+	},
+}
+
+// removeAlertCmd deletes a stored alert rule.
+var removeAlertCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove an alert rule by ID",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt("id")
+		if id <= 0 {
+			return fmt.Errorf("--id is required")
+		}
+		path, err := alerts.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		rules, err := alerts.Load(path)
+		if err != nil {
+			return err
+		}
+		rules, found := alerts.Remove(rules, id)
+		if !found {
+			return fmt.Errorf("no alert with ID %d", id)
+		}
+		if err := alerts.Save(path, rules); err != nil {
+			return err
+		}
+		fmt.Printf("Removed alert %d\n", id)
+		return nil
+	},
+}
+
+// runAlertsCmd evaluates alert rules against live quotes until interrupted.
+var runAlertsCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Watch quotes and fire alerts until interrupted",
+	Long: `Poll quotes for every symbol with an active alert and fire rules as their conditions turn true.
+
+Alerts are edge triggered: a rule fires when its condition becomes true and re-arms once it
+turns false again. Price alerts fire on a cross, so a price already beyond the level when the
+daemon starts does not fire until it crosses back and through. Rules marked --once are retired
+after firing. The rules file is re-read on every poll, so alerts added or removed while the
+daemon runs take effect at the next poll.
+
+With --stream, trades and quotes from Tradier's market event stream update prices between
+polls (not available in the sandbox). Polling continues for implied volatility and position
+P&L, and picks up symbols added after the stream started.
+
+With --json, each fired alert is printed as one JSON object per line.
+
+Examples:
+  tradier alerts run
+  tradier alerts run --interval 5s --stream
+  tradier alerts run --json >> alerts.log`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		interval, _ := cmd.Flags().GetDuration("interval")
+		stream, _ := cmd.Flags().GetBool("stream")
+		if interval < time.Second {
+			return fmt.Errorf("--interval must be at least 1s")
+		}
+		if stream && sandboxMode {
+			return fmt.Errorf("--stream is not available in the sandbox")
+		}
+
+		path, err := alerts.DefaultPath()
+		if err != nil {
+			return err
+		}
+		m := &alertMonitor{c: c, path: path, engine: alerts.NewEngine(), positions: map[string]alertPosition{}}
+		if err := m.reload(); err != nil {
+			return err
+		}
+		if len(m.rules) == 0 {
+			return fmt.Errorf("no active alerts (add one with 'tradier alerts add')")
+		}
+		// P&L rules may also be added while the daemon runs, so resolve the account up front
+		if m.accountID, err = requireAccountID(cmd, cfg); err != nil && m.needs(alerts.KindPnL) {
+			return err
+		}
+
+		mode := fmt.Sprintf("polling every %s", interval)
+		if stream {
+			mode += " and streaming trades and quotes"
+		}
+		fmt.Fprintf(os.Stderr, "Watching %d alerts on %s (%s). Press Ctrl+C to stop.\n",
+			len(m.rules), strings.Join(m.symbols(), ", "), mode)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := m.poll(); err != nil {
+			return err
+		}
+		if stream {
+			go streamQuotes(ctx, m.c, m.symbols, interval, m.applyEvent)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				if err := m.poll(); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+			}
+		}
+	},
+}
+
+// alertPosition is the part of an open position needed to value its P&L from a quote.
+type alertPosition struct {
+	quantity   float64
+	costBasis  float64
+	multiplier float64
+}
+
+// alertMonitor holds the daemon's rules and the latest snapshot per symbol. Polling and the
+// stream update snapshots from different goroutines, so every field is guarded by mu.
+type alertMonitor struct {
+	c         *client.Client
+	path      string
+	accountID string
+	engine    *alerts.Engine
+
+	mu        sync.Mutex
+	rules     []alerts.Rule
+	snaps     map[string]alerts.Snapshot
+	positions map[string]alertPosition
+}
+
+// reload reads the active rules from disk.
+func (m *alertMonitor) reload() error {
+	rules, err := alerts.Load(m.path)
+	if err != nil {
+		return err
+	}
+	active := rules[:0]
+	for _, r := range rules {
+		if !r.Done() {
+			active = append(active, r)
+		}
+	}
+	m.mu.Lock()
+	m.rules = active
+	m.mu.Unlock()
+	return nil
+}
+
+// needs reports whether any active rule is of the given kind.
+func (m *alertMonitor) needs(kind alerts.Kind) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.rules {
+		if r.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// symbols returns the sorted, unique symbols of the active rules.
+func (m *alertMonitor) symbols() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	seen := map[string]bool{}
+	var out []string
+	for _, r := range m.rules {
+		if !seen[r.Symbol] {
+			seen[r.Symbol] = true
+			out = append(out, r.Symbol)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// poll reloads the rules, refreshes every snapshot from GetQuotes (and positions for P&L
+// rules), and fires any rules whose conditions turned true.
+func (m *alertMonitor) poll() error {
+	if err := m.reload(); err != nil {
+		return err
+	}
+	symbols := m.symbols()
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	greeks := ""
+	if m.needs(alerts.KindIV) {
+		greeks = "true"
+	}
+	data, err := m.c.GetQuotes(strings.Join(symbols, ","), greeks)
+	if err != nil {
+		return fmt.Errorf("quotes: %w", err)
+	}
+	positions := map[string]alertPosition{}
+	if m.needs(alerts.KindPnL) && m.accountID != "" {
+		posData, err := m.c.GetPositions(m.accountID)
+		if err != nil {
+			return fmt.Errorf("positions: %w", err)
+		}
+		for _, p := range toSlice(nested(parseJSON(posData), "positions")["position"]) {
+			positions[str(p, "symbol")] = alertPosition{quantity: num(p, "quantity"), costBasis: num(p, "cost_basis")}
+		}
+	}
+
+	snaps := map[string]alerts.Snapshot{}
+	for _, q := range toSlice(nested(parseJSON(data), "quotes")["quote"]) {
+		symbol := str(q, "symbol")
+		snaps[symbol] = alerts.Snapshot{
+			Last:      num(q, "last"),
+			PrevClose: num(q, "prevclose"),
+			Bid:       num(q, "bid"),
+			Ask:       num(q, "ask"),
+			IV:        num(nested(q, "greeks"), "mid_iv"),
+		}
+		if p, ok := positions[symbol]; ok {
+			p.multiplier = contractMultiplier(symbol, q)
+			positions[symbol] = p
+		}
+	}
+
+	m.mu.Lock()
+	m.positions = positions
+	m.snaps = snaps
+	for symbol := range snaps {
+		m.valuePosition(symbol)
+	}
+	m.mu.Unlock()
+	m.check()
+	return nil
+}
+
+// valuePosition sets the P&L on a symbol's snapshot from its position, if any. Callers hold mu.
+func (m *alertMonitor) valuePosition(symbol string) {
+	p, ok := m.positions[symbol]
+	s := m.snaps[symbol]
+	mark := s.Last
+	if s.Bid > 0 && s.Ask > 0 {
+		mark = (s.Bid + s.Ask) / 2
+	}
+	if !ok || mark <= 0 {
+		return
+	}
+	s.PnL = mark*p.quantity*p.multiplier - p.costBasis
+	s.HasPnL = true
+	m.snaps[symbol] = s
+}
+
+// check evaluates the rules, reports and dispatches each fired alert, and records firings
+// in the rules file.
+func (m *alertMonitor) check() {
+	m.mu.Lock()
+	events := m.engine.Check(m.rules, m.snaps, time.Now())
+	m.mu.Unlock()
+	if len(events) == 0 {
+		return
+	}
+
+	for _, ev := range events {
+		if jsonOutput {
+			line, _ := json.Marshal(ev)
+			fmt.Println(string(line))
+		} else {
+			fmt.Printf("%s  #%d  %s\n", ev.Time.Format("2006-01-02 15:04:05"), ev.Rule.ID, ev.Message)
+		}
+		if err := alerts.Dispatch(ev, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+
+	if err := m.markFired(events); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	if err := m.reload(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// markFired records firings in the rules file under its file lock, re-reading it first so rules
+// added or removed while the daemon runs are kept.
+func (m *alertMonitor) markFired(events []alerts.Event) error {
+	unlock, err := filelock.Lock(m.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	rules, err := alerts.Load(m.path)
+	if err != nil {
+		return err
+	}
+	return alerts.Save(m.path, alerts.MarkFired(rules, events))
+}
+
+// applyEvent updates a snapshot from a stream trade or quote event and re-checks the rules.
+func (m *alertMonitor) applyEvent(ev map[string]interface{}) {
+	symbol := str(ev, "symbol")
+	m.mu.Lock()
+	s, ok := m.snaps[symbol]
+	if ok {
+		switch str(ev, "type") {
+		case "trade":
+			if price := eventNumber(ev, "price"); price > 0 {
+				s.Last = price
+			}
+		case "quote":
+			s.Bid, s.Ask = eventNumber(ev, "bid"), eventNumber(ev, "ask")
+		}
+		m.snaps[symbol] = s
+		m.valuePosition(symbol)
+	}
+	m.mu.Unlock()
+	if ok {
+		m.check()
+	}
+}
+
+// printAlerts outputs rules as a table or, with --json, as {"alerts": [...]}.
+func printAlerts(rules []alerts.Rule) error {
+	if rules == nil {
+		rules = []alerts.Rule{}
+	}
+	out, err := json.Marshal(map[string]interface{}{"alerts": rules})
+	if err != nil {
+		return err
+	}
+	printResult(out, displayAlerts)
+	return nil
+}
+
+// displayAlerts renders stored alert rules as a table.
+func displayAlerts(data []byte) {
+	var out struct {
+		Alerts []alerts.Rule `json:"alerts"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	if len(out.Alerts) == 0 {
+		fmt.Println("No alerts.")
+		return
+	}
+
+	var rows [][]string
+	for _, r := range out.Alerts {
+		var actions []string
+		if r.Bell {
+			actions = append(actions, "bell")
+		}
+		if r.Command != "" {
+			actions = append(actions, "command")
+		}
+		if r.Webhook != "" {
+			actions = append(actions, "webhook")
+		}
+		if r.Once {
+			actions = append(actions, "once")
+		}
+		fired := "-"
+		if !r.FiredAt.IsZero() {
+			fired = r.FiredAt.Local().Format("2006-01-02 15:04")
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(r.ID),
+			r.Symbol,
+			string(r.Kind),
+			r.Condition(),
+			strings.Join(actions, ", "),
+			fired,
+			r.Note,
+		})
+	}
+	printTable([]string{"ID", "Symbol", "Type", "Condition", "Actions", "Last Fired", "Note"}, rows)
+}
+
+func init() {
+	// Add alert flags
+	addAlertCmd.Flags().String("symbol", "", "Symbol to watch (required)")
+	addAlertCmd.Flags().String("type", "price", "Alert type: price, change, iv, spread, pnl")
+	addAlertCmd.Flags().Float64("above", 0, "Fire when the value rises to or above this level")
+	addAlertCmd.Flags().Float64("below", 0, "Fire when the value falls below this level")
+	addAlertCmd.Flags().Bool("bell", false, "Ring the terminal bell (default when no other action is given)")
+	addAlertCmd.Flags().String("command", "", "Shell command to run when the alert fires")
+	addAlertCmd.Flags().String("webhook", "", "URL to POST the alert event to as JSON")
+	addAlertCmd.Flags().Bool("once", false, "Retire the alert after it fires")
+	addAlertCmd.Flags().String("note", "", "Note included in the alert message")
+
+	// Remove alert flags
+	removeAlertCmd.Flags().Int("id", 0, "Alert ID (required)")
+
+	// Run flags
+	runAlertsCmd.Flags().Duration("interval", 15*time.Second, "How often to poll quotes")
+	runAlertsCmd.Flags().Bool("stream", false, "Also apply trades and quotes from the market event stream")
+	runAlertsCmd.Flags().String("account-id", "", "Account for pnl alerts (defaults to config)")
+
+	// Build command tree
+	alertsCmd.AddCommand(addAlertCmd, listAlertsCmd, removeAlertCmd, runAlertsCmd)
+	rootCmd.AddCommand(alertsCmd)
+}
@@ -0,0 +1,414 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/backtest"
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/cache"
+	"github.com/cloudmanic/tradier/chart"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/strategy"
+	"github.com/spf13/cobra"
+)
+
+// backtestIntervals are the bar intervals a backtest can replay, with their widths (zero for daily).
+var backtestIntervals = map[string]time.Duration{"daily": 0, "1min": time.Minute, "5min": 5 * time.Minute, "15min": 15 * time.Minute}
+
+// backtestReport is the result of a backtest with the settings it ran with.
+type backtestReport struct {
+	Strategy string   `json:"strategy"`
+	Symbols  []string `json:"symbols"`
+	Interval string   `json:"interval"`
+	Warmup   int      `json:"warmup_bars"`
+	*backtest.Result
+}
+
+// backtestCmd is the parent command for backtesting strategies.
+var backtestCmd = &cobra.Command{
+	Use:   "backtest",
+	Short: "Backtest strategies on historical bars",
+	Long:  "Commands for testing trading strategies against historical price bars before risking money.",
+}
+
+// runBacktestCmd replays historical bars through a strategy.
+var runBacktestCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Replay historical bars through a strategy and report the results",
+	Long: `Replay daily or intraday bars through a strategy and report its trades, equity curve,
+drawdown, and statistics. Bars come from historical pricing or time and sales and are kept in
+the local bar cache, along with enough history before --start for the strategy's indicators.
+
+The strategy is a YAML rules file or a Go plugin (.so). A rules file lists conditions and the
+order each places, written like an order file, with quantity, price, and stop allowed to be
+expressions:
+
+  name: sma-cross
+  interval: daily
+  rules:
+    - name: enter
+      when: close > sma_50 and prev_close <= prev_sma_50 and position == 0 and open_orders == 0
+      order: {side: buy, quantity: floor(cash * 0.95 / close), type: market, duration: day}
+    - name: exit
+      when: position > 0 and close < sma_50
+      order: {side: sell, quantity: position, type: market, duration: day}
+
+Conditions use the markets scan expression syntax over open, high, low, close, volume, indicator
+columns (sma_50, ema_20, rsi_14, macd_hist, bb_upper_20_2, atr_14, vwap), prev_ versions of each,
+position, avg_price, open_orders, cash, equity, time (HHMM Eastern), and symbol. Prefix a field
+with another symbol to read its bars, as in spy.close > spy.sma_200.
+
+A plugin is built with 'go build -buildmode=plugin' from a main package exporting
+'func New() backtest.Strategy', whose OnBar places orders with the same Tradier order parameters
+as 'tradier trading place', or 'func New() strategy.Strategy' for a strategy that also runs live
+with 'tradier strategy run'; its OnTimer is called at bar closes every --timer. Plugins need a
+tradier binary built with cgo on Linux or macOS.
+
+Orders are placed in a simulated paper account and can fill from the bar after the one that
+placed them: each bar is walked from the open through its high and low to the close, so market
+orders fill at the next open, limits at their price, and stops where they are crossed or at the
+open after a gap. Commissions follow the paper account's schedule.
+
+Examples:
+  tradier backtest run --strategy sma-cross.yaml --symbols SPY --start 2020-01-01 --end 2025-12-31
+  tradier backtest run --strategy rsi.yaml --symbols AAPL,MSFT,NVDA --start 2024-01-01 --cash 25000
+  tradier backtest run --strategy opening-range.yaml --symbols QQQ --interval 5min --start 2026-09-01
+  tradier backtest run --strategy ./momentum.so --symbols SPY,QQQ --start 2023-01-01 --equity-csv equity.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		strategyPath, _ := cmd.Flags().GetString("strategy")
+		symbolList, _ := cmd.Flags().GetString("symbols")
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+		equityCSV, _ := cmd.Flags().GetString("equity-csv")
+		if strategyPath == "" || symbolList == "" || start == "" {
+			return fmt.Errorf("--strategy, --symbols, and --start are required")
+		}
+
+		var strat backtest.Strategy
+		var rules *backtest.Rules
+		if strings.EqualFold(filepath.Ext(strategyPath), ".so") {
+			timer, _ := cmd.Flags().GetDuration("timer")
+			if strat, err = strategy.LoadBacktestPlugin(strategyPath, timer); err != nil {
+				return err
+			}
+		} else {
+			if rules, err = backtest.LoadRules(strategyPath); err != nil {
+				return err
+			}
+			strat = rules
+		}
+
+		cfg, interval, err := backtestConfig(cmd, rules)
+		if err != nil {
+			return err
+		}
+		for _, s := range strings.Split(symbolList, ",") {
+			if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+				cfg.Symbols = append(cfg.Symbols, s)
+			}
+		}
+
+		eastern := pricing.Eastern()
+		if cfg.Start, err = time.ParseInLocation("2006-01-02", start, eastern); err != nil {
+			return fmt.Errorf("invalid --start %q (YYYY-MM-DD)", start)
+		}
+		if end == "" {
+			end = time.Now().In(eastern).Format("2006-01-02")
+		}
+		endDay, err := time.ParseInLocation("2006-01-02", end, eastern)
+		if err != nil {
+			return fmt.Errorf("invalid --end %q (YYYY-MM-DD)", end)
+		}
+		if endDay.Before(cfg.Start) {
+			return fmt.Errorf("--end must not be before --start")
+		}
+		cfg.End = endDay.Add(24*time.Hour - time.Second)
+
+		warmup := 0
+		if w, ok := strat.(backtest.Warmup); ok {
+			warmup = w.Lookback()
+		}
+		if cmd.Flags().Changed("warmup") {
+			warmup, _ = cmd.Flags().GetInt("warmup")
+		}
+		from := warmupStart(cfg.Start, warmup, cfg.Interval)
+
+		store := openCache(cmd)
+		load := func(symbol string) ([]bars.Bar, error) {
+			return backtestBars(c, store, symbol, interval, from, endDay)
+		}
+		cfg.Bars = map[string][]bars.Bar{}
+		for _, symbol := range cfg.Symbols {
+			b, err := load(symbol)
+			if err != nil {
+				return fmt.Errorf("bars for %s: %w", symbol, err)
+			}
+			if len(b) == 0 {
+				return fmt.Errorf("no price data found for %s", symbol)
+			}
+			cfg.Bars[symbol] = b
+		}
+		cfg.Fetch = load
+
+		res, err := backtest.Run(cfg, strat)
+		if err != nil {
+			return err
+		}
+		if equityCSV != "" {
+			if err := writeEquityCSV(equityCSV, res.Equity); err != nil {
+				return err
+			}
+		}
+
+		name := strategyPath
+		if rules != nil && rules.Name != "" {
+			name = rules.Name
+		}
+		out, err := json.Marshal(backtestReport{Strategy: name, Symbols: cfg.Symbols, Interval: interval, Warmup: warmup, Result: res})
+		if err != nil {
+			return err
+		}
+		limit, _ := cmd.Flags().GetInt("trades")
+		printResult(out, func(data []byte) { displayBacktest(data, limit) })
+		return nil
+	},
+}
+
+// backtestConfig returns the interval, cash, and commissions for a backtest: the flags when
+// set, then the rules file's settings, then the paper account defaults.
+func backtestConfig(cmd *cobra.Command, rules *backtest.Rules) (backtest.Config, string, error) {
+	cfg := backtest.Config{Cash: paper.DefaultCash, Commission: paper.DefaultCommission}
+	interval := "daily"
+	if rules != nil {
+		if rules.Interval != "" {
+			interval = rules.Interval
+		}
+		if rules.Cash > 0 {
+			cfg.Cash = rules.Cash
+		}
+		if rules.Commission != nil {
+			cfg.Commission = *rules.Commission
+		}
+	}
+
+	if cmd.Flags().Changed("interval") {
+		interval, _ = cmd.Flags().GetString("interval")
+	}
+	if cmd.Flags().Changed("cash") {
+		cfg.Cash, _ = cmd.Flags().GetFloat64("cash")
+	}
+	if cmd.Flags().Changed("commission-per-order") {
+		cfg.Commission.PerOrder, _ = cmd.Flags().GetFloat64("commission-per-order")
+	}
+	if cmd.Flags().Changed("commission-per-contract") {
+		cfg.Commission.PerContract, _ = cmd.Flags().GetFloat64("commission-per-contract")
+	}
+
+	width, ok := backtestIntervals[interval]
+	if !ok {
+		return cfg, "", fmt.Errorf("invalid interval %q (daily, 1min, 5min, 15min)", interval)
+	}
+	if cfg.Cash <= 0 {
+		return cfg, "", fmt.Errorf("starting cash must be positive")
+	}
+	cfg.Interval = width
+	return cfg, interval, nil
+}
+
+// warmupStart returns the first day to fetch so that bars of history precede start, allowing
+// for weekends and holidays.
+func warmupStart(start time.Time, bars int, interval time.Duration) time.Time {
+	if bars <= 0 {
+		return start
+	}
+	days := bars
+	if interval > 0 {
+		perDay := int((390 * time.Minute) / interval)
+		days = (bars + perDay - 1) / perDay
+	}
+	// Roughly 252 trading days a year, plus room for holidays
+	return start.AddDate(0, 0, -(days*365/252 + 10))
+}
+
+// backtestBars fetches a symbol's bars for the backtest from the bar cache: daily history, or
+// regular-session time and sales for intraday intervals.
+func backtestBars(c *client.Client, store *cache.Store, symbol, interval string, from, to time.Time) ([]bars.Bar, error) {
+	if interval == "daily" {
+		return fetchBars(c, store, symbol, interval, from.Format("2006-01-02"), to.Format("2006-01-02"), "")
+	}
+	return fetchBars(c, store, symbol, interval, from.Format("2006-01-02")+" 00:00", to.Format("2006-01-02")+" 23:59", "open")
+}
+
+// writeEquityCSV writes the equity curve to a CSV file.
+func writeEquityCSV(path string, equity []backtest.EquityPoint) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %w", path, err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"time", "equity", "cash", "drawdown", "positions"})
+	for _, p := range equity {
+		w.Write([]string{
+			p.Time.Format(time.RFC3339),
+			strconv.FormatFloat(p.Equity, 'f', 2, 64),
+			strconv.FormatFloat(p.Cash, 'f', 2, 64),
+			strconv.FormatFloat(p.Drawdown, 'f', 6, 64),
+			strconv.Itoa(p.Positions),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("unable to write %s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// displayBacktest renders the statistics, the most recent limit trades (all when limit is 0),
+// the log, and an equity chart.
+func displayBacktest(data []byte, limit int) {
+	var r backtestReport
+	if err := json.Unmarshal(data, &r); err != nil || r.Result == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	eastern := pricing.Eastern()
+	layout := "2006-01-02"
+	if r.Interval != "daily" {
+		layout = "2006-01-02 15:04"
+	}
+	when := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.In(eastern).Format(layout)
+	}
+
+	st := r.Stats
+	fmt.Printf("Backtest: %s on %s, %s bars, %s to %s\n\n", r.Strategy, strings.Join(r.Symbols, ", "), r.Interval, when(r.Start), when(r.End))
+	drawdown := pct(st.MaxDrawdown * 100)
+	if !st.DrawdownPeak.IsZero() {
+		drawdown = fmt.Sprintf("%s (%s to %s)", drawdown, when(st.DrawdownPeak), when(st.DrawdownTrough))
+	}
+	profitFactor := "-"
+	if st.ProfitFactor > 0 {
+		profitFactor = fmt.Sprintf("%.2f", st.ProfitFactor)
+	}
+	pairs := [][2]string{
+		{"Starting Cash", money(st.StartingCash)},
+		{"Final Equity", money(st.FinalEquity)},
+		{"Net Profit", money(st.NetProfit)},
+		{"Total Return", pct(st.TotalReturn * 100)},
+		{"Annualized Return", pct(st.AnnualizedReturn * 100)},
+		{"Max Drawdown", drawdown},
+		{"Volatility (ann.)", fmt.Sprintf("%.2f%%", st.Volatility*100)},
+		{"Sharpe Ratio", fmt.Sprintf("%.2f", st.Sharpe)},
+		{"Closed Trades", strconv.Itoa(st.Trades)},
+		{"Win Rate", fmt.Sprintf("%.1f%% (%d won, %d lost)", st.WinRate*100, st.Wins, st.Losses)},
+		{"Profit Factor", profitFactor},
+		{"Average Win / Loss", fmt.Sprintf("%s / %s", money(st.AverageWin), money(st.AverageLoss))},
+		{"Largest Win / Loss", fmt.Sprintf("%s / %s", money(st.LargestWin), money(st.LargestLoss))},
+		{"Average Hold", fmt.Sprintf("%.1f days", st.AverageHoldDays)},
+		{"Exposure", fmt.Sprintf("%.1f%%", st.Exposure*100)},
+		{"Commissions", money(st.Commission)},
+	}
+	for _, symbol := range r.Symbols {
+		if ret, ok := st.BuyAndHold[symbol]; ok {
+			pairs = append(pairs, [2]string{"Buy & Hold " + symbol, pct(ret * 100)})
+		}
+	}
+	printKV(pairs)
+
+	trades := r.Trades
+	if len(trades) > 0 {
+		fmt.Println()
+		if limit > 0 && len(trades) > limit {
+			fmt.Printf("Last %d of %d trades (--trades 0 for all):\n", limit, len(trades))
+			trades = trades[len(trades)-limit:]
+		}
+		var rows [][]string
+		for _, t := range trades {
+			exit := when(t.ExitTime)
+			if t.Open {
+				exit = "open"
+			}
+			rows = append(rows, []string{
+				formatOptionSymbol(t.Symbol),
+				t.Side,
+				strconv.FormatFloat(t.Quantity, 'f', -1, 64),
+				when(t.EntryTime),
+				money(t.EntryPrice),
+				exit,
+				money(t.ExitPrice),
+				money(t.PnL),
+				pct(t.Return * 100),
+				t.Tag,
+			})
+		}
+		printTable([]string{"Symbol", "Side", "Qty", "Entry", "Entry Price", "Exit", "Exit Price", "P&L", "Return", "Tag"}, rows)
+	}
+
+	if len(r.Log) > 0 {
+		fmt.Println()
+		shown := r.Log
+		if len(shown) > 10 {
+			fmt.Printf("Log (last 10 of %d entries; --json for all):\n", len(shown))
+			shown = shown[len(shown)-10:]
+		} else {
+			fmt.Println("Log:")
+		}
+		for _, entry := range shown {
+			fmt.Printf("  %s  %s\n", when(entry.Time), entry.Message)
+		}
+	}
+
+	if len(r.Equity) > 1 {
+		values := make([]float64, len(r.Equity))
+		for i, p := range r.Equity {
+			values[i] = p.Equity
+		}
+		fmt.Println()
+		fmt.Println("Equity:")
+		fmt.Print(chart.Line(chart.Options{
+			XLabels: []string{when(r.Equity[0].Time), when(r.Equity[len(r.Equity)-1].Time)},
+		}, chart.Series{Name: "Equity", Values: values, Glyph: '*'}))
+	}
+}
+
+func init() {
+	runBacktestCmd.Flags().String("strategy", "", "Strategy rules file (.yaml) or Go plugin (.so) (required)")
+	runBacktestCmd.Flags().String("symbols", "", "Comma-separated symbols to replay (required)")
+	runBacktestCmd.Flags().String("start", "", "First day to trade, YYYY-MM-DD (required)")
+	runBacktestCmd.Flags().String("end", "", "Last day to trade, YYYY-MM-DD (defaults to today)")
+	runBacktestCmd.Flags().String("interval", "daily", "Bar interval: daily, 1min, 5min, 15min (overrides the strategy file)")
+	runBacktestCmd.Flags().Float64("cash", paper.DefaultCash, "Starting cash (overrides the strategy file)")
+	runBacktestCmd.Flags().Float64("commission-per-order", paper.DefaultCommission.PerOrder, "Commission per equity order (overrides the strategy file)")
+	runBacktestCmd.Flags().Float64("commission-per-contract", paper.DefaultCommission.PerContract, "Commission per option contract (overrides the strategy file)")
+	runBacktestCmd.Flags().Int("warmup", 0, "Bars of history to load before --start (defaults to what the strategy's indicators need)")
+	runBacktestCmd.Flags().Duration("timer", 0, "How often a strategy.Strategy plugin's OnTimer is called, at bar closes (0 for never)")
+	runBacktestCmd.Flags().Int("trades", 20, "Number of most recent trades to show (0 for all)")
+	runBacktestCmd.Flags().String("equity-csv", "", "Write the equity curve to this CSV file")
+	addCacheFlags(runBacktestCmd)
+
+	// Build command tree
+	backtestCmd.AddCommand(runBacktestCmd)
+	rootCmd.AddCommand(backtestCmd)
+}
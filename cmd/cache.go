@@ -0,0 +1,152 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/cache"
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// cachedIntradayIntervals are the timesales intervals kept in the bar cache. Ticks are too
+// large to be worth storing.
+var cachedIntradayIntervals = map[string]bool{"1min": true, "5min": true, "15min": true}
+
+// addCacheFlags registers the --no-cache flag on a command that reads from the bar cache.
+func addCacheFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-cache", false, "Fetch directly from the API without reading or updating the local bar cache")
+}
+
+// openCache returns the local bar cache, or nil when --no-cache is set or no cache directory is available.
+// Sandbox data is delayed, so it is cached apart from production data in a sandbox subdirectory.
+func openCache(cmd *cobra.Command) *cache.Store {
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		return nil
+	}
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil
+	}
+	if sandboxMode {
+		dir = filepath.Join(dir, "sandbox")
+	}
+	return cache.New(dir)
+}
+
+// fetchHistory returns historical pricing in the history endpoint's shape. Daily bars with a
+// start date are served from the cache, fetching only the days it is missing.
+func fetchHistory(c *client.Client, store *cache.Store, symbol, interval, start, end string) ([]byte, error) {
+	from, errFrom := time.ParseInLocation("2006-01-02", start, pricing.Eastern())
+	if store == nil || (interval != "" && interval != "daily") || errFrom != nil {
+		return c.GetHistoricalPricing(symbol, interval, start, end)
+	}
+	to, err := time.ParseInLocation("2006-01-02", end, pricing.Eastern())
+	if err != nil {
+		to = time.Now()
+	}
+
+	key := cache.Key{Symbol: symbol, Interval: "daily"}
+	b, err := store.Bars(key, from, to, time.Now(), func(from, to time.Time) ([]bars.Bar, error) {
+		data, err := c.GetHistoricalPricing(symbol, "daily", from.Format("2006-01-02"), to.Format("2006-01-02"))
+		if err != nil {
+			return nil, err
+		}
+		return bars.ParseHistory(data)
+	}, tradingDays(marketCalendar(c, store)))
+	if err != nil {
+		return nil, err
+	}
+	return historyJSON(b)
+}
+
+// fetchTimeSales returns time and sales data in the timesales endpoint's shape. Minute bars with
+// a start time are served from the cache, which stores whole days and fetches only missing ones.
+func fetchTimeSales(c *client.Client, store *cache.Store, symbol, interval, start, end, sessionFilter string) ([]byte, error) {
+	from, errFrom := parseDateTime(start)
+	if store == nil || !cachedIntradayIntervals[interval] || errFrom != nil {
+		return c.GetTimeSales(symbol, interval, start, end, sessionFilter)
+	}
+	to, err := parseDateTime(end)
+	if err != nil {
+		to = time.Now()
+	}
+	if sessionFilter == "" {
+		sessionFilter = "all"
+	}
+
+	key := cache.Key{Symbol: symbol, Interval: interval, Filter: sessionFilter}
+	b, err := store.Bars(key, from, to, time.Now(), func(from, to time.Time) ([]bars.Bar, error) {
+		data, err := c.GetTimeSales(symbol, interval, from.Format("2006-01-02")+" 00:00", to.Format("2006-01-02")+" 23:59", sessionFilter)
+		if err != nil {
+			return nil, err
+		}
+		return bars.ParseTimeSales(data)
+	}, tradingDays(marketCalendar(c, store)))
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache works in whole days, so trim to the requested times
+	var out []bars.Bar
+	for _, bar := range b {
+		if !bar.Time.Before(from) && (end == "" || !bar.Time.After(to)) {
+			out = append(out, bar)
+		}
+	}
+	return timeSalesJSON(out)
+}
+
+// parseDateTime parses a timesales start or end, either "YYYY-MM-DD HH:MM" or a bare date, in Eastern time.
+func parseDateTime(s string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04", strings.TrimSpace(s), pricing.Eastern()); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", strings.TrimSpace(s), pricing.Eastern())
+}
+
+// marketCalendar returns the market calendar, reading each month from the cache when there is one.
+func marketCalendar(c *client.Client, store *cache.Store) *calendar.Calendar {
+	return calendar.New(func(year int, month time.Month) ([]byte, error) {
+		fetch := func() ([]byte, error) {
+			return c.GetCalendar(strconv.Itoa(int(month)), strconv.Itoa(year))
+		}
+		if store == nil {
+			return fetch()
+		}
+		return store.Calendar(year, month, time.Now(), fetch)
+	})
+}
+
+// tradingDays reports whether any trading day falls within a range of days.
+func tradingDays(cal *calendar.Calendar) cache.TradingDays {
+	return func(from, to time.Time) (bool, error) {
+		n, err := cal.TradingDaysBetween(from, to)
+		return n > 0, err
+	}
+}
+
+// historyJSON encodes bars in the history endpoint's response shape.
+func historyJSON(b []bars.Bar) ([]byte, error) {
+	days := make([]map[string]interface{}, 0, len(b))
+	for _, bar := range b {
+		days = append(days, map[string]interface{}{
+			"date":   bar.Time.Format("2006-01-02"),
+			"open":   bar.Open,
+			"high":   bar.High,
+			"low":    bar.Low,
+			"close":  bar.Close,
+			"volume": bar.Volume,
+		})
+	}
+	return json.Marshal(map[string]interface{}{"history": map[string]interface{}{"day": days}})
+}
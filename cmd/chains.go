@@ -0,0 +1,76 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/chain"
+)
+
+// analyzedChain rebuilds an options chain response from filtered contracts, keeping every field
+// Tradier returned and adding computed mid, spread, intrinsic/extrinsic value, and probability ITM.
+// The underlying price used for the calculations is included alongside the options.
+func analyzedChain(symbol string, spot float64, contracts []chain.Contract, now time.Time) map[string]interface{} {
+	options := make([]map[string]interface{}, 0, len(contracts))
+	for _, c := range contracts {
+		opt := map[string]interface{}{}
+		for k, v := range c.Raw {
+			opt[k] = v
+		}
+		opt["mid"] = c.Mid()
+		if sp := c.SpreadPct(); sp >= 0 {
+			opt["spread_pct"] = sp
+		}
+		opt["intrinsic"] = c.Intrinsic(spot)
+		opt["extrinsic"] = c.Extrinsic(spot)
+		if p := c.ProbITM(spot, now); p >= 0 {
+			opt["prob_itm"] = p
+		}
+		options = append(options, opt)
+	}
+
+	return map[string]interface{}{
+		"underlying": map[string]interface{}{"symbol": symbol, "price": spot},
+		"options":    map[string]interface{}{"option": options},
+	}
+}
+
+// parseRange parses a numeric range written as "low-high" (or "low:high").
+func parseRange(s string) (float64, float64, error) {
+	sep := "-"
+	if strings.Contains(s, ":") {
+		sep = ":"
+	}
+	parts := strings.SplitN(s, sep, 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected low-high, got %q", s)
+	}
+	lo, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid lower bound %q", parts[0])
+	}
+	hi, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid upper bound %q", parts[1])
+	}
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return lo, hi, nil
+}
+
+// optField formats a numeric field with the given verb, or returns an empty string when the field is absent.
+func optField(m map[string]interface{}, key, format string) string {
+	if m == nil {
+		return ""
+	}
+	if _, ok := m[key].(float64); !ok {
+		return ""
+	}
+	return fmt.Sprintf(format, num(m, key))
+}
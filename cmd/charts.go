@@ -0,0 +1,123 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/chart"
+	"github.com/cloudmanic/tradier/indicators"
+	"github.com/spf13/cobra"
+)
+
+// chartStyle is the --chart-style flag: candle or line.
+var chartStyle string
+
+// chartOverlay is the --overlay flag: price indicators drawn over the chart.
+var chartOverlay string
+
+// chartHeight is the --chart-height flag: rows in the price panel.
+var chartHeight int
+
+// overlayGlyphs are assigned to overlay series in order.
+var overlayGlyphs = []rune{'*', '+', 'x', 'o', '~', '='}
+
+// priceOverlays are the indicators that share the price axis and can be drawn over a chart.
+var priceOverlays = map[string]bool{"sma": true, "ema": true, "bbands": true, "vwap": true}
+
+// addChartFlags registers the --chart flags on a command that supports chart output.
+func addChartFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&chartOutput, "chart", false, "Draw a chart in the terminal instead of a table")
+	cmd.Flags().StringVar(&chartStyle, "chart-style", "candle", "Chart style: candle, line")
+	cmd.Flags().StringVar(&chartOverlay, "overlay", "", "Indicators to overlay on the chart, e.g. sma:20,ema:50,bbands:20:2,vwap")
+	cmd.Flags().IntVar(&chartHeight, "chart-height", 15, "Rows in the price panel of the chart")
+}
+
+// validateChartFlags checks the chart flags before any data is fetched.
+func validateChartFlags() error {
+	if !chartOutput {
+		return nil
+	}
+	if chartStyle != "candle" && chartStyle != "line" {
+		return fmt.Errorf("invalid --chart-style %q (candle, line)", chartStyle)
+	}
+	_, err := overlaySpecs()
+	return err
+}
+
+// overlaySpecs parses --overlay, allowing only indicators plotted on the price axis.
+func overlaySpecs() ([]indicators.Spec, error) {
+	if chartOverlay == "" {
+		return nil, nil
+	}
+	specs, err := indicators.ParseSpecs(chartOverlay)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range specs {
+		if !priceOverlays[s.Name] {
+			return nil, fmt.Errorf("%s cannot be overlaid on a price chart (supported: sma, ema, bbands, vwap)", s.Name)
+		}
+	}
+	return specs, nil
+}
+
+// displayHistoryChart draws historical pricing as a chart.
+func displayHistoryChart(data []byte) {
+	b, err := bars.ParseHistory(data)
+	if err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	printBarsChart(b)
+}
+
+// displayTimeSalesChart draws time and sales data as a chart.
+func displayTimeSalesChart(data []byte) {
+	b, err := bars.ParseTimeSales(data)
+	if err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	printBarsChart(b)
+}
+
+// printBarsChart draws bars in the chosen style, sized to the terminal, with any overlays.
+// Terminals without a UTF-8 locale get a plain ASCII chart.
+func printBarsChart(b []bars.Bar) {
+	if len(b) == 0 {
+		fmt.Println("No price data to chart.")
+		return
+	}
+
+	candles := make([]chart.Candle, len(b))
+	for i, bar := range b {
+		candles[i] = chart.Candle{Open: bar.Open, High: bar.High, Low: bar.Low, Close: bar.Close, Volume: bar.Volume}
+	}
+	specs, _ := overlaySpecs()
+	var overlays []chart.Series
+	for _, spec := range specs {
+		for _, col := range indicators.Compute(spec, b) {
+			glyph := overlayGlyphs[len(overlays)%len(overlayGlyphs)]
+			overlays = append(overlays, chart.Series{Name: col.Name, Values: col.Values, Glyph: glyph})
+		}
+	}
+
+	layout := "2006-01-02"
+	if bars.Intraday(b) {
+		layout = "01-02 15:04"
+	}
+	opts := chart.Options{
+		Width:   chart.TerminalWidth(),
+		Height:  chartHeight,
+		ASCII:   !chart.UTF8(),
+		XLabels: []string{b[0].Time.Format(layout), b[len(b)/2].Time.Format(layout), b[len(b)-1].Time.Format(layout)},
+	}
+	if chartStyle == "line" {
+		fmt.Print(chart.PriceLine(opts, candles, overlays...))
+		return
+	}
+	fmt.Print(chart.Candles(opts, candles, overlays...))
+}
@@ -0,0 +1,672 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/conditional"
+	"github.com/cloudmanic/tradier/filelock"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/scheduler"
+	"github.com/spf13/cobra"
+)
+
+// conditionalOrderCmd stages an order from a file to be sent when a trigger expression turns true.
+var conditionalOrderCmd = &cobra.Command{
+	Use:   "conditional",
+	Short: "Hold an order locally until a quote condition is met",
+	Long: `Stage an order to be submitted by 'tradier conditionals run' once a trigger expression over
+quotes turns true. Unlike OTO/OCO orders, the trigger can watch any symbols, not just the
+order's own.
+
+The trigger uses the same syntax as 'markets scan', with each field written SYMBOL.field:
+
+  spy.last > 600
+  vix.last > 25 or spy.change_percentage < -2
+  qqq.mid > 520 and iwm.last < 220
+
+Fields: last, bid, ask, mid, open, high, low, prevclose, change, change_percentage, volume.
+
+The order file is the same YAML (or JSON) format as 'trading schedule'. The order is previewed
+with the API when added unless --skip-preview is given. --expires drops the order if it has not
+triggered by then, using the same forms as 'trading schedule --at' (e.g. close, 2026-10-30 16:00).
+
+Examples:
+  tradier trading conditional --when "spy.last > 600" --file buy-aapl.yaml
+  tradier trading conditional --when "vix.last > 25" --file close-spread.yaml --expires close
+  tradier trading conditional --when "spy.last < 580 and vix.last > 22" --file hedge.yaml --account-id 6YA00001`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		when, _ := cmd.Flags().GetString("when")
+		file, _ := cmd.Flags().GetString("file")
+		expiresSpec, _ := cmd.Flags().GetString("expires")
+		skipPreview, _ := cmd.Flags().GetBool("skip-preview")
+		if when == "" || file == "" {
+			return fmt.Errorf("--when and --file are required")
+		}
+
+		trigger, err := conditional.Compile(when)
+		if err != nil {
+			return err
+		}
+		params, err := scheduler.LoadOrder(file)
+		if err != nil {
+			return err
+		}
+		if err := applyStrategyTag(params); err != nil {
+			return err
+		}
+		var expires time.Time
+		if expiresSpec != "" {
+			if expires, err = scheduler.ResolveAt(expiresSpec, time.Now(), marketCalendar(c, openCache(cmd))); err != nil {
+				return err
+			}
+		}
+		if !skipPreview {
+			preview := maps.Clone(params)
+			preview["preview"] = "true"
+			if _, err := c.PlaceOrder(accountID, preview); err != nil {
+				return fmt.Errorf("order preview failed (use --skip-preview to add anyway): %w", err)
+			}
+		}
+
+		quotes, err := fetchTriggerQuotes(c, trigger.Symbols())
+		if err != nil {
+			return err
+		}
+		for _, symbol := range trigger.Symbols() {
+			if _, ok := quotes[symbol]; !ok {
+				return fmt.Errorf("no quote found for %s", symbol)
+			}
+		}
+		if fired, _ := trigger.Eval(quotes); fired {
+			fmt.Fprintln(os.Stderr, "Warning: the trigger is already true; the order will be sent as soon as 'tradier conditionals run' sees it")
+		}
+
+		path, err := conditional.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		orders, err := conditional.Load(path)
+		if err != nil {
+			return err
+		}
+		orders, order := conditional.Add(orders, conditional.Order{
+			Trigger:   when,
+			AccountID: accountID,
+			Sandbox:   sandboxMode,
+			Paper:     paperMode,
+			Params:    params,
+			Expires:   expires,
+			Created:   time.Now(),
+		})
+		if err := conditional.Save(path, orders); err != nil {
+			return err
+		}
+		return printConditionalOrders([]conditional.Order{order})
+	},
+}
+
+// conditionalsCmd is the parent command for managing and running conditional orders.
+var conditionalsCmd = &cobra.Command{
+	Use:   "conditionals",
+	Short: "Conditional order commands",
+	Long:  "Commands for listing and canceling orders staged with 'tradier trading conditional', and the daemon that submits them.",
+}
+
+// listConditionalCmd prints conditional orders.
+var listConditionalCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List conditional orders",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		path, err := conditional.DefaultPath()
+		if err != nil {
+			return err
+		}
+		orders, err := conditional.Load(path)
+		if err != nil {
+			return err
+		}
+		var out []conditional.Order
+		for _, o := range orders {
+			if all || o.Status == conditional.StatusPending || o.Status == conditional.StatusSubmitting {
+				out = append(out, o)
+			}
+		}
+		return printConditionalOrders(out)
+	},
+}
+
+// cancelConditionalCmd cancels a pending conditional order.
+var cancelConditionalCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel a pending conditional order",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt("id")
+		if id <= 0 {
+			return fmt.Errorf("--id is required")
+		}
+		path, err := conditional.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		orders, err := conditional.Load(path)
+		if err != nil {
+			return err
+		}
+		if err := conditional.Cancel(orders, id); err != nil {
+			return err
+		}
+		if err := conditional.Save(path, orders); err != nil {
+			return err
+		}
+		fmt.Printf("Canceled conditional order %d\n", id)
+		return nil
+	},
+}
+
+// runConditionalCmd watches quotes and submits conditional orders as their triggers turn true.
+var runConditionalCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Submit conditional orders as their triggers are met until interrupted",
+	Long: `Watch quotes for every pending conditional order and submit each order once, the first time its
+trigger is true. Only orders added in the same environment are submitted, so run with --sandbox
+to submit sandbox orders.
+
+Trades and quotes from Tradier's market event stream are applied as they arrive, with a full
+quote poll every --interval that also picks up orders added while the daemon runs. The stream
+is not available in the sandbox, where the daemon only polls. Use --no-stream to poll only.
+
+Each order is marked submitting in ~/.config/tradier/conditional_orders.json before it is sent,
+so a restart never sends it twice. If the daemon stops mid-submission, the next run looks for
+the order's tag among the account's orders and records the order it finds, or marks the
+conditional order failed if the API never received it. Run one daemon per environment.
+
+With --json, each submission, failure, and expiration is printed as one JSON object per line.
+
+Examples:
+  tradier conditionals run
+  tradier conditionals run --interval 5s
+  tradier conditionals run --sandbox --json >> conditionals.log`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		interval, _ := cmd.Flags().GetDuration("interval")
+		noStream, _ := cmd.Flags().GetBool("no-stream")
+		if interval < time.Second {
+			return fmt.Errorf("--interval must be at least 1s")
+		}
+		path, err := conditional.DefaultPath()
+		if err != nil {
+			return err
+		}
+
+		m := &conditionalMonitor{c: c, path: path, quotes: map[string]conditional.Quote{}}
+		if err := m.reconcile(); err != nil {
+			return err
+		}
+		if err := m.reload(); err != nil {
+			return err
+		}
+		stream := !noStream && !sandboxMode
+		mode := fmt.Sprintf("polling every %s", interval)
+		if stream {
+			mode += " and streaming trades and quotes"
+		}
+		fmt.Fprintf(os.Stderr, "Watching %d conditional orders (%s). Press Ctrl+C to stop.\n", len(m.orders), mode)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		streaming := false
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := m.poll(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+			// The stream needs symbols to subscribe to, so start it once there are orders to watch
+			if stream && !streaming && len(m.symbols()) > 0 {
+				streaming = true
+				go streamQuotes(ctx, c, m.symbols, interval, m.applyEvent)
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+// conditionalMonitor holds the daemon's pending orders, their compiled triggers, and the latest
+// quote per symbol. Polling and the stream update quotes from different goroutines, so those
+// fields are guarded by mu. Every change to the orders file is made under its file lock, shared
+// with other daemons and the add and cancel commands, so an order is never claimed twice and no
+// change is lost.
+type conditionalMonitor struct {
+	c    *client.Client
+	path string
+
+	mu       sync.Mutex
+	orders   []conditional.Order
+	triggers map[int]*conditional.Trigger
+	quotes   map[string]conditional.Quote
+}
+
+// reconcile settles orders a previous run left in submitting by looking for their tags among
+// the account's orders. It fails rather than guessing if the orders cannot be fetched.
+func (m *conditionalMonitor) reconcile() error {
+	unlock, err := filelock.Lock(m.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	orders, err := conditional.Load(m.path)
+	if err != nil {
+		return err
+	}
+	interrupted := conditional.Interrupted(orders, sandboxMode, paperMode)
+	if len(interrupted) == 0 {
+		return nil
+	}
+
+	placed := map[string][]map[string]interface{}{}
+	for _, o := range interrupted {
+		if _, ok := placed[o.AccountID]; ok {
+			continue
+		}
+		data, err := m.c.GetOrders(o.AccountID, "", "", "true")
+		if err != nil {
+			return fmt.Errorf("unable to check orders for interrupted conditional orders: %w", err)
+		}
+		placed[o.AccountID] = toSlice(nested(parseJSON(data), "orders")["order"])
+	}
+
+	for _, o := range interrupted {
+		o.Status = conditional.StatusFailed
+		o.Error = fmt.Sprintf("submission interrupted and no order tagged %s was found", o.Params["tag"])
+		if id, ok := taggedOrderID(placed[o.AccountID], o.Params["tag"], o.TriggeredAt); ok {
+			o.Status = conditional.StatusSubmitted
+			o.OrderID = id
+			o.Error = ""
+		}
+		conditional.Record(orders, o)
+		printConditionalOutcome(o, o.TriggeredAt)
+	}
+	return conditional.Save(m.path, orders)
+}
+
+// taggedOrderID returns the ID of the order in placed carrying tag that was created no earlier
+// than a minute before since, allowing for clock differences with the API.
+func taggedOrderID(placed []map[string]interface{}, tag string, since time.Time) (string, bool) {
+	for _, order := range placed {
+		created, _ := time.Parse(time.RFC3339, str(order, "create_date"))
+		if str(order, "tag") == tag && !created.Before(since.Add(-time.Minute)) {
+			return str(order, "id"), true
+		}
+	}
+	return "", false
+}
+
+// reload expires stale orders and reads the pending orders for this environment from disk.
+func (m *conditionalMonitor) reload() error {
+	unlock, err := filelock.Lock(m.path)
+	if err != nil {
+		return err
+	}
+	orders, err := conditional.Load(m.path)
+	if err != nil {
+		unlock()
+		return err
+	}
+	expired := conditional.Expire(orders, time.Now())
+	if len(expired) > 0 {
+		err = conditional.Save(m.path, orders)
+	}
+	unlock()
+	if err != nil {
+		return err
+	}
+	for _, o := range expired {
+		printConditionalOutcome(o, o.Expires)
+	}
+
+	active := conditional.Active(orders, sandboxMode, paperMode)
+	triggers := map[int]*conditional.Trigger{}
+	for _, o := range active {
+		t, err := conditional.Compile(o.Trigger)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: conditional order %d: %v\n", o.ID, err)
+			continue
+		}
+		triggers[o.ID] = t
+	}
+	m.mu.Lock()
+	m.orders = active
+	m.triggers = triggers
+	m.mu.Unlock()
+	return nil
+}
+
+// symbols returns the sorted, unique symbols read by the pending orders' triggers.
+func (m *conditionalMonitor) symbols() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	seen := map[string]bool{}
+	var out []string
+	for _, t := range m.triggers {
+		for _, s := range t.Symbols() {
+			if !seen[s] {
+				seen[s] = true
+				out = append(out, s)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// poll reloads the orders, refreshes every quote, and submits orders whose triggers are true.
+func (m *conditionalMonitor) poll() error {
+	if err := m.reload(); err != nil {
+		return err
+	}
+	symbols := m.symbols()
+	if len(symbols) == 0 {
+		return nil
+	}
+	quotes, err := fetchTriggerQuotes(m.c, symbols)
+	if err != nil {
+		return fmt.Errorf("quotes: %w", err)
+	}
+	m.mu.Lock()
+	m.quotes = quotes
+	m.mu.Unlock()
+	m.check()
+	return nil
+}
+
+// applyEvent updates a quote from a stream trade or quote event and re-checks the triggers.
+func (m *conditionalMonitor) applyEvent(ev map[string]interface{}) {
+	symbol := str(ev, "symbol")
+	m.mu.Lock()
+	q, ok := m.quotes[symbol]
+	if ok {
+		switch str(ev, "type") {
+		case "trade":
+			if price := eventNumber(ev, "price"); price > 0 {
+				q.Last = price
+				q.High = max(q.High, price)
+				if q.Low <= 0 || price < q.Low {
+					q.Low = price
+				}
+			}
+			if volume := eventNumber(ev, "cvol"); volume > 0 {
+				q.Volume = volume
+			}
+		case "quote":
+			q.Bid, q.Ask = eventNumber(ev, "bid"), eventNumber(ev, "ask")
+		}
+		m.quotes[symbol] = q
+	}
+	m.mu.Unlock()
+	if ok {
+		m.check()
+	}
+}
+
+// check submits every pending order whose trigger is true.
+func (m *conditionalMonitor) check() {
+	m.mu.Lock()
+	var due []int
+	for _, o := range m.orders {
+		t, ok := m.triggers[o.ID]
+		if !ok {
+			continue
+		}
+		if fired, err := t.Eval(m.quotes); err == nil && fired {
+			due = append(due, o.ID)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, id := range due {
+		m.submit(id)
+	}
+	if len(due) > 0 {
+		if err := m.reload(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+}
+
+// submit claims an order in the orders file, sends it, and records the outcome. An order that
+// is no longer pending on disk, because it was canceled or already sent, is skipped, and an
+// order whose claim cannot be saved is not sent. The file lock is not held while the order is
+// sent; the saved claim keeps anything else from touching it meanwhile.
+func (m *conditionalMonitor) submit(id int) {
+	order, err := m.claim(id)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: conditional order %d not sent: %v\n", id, err)
+		return
+	}
+	if order.ID == 0 {
+		return
+	}
+
+	outcome := submitConditionalOrder(m.c, order)
+	if err := m.record(outcome); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	printConditionalOutcome(outcome, outcome.TriggeredAt)
+}
+
+// claim marks a pending order submitting and saves that under the file lock, returning the zero
+// order if it is no longer pending.
+func (m *conditionalMonitor) claim(id int) (conditional.Order, error) {
+	unlock, err := filelock.Lock(m.path)
+	if err != nil {
+		return conditional.Order{}, err
+	}
+	defer unlock()
+	orders, err := conditional.Load(m.path)
+	if err != nil {
+		return conditional.Order{}, err
+	}
+	order, err := conditional.Claim(orders, id, time.Now())
+	if err != nil {
+		return conditional.Order{}, nil
+	}
+	return order, conditional.Save(m.path, orders)
+}
+
+// record saves a submission outcome under the file lock, re-reading the file first so orders
+// added while this one was sent are kept.
+func (m *conditionalMonitor) record(outcome conditional.Order) error {
+	unlock, err := filelock.Lock(m.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	orders, err := conditional.Load(m.path)
+	if err != nil {
+		return err
+	}
+	conditional.Record(orders, outcome)
+	return conditional.Save(m.path, orders)
+}
+
+// submitConditionalOrder places a triggered order and returns it with its outcome.
+func submitConditionalOrder(c *client.Client, o conditional.Order) conditional.Order {
+	data, err := c.PlaceOrder(o.AccountID, o.Params)
+	if err != nil {
+		o.Status = conditional.StatusFailed
+		o.Error = err.Error()
+		return o
+	}
+	order := nested(parseJSON(data), "order")
+	if status := str(order, "status"); status != "ok" {
+		o.Status = conditional.StatusFailed
+		o.Error = fmt.Sprintf("order status %q: %s", status, data)
+		return o
+	}
+	o.Status = conditional.StatusSubmitted
+	o.OrderID = str(order, "id")
+	return o
+}
+
+// fetchTriggerQuotes returns the current quote for each symbol, keyed by upper-case symbol.
+func fetchTriggerQuotes(c *client.Client, symbols []string) (map[string]conditional.Quote, error) {
+	raw, err := fetchQuoteMap(c, symbols, false)
+	if err != nil {
+		return nil, err
+	}
+	quotes := map[string]conditional.Quote{}
+	for symbol, q := range raw {
+		quotes[strings.ToUpper(symbol)] = conditional.Quote{
+			Last:      num(q, "last"),
+			Bid:       num(q, "bid"),
+			Ask:       num(q, "ask"),
+			Open:      num(q, "open"),
+			High:      num(q, "high"),
+			Low:       num(q, "low"),
+			PrevClose: num(q, "prevclose"),
+			Volume:    num(q, "volume"),
+		}
+	}
+	return quotes, nil
+}
+
+// printConditionalOutcome reports a submission, failure, or expiration as a line of text, or a
+// JSON object with --json.
+func printConditionalOutcome(o conditional.Order, at time.Time) {
+	if jsonOutput {
+		line, _ := json.Marshal(o)
+		fmt.Println(string(line))
+		return
+	}
+	result := "order " + o.OrderID
+	if o.Status != conditional.StatusSubmitted {
+		result = o.Error
+	}
+	if o.Status == conditional.StatusExpired {
+		result = "trigger not met: " + o.Trigger
+	}
+	fmt.Printf("%s  #%d  %s  %s: %s\n", at.In(pricing.Eastern()).Format("2006-01-02 15:04:05"),
+		o.ID, orderSummary(o.Params), o.Status, result)
+}
+
+// printConditionalOrders outputs orders as a table or, with --json, as {"conditional_orders": [...]}.
+func printConditionalOrders(orders []conditional.Order) error {
+	if orders == nil {
+		orders = []conditional.Order{}
+	}
+	out, err := json.Marshal(map[string]interface{}{"conditional_orders": orders})
+	if err != nil {
+		return err
+	}
+	printResult(out, displayConditionalOrders)
+	return nil
+}
+
+// displayConditionalOrders renders conditional orders as a table.
+func displayConditionalOrders(data []byte) {
+	var out struct {
+		Orders []conditional.Order `json:"conditional_orders"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	if len(out.Orders) == 0 {
+		fmt.Println("No conditional orders.")
+		return
+	}
+
+	eastern := pricing.Eastern()
+	var rows [][]string
+	for _, o := range out.Orders {
+		account := o.AccountID
+		if o.Sandbox {
+			account += " (sandbox)"
+		}
+		if o.Paper {
+			account += " (paper)"
+		}
+		expires := "-"
+		if !o.Expires.IsZero() {
+			expires = o.Expires.In(eastern).Format("Mon Jan 2 15:04")
+		}
+		result := o.OrderID
+		if o.Error != "" {
+			result = o.Error
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(o.ID),
+			o.Trigger,
+			account,
+			orderSummary(o.Params),
+			expires,
+			string(o.Status),
+			result,
+		})
+	}
+	printTable([]string{"ID", "Trigger", "Account", "Order", "Expires", "Status", "Order ID / Error"}, rows)
+}
+
+func init() {
+	// Conditional order flags
+	conditionalOrderCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	conditionalOrderCmd.Flags().String("when", "", "Trigger expression, e.g. \"spy.last > 600\" (required)")
+	conditionalOrderCmd.Flags().String("file", "", "YAML or JSON order file (required)")
+	conditionalOrderCmd.Flags().String("expires", "", "Drop the order if not triggered by then, e.g. close, 2026-10-30 16:00")
+	conditionalOrderCmd.Flags().Bool("skip-preview", false, "Add without previewing the order first")
+
+	// List and cancel flags
+	listConditionalCmd.Flags().Bool("all", false, "Include submitted, failed, expired, and canceled orders")
+	cancelConditionalCmd.Flags().Int("id", 0, "Conditional order ID (required)")
+
+	// Run flags
+	runConditionalCmd.Flags().Duration("interval", 15*time.Second, "How often to poll quotes and re-read the orders file")
+	runConditionalCmd.Flags().Bool("no-stream", false, "Poll quotes only, without the market event stream")
+
+	// Build command tree
+	tradingCmd.AddCommand(conditionalOrderCmd)
+	conditionalsCmd.AddCommand(listConditionalCmd, cancelConditionalCmd, runConditionalCmd)
+	rootCmd.AddCommand(conditionalsCmd)
+}
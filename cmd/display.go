@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 
+	"github.com/cloudmanic/tradier/occ"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
@@ -156,63 +158,20 @@ func pct(f float64) string {
 // into a human-readable string (e.g. UNG 02/20/26 $14.00 Put).
 // Returns the original string unchanged if it does not match the OCC format.
 func formatOptionSymbol(sym string) string {
-	// OCC format: ROOT(1-6 chars) + YYMMDD(6) + C/P(1) + STRIKE(8)
-	// The last 15 characters are always date + type + strike
-	if len(sym) < 15 {
+	o, err := occ.Parse(sym)
+	if err != nil {
 		return sym
 	}
 
-	suffix := sym[len(sym)-15:]
-	root := sym[:len(sym)-15]
-
-	// Validate date portion is numeric
-	datePart := suffix[0:6]
-	for _, c := range datePart {
-		if c < '0' || c > '9' {
-			return sym
-		}
-	}
-
-	// Validate option type
-	optType := suffix[6]
-	if optType != 'C' && optType != 'P' {
-		return sym
-	}
-
-	// Validate strike portion is numeric
-	strikePart := suffix[7:15]
-	for _, c := range strikePart {
-		if c < '0' || c > '9' {
-			return sym
-		}
-	}
-
-	// Parse date: YYMMDD
-	yy := datePart[0:2]
-	mm := datePart[2:4]
-	dd := datePart[4:6]
-
-	// Parse strike: 8 digits representing price * 1000
-	var strikeVal int
-	for _, c := range strikePart {
-		strikeVal = strikeVal*10 + int(c-'0')
-	}
-	strike := float64(strikeVal) / 1000.0
-
-	typeName := "Call"
-	if optType == 'P' {
-		typeName = "Put"
-	}
-
 	// Format strike: drop decimals if whole number
 	var strikeStr string
-	if strike == float64(int(strike)) {
-		strikeStr = fmt.Sprintf("$%d", int(strike))
+	if o.Strike == float64(int(o.Strike)) {
+		strikeStr = fmt.Sprintf("$%d", int(o.Strike))
 	} else {
-		strikeStr = fmt.Sprintf("$%.2f", strike)
+		strikeStr = fmt.Sprintf("$%.2f", o.Strike)
 	}
 
-	return fmt.Sprintf("%s %s/%s/%s %s %s", root, mm, dd, yy, strikeStr, typeName)
+	return fmt.Sprintf("%s %s %s %s", o.Root, o.Expiration.Format("01/02/06"), strikeStr, o.TypeName())
 }
 
 // shortDate trims an ISO 8601 datetime string to just the date portion.
@@ -528,6 +487,17 @@ func displayOrders(data []byte) {
 
 	orders := toSlice(o["order"])
 	headers := []string{"ID", "CLASS", "SYMBOL", "OPTION SYMBOL", "SIDE", "QTY", "TYPE", "PRICE", "STATUS", "DURATION", "AVG FILL", "CREATED"}
+
+	// Only show a tag column when tags were requested and at least one order has one
+	showTags := false
+	for _, ord := range orders {
+		if str(ord, "tag") != "" {
+			showTags = true
+		}
+	}
+	if showTags {
+		headers = append(headers, "TAG")
+	}
 	rows := make([][]string, 0, len(orders))
 	showLegend := false
 	for _, ord := range orders {
@@ -560,7 +530,7 @@ func displayOrders(data []byte) {
 			showLegend = true
 		}
 
-		rows = append(rows, []string{
+		row := []string{
 			str(ord, "id"),
 			str(ord, "class"),
 			str(ord, "symbol"),
@@ -573,7 +543,11 @@ func displayOrders(data []byte) {
 			str(ord, "duration"),
 			money(num(ord, "avg_fill_price")),
 			shortDate(str(ord, "create_date")),
-		})
+		}
+		if showTags {
+			row = append(row, str(ord, "tag"))
+		}
+		rows = append(rows, row)
 	}
 	printTable(headers, rows)
 
@@ -712,7 +686,9 @@ func displayQuotes(data []byte) {
 	printTable(headers, rows)
 }
 
-// displayOptionsChains renders option chain data as a table.
+// displayOptionsChains renders option chain data as a table. A chain passed through from
+// Tradier shows its own columns; an analyzed chain, which names its underlying, adds the
+// computed columns (mid, spread, intrinsic/extrinsic, probability ITM).
 func displayOptionsChains(data []byte) {
 	root := parseJSON(data)
 	if root == nil {
@@ -727,23 +703,140 @@ func displayOptionsChains(data []byte) {
 	}
 
 	options := toSlice(o["option"])
-	headers := []string{"OPTION", "TYPE", "STRIKE", "LAST", "BID", "ASK", "VOLUME", "OPEN INT"}
+	u := nested(root, "underlying")
+	if u == nil {
+		headers := []string{"OPTION", "TYPE", "STRIKE", "LAST", "BID", "ASK", "VOLUME", "OPEN INT"}
+		rows := make([][]string, 0, len(options))
+		for _, opt := range options {
+			rows = append(rows, []string{
+				formatOptionSymbol(str(opt, "symbol")),
+				str(opt, "option_type"),
+				fmt.Sprintf("%.2f", num(opt, "strike")),
+				fmt.Sprintf("%.2f", num(opt, "last")),
+				fmt.Sprintf("%.2f", num(opt, "bid")),
+				fmt.Sprintf("%.2f", num(opt, "ask")),
+				str(opt, "volume"),
+				str(opt, "open_interest"),
+			})
+		}
+		printTable(headers, rows)
+		return
+	}
+
+	fmt.Printf("Underlying: %s @ %.2f\n", str(u, "symbol"), num(u, "price"))
+	headers := []string{"OPTION", "TYPE", "STRIKE", "BID", "ASK", "MID", "SPREAD%", "LAST", "VOLUME", "OPEN INT", "DELTA", "IV", "INTRINSIC", "EXTRINSIC", "P(ITM)"}
 	rows := make([][]string, 0, len(options))
 	for _, opt := range options {
+		g := nested(opt, "greeks")
 		rows = append(rows, []string{
 			formatOptionSymbol(str(opt, "symbol")),
 			str(opt, "option_type"),
 			fmt.Sprintf("%.2f", num(opt, "strike")),
-			fmt.Sprintf("%.2f", num(opt, "last")),
 			fmt.Sprintf("%.2f", num(opt, "bid")),
 			fmt.Sprintf("%.2f", num(opt, "ask")),
+			optField(opt, "mid", "%.2f"),
+			optField(opt, "spread_pct", "%.1f%%"),
+			fmt.Sprintf("%.2f", num(opt, "last")),
 			str(opt, "volume"),
 			str(opt, "open_interest"),
+			optField(g, "delta", "%.3f"),
+			ivField(g),
+			optField(opt, "intrinsic", "%.2f"),
+			optField(opt, "extrinsic", "%.2f"),
+			probField(opt),
 		})
 	}
 	printTable(headers, rows)
 }
 
+// displayOptionsChainsSideBySide renders calls on the left and puts on the right of each strike.
+func displayOptionsChainsSideBySide(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	o := nested(root, "options")
+	if o == nil {
+		fmt.Println("No options chain data found.")
+		return
+	}
+
+	if u := nested(root, "underlying"); u != nil {
+		fmt.Printf("Underlying: %s @ %.2f\n", str(u, "symbol"), num(u, "price"))
+	}
+
+	calls := map[float64]map[string]interface{}{}
+	puts := map[float64]map[string]interface{}{}
+	var strikes []float64
+	for _, opt := range toSlice(o["option"]) {
+		k := num(opt, "strike")
+		if calls[k] == nil && puts[k] == nil {
+			strikes = append(strikes, k)
+		}
+		if str(opt, "option_type") == "call" {
+			calls[k] = opt
+		} else {
+			puts[k] = opt
+		}
+	}
+	sort.Float64s(strikes)
+
+	// side returns the columns for one side of the chain, mirrored for puts so the strike sits in the middle
+	side := func(opt map[string]interface{}, mirror bool) []string {
+		if opt == nil {
+			return []string{"", "", "", "", "", "", ""}
+		}
+		g := nested(opt, "greeks")
+		cols := []string{
+			str(opt, "open_interest"),
+			optField(g, "delta", "%.3f"),
+			ivField(g),
+			probField(opt),
+			fmt.Sprintf("%.2f", num(opt, "bid")),
+			optField(opt, "mid", "%.2f"),
+			fmt.Sprintf("%.2f", num(opt, "ask")),
+		}
+		if mirror {
+			for i, j := 0, len(cols)-1; i < j; i, j = i+1, j-1 {
+				cols[i], cols[j] = cols[j], cols[i]
+			}
+		}
+		return cols
+	}
+
+	headers := []string{"OI", "DELTA", "IV", "P(ITM)", "BID", "MID", "ASK", "STRIKE", "ASK", "MID", "BID", "P(ITM)", "IV", "DELTA", "OI"}
+	rows := make([][]string, 0, len(strikes))
+	for _, k := range strikes {
+		row := side(calls[k], false)
+		row = append(row, fmt.Sprintf("%.2f", k))
+		row = append(row, side(puts[k], true)...)
+		rows = append(rows, row)
+	}
+	fmt.Println("CALLS on the left, PUTS on the right")
+	printTable(headers, rows)
+}
+
+// ivField formats the mid implied volatility from a greeks object as a percentage.
+func ivField(g map[string]interface{}) string {
+	if g == nil {
+		return ""
+	}
+	if iv := num(g, "mid_iv"); iv > 0 {
+		return fmt.Sprintf("%.1f%%", iv*100)
+	}
+	return ""
+}
+
+// probField formats the computed probability of expiring in the money as a percentage.
+func probField(opt map[string]interface{}) string {
+	if _, ok := opt["prob_itm"].(float64); !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.1f%%", num(opt, "prob_itm")*100)
+}
+
 // displayOptionsExpirations renders available expiration dates as a simple list.
 func displayOptionsExpirations(data []byte) {
 	root := parseJSON(data)
@@ -878,9 +971,13 @@ func displayTimeSales(data []byte) {
 	}
 
 	entries := toSlice(s["data"])
-	headers := []string{"TIMESTAMP", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME"}
+	headers := []string{"TIMESTAMP", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME", "VWAP"}
 	rows := make([][]string, 0, len(entries))
 	for _, e := range entries {
+		vwap := "-"
+		if v := num(e, "vwap"); v > 0 {
+			vwap = fmt.Sprintf("%.2f", v)
+		}
 		rows = append(rows, []string{
 			str(e, "timestamp"),
 			fmt.Sprintf("%.2f", num(e, "open")),
@@ -888,6 +985,7 @@ func displayTimeSales(data []byte) {
 			fmt.Sprintf("%.2f", num(e, "low")),
 			fmt.Sprintf("%.2f", num(e, "close")),
 			str(e, "volume"),
+			vwap,
 		})
 	}
 	printTable(headers, rows)
@@ -0,0 +1,269 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/chain"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// expectedMove is the market-implied move for one expiration and the probabilities at chosen price levels.
+type expectedMove struct {
+	Symbol      string          `json:"symbol"`
+	Expiration  string          `json:"expiration"`
+	Days        int             `json:"days"`
+	Spot        float64         `json:"underlying_price"`
+	ATMStrike   float64         `json:"atm_strike"`
+	CallMid     float64         `json:"call_mid"`
+	PutMid      float64         `json:"put_mid"`
+	Straddle    float64         `json:"straddle"`
+	ATMIV       float64         `json:"atm_iv"`
+	IVMove      float64         `json:"iv_move"`
+	Ranges      []moveRange     `json:"ranges"`
+	Probability []levelEstimate `json:"levels"`
+}
+
+// moveRange is a price range implied by one of the expected move estimates.
+type moveRange struct {
+	Label string  `json:"label"`
+	Low   float64 `json:"low"`
+	High  float64 `json:"high"`
+}
+
+// levelEstimate is the probability of the underlying touching, or closing beyond, a price level by expiration.
+type levelEstimate struct {
+	Price     float64 `json:"price"`
+	Direction string  `json:"direction"`
+	ProbClose float64 `json:"prob_close_beyond"`
+	ProbTouch float64 `json:"prob_touch"`
+}
+
+// expectedMoveCmd estimates the market-implied move into an expiration.
+var expectedMoveCmd = &cobra.Command{
+	Use:   "expected-move",
+	Short: "Estimate the market-implied move and price level probabilities for an expiration",
+	Long: `Estimate how far the market expects a symbol to move by an expiration, both from the
+price of the at-the-money straddle and from at-the-money implied volatility (one standard
+deviation is price x IV x sqrt(time)).
+
+For each price level, shows the risk-neutral probability of closing beyond it at expiration
+and of touching it at any time before. Without --levels, the one and two standard deviation
+boundaries are used.
+
+Examples:
+  tradier markets expected-move --symbol AAPL --expiration 2026-11-20
+  tradier markets expected-move --symbol AAPL --expiration 2026-11-20 --levels 230,240,260,270
+  tradier markets expected-move --symbol SPY --expiration 2026-11-20 --rate 0.04 --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		symbol, _ := cmd.Flags().GetString("symbol")
+		expiration, _ := cmd.Flags().GetString("expiration")
+		if symbol == "" || expiration == "" {
+			return fmt.Errorf("--symbol and --expiration are required")
+		}
+		symbol = strings.ToUpper(symbol)
+		levelsFlag, _ := cmd.Flags().GetString("levels")
+		rate, _ := cmd.Flags().GetFloat64("rate")
+		dividend, _ := cmd.Flags().GetFloat64("dividend-yield")
+
+		levels, err := parseLevels(levelsFlag)
+		if err != nil {
+			return err
+		}
+		expDate, ok := parseDay(expiration)
+		if !ok {
+			return fmt.Errorf("invalid --expiration %q (use YYYY-MM-DD)", expiration)
+		}
+
+		spot, err := underlyingPrice(c, symbol)
+		if err != nil {
+			return err
+		}
+		data, err := c.GetOptionsChains(symbol, expiration, "true")
+		if err != nil {
+			return err
+		}
+		contracts, err := chain.Parse(data)
+		if err != nil {
+			return err
+		}
+
+		move, err := buildExpectedMove(symbol, expDate, spot, contracts, levels, rate, dividend, time.Now())
+		if err != nil {
+			return err
+		}
+		out, err := json.Marshal(move)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayExpectedMove)
+		return nil
+	},
+}
+
+// buildExpectedMove computes the straddle and IV based moves and the probabilities at each level.
+// When Tradier has no implied volatility at the money, it is solved from the ATM call's mid price.
+func buildExpectedMove(symbol string, expiration time.Time, spot float64, contracts []chain.Contract, levels []float64, rate, dividend float64, now time.Time) (expectedMove, error) {
+	strike := chain.ATMStrike(chain.Strikes(contracts), spot)
+	var call, put *chain.Contract
+	for i := range contracts {
+		if contracts[i].Strike != strike {
+			continue
+		}
+		if contracts[i].IsCall() {
+			call = &contracts[i]
+		} else {
+			put = &contracts[i]
+		}
+	}
+	if call == nil || put == nil {
+		return expectedMove{}, fmt.Errorf("no at-the-money straddle found for %s on %s", symbol, expiration.Format("2006-01-02"))
+	}
+
+	m := expectedMove{
+		Symbol:     symbol,
+		Expiration: expiration.Format("2006-01-02"),
+		Days:       expirationDays(expiration, now),
+		Spot:       spot,
+		ATMStrike:  strike,
+		CallMid:    call.Mid(),
+		PutMid:     put.Mid(),
+	}
+	m.Straddle = m.CallMid + m.PutMid
+
+	p := pricing.Params{
+		Spot:     spot,
+		Years:    pricing.YearsToExpiry(expiration, now),
+		Rate:     rate,
+		Dividend: dividend,
+	}
+	m.ATMIV = chain.ATMIV(contracts, spot)
+	if m.ATMIV <= 0 {
+		solve := p
+		solve.Strike, solve.Call = strike, true
+		if iv, err := pricing.ImpliedVol(solve, call.Mid()); err == nil {
+			m.ATMIV = iv
+		}
+	}
+	p.Vol = m.ATMIV
+	m.IVMove = spot * m.ATMIV * math.Sqrt(p.Years)
+
+	m.Ranges = []moveRange{
+		{Label: "Straddle", Low: spot - m.Straddle, High: spot + m.Straddle},
+		{Label: "1 SD (IV)", Low: spot - m.IVMove, High: spot + m.IVMove},
+		{Label: "2 SD (IV)", Low: spot - 2*m.IVMove, High: spot + 2*m.IVMove},
+	}
+	if len(levels) == 0 && m.IVMove > 0 {
+		levels = []float64{spot - 2*m.IVMove, spot - m.IVMove, spot + m.IVMove, spot + 2*m.IVMove}
+	}
+
+	for _, level := range levels {
+		q := p
+		q.Strike = level
+		q.Call = level >= spot
+		est := levelEstimate{
+			Price:     level,
+			Direction: "below",
+			ProbClose: pricing.ProbITM(q),
+			ProbTouch: pricing.ProbTouch(q),
+		}
+		if q.Call {
+			est.Direction = "above"
+		}
+		m.Probability = append(m.Probability, est)
+	}
+	return m, nil
+}
+
+// parseLevels parses a comma-separated list of price levels.
+func parseLevels(s string) ([]float64, error) {
+	var levels []float64
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("invalid price level %q", part)
+		}
+		levels = append(levels, v)
+	}
+	return levels, nil
+}
+
+// displayExpectedMove renders the expected move summary, implied ranges, and level probabilities.
+func displayExpectedMove(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	spot := num(root, "underlying_price")
+	straddle := num(root, "straddle")
+	ivMove := num(root, "iv_move")
+	movePct := func(v float64) string {
+		if spot == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%.2f%%", v/spot*100)
+	}
+
+	printKV([][2]string{
+		{"Symbol", str(root, "symbol")},
+		{"Expiration", fmt.Sprintf("%s (%s days)", str(root, "expiration"), str(root, "days"))},
+		{"Underlying", fmt.Sprintf("%.2f", spot)},
+		{"ATM Strike", fmt.Sprintf("%.2f", num(root, "atm_strike"))},
+		{"Straddle", fmt.Sprintf("%.2f (call %.2f + put %.2f)", straddle, num(root, "call_mid"), num(root, "put_mid"))},
+		{"Straddle Move", fmt.Sprintf("±%.2f (%s)", straddle, movePct(straddle))},
+		{"ATM IV", fmt.Sprintf("%.2f%%", num(root, "atm_iv")*100)},
+		{"IV Move (1 SD)", fmt.Sprintf("±%.2f (%s)", ivMove, movePct(ivMove))},
+	})
+
+	fmt.Println()
+	ranges := toSlice(root["ranges"])
+	rows := make([][]string, 0, len(ranges))
+	for _, r := range ranges {
+		rows = append(rows, []string{str(r, "label"), fmt.Sprintf("%.2f", num(r, "low")), fmt.Sprintf("%.2f", num(r, "high"))})
+	}
+	printTable([]string{"RANGE", "LOW", "HIGH"}, rows)
+
+	levels := toSlice(root["levels"])
+	if len(levels) == 0 {
+		return
+	}
+	fmt.Println()
+	rows = make([][]string, 0, len(levels))
+	for _, l := range levels {
+		rows = append(rows, []string{
+			fmt.Sprintf("%.2f", num(l, "price")),
+			str(l, "direction"),
+			pct((num(l, "price") - spot) / spot * 100),
+			fmt.Sprintf("%.1f%%", num(l, "prob_close_beyond")*100),
+			fmt.Sprintf("%.1f%%", num(l, "prob_touch")*100),
+		})
+	}
+	printTable([]string{"LEVEL", "SIDE", "DISTANCE", "P(CLOSE BEYOND)", "P(TOUCH)"}, rows)
+}
+
+func init() {
+	expectedMoveCmd.Flags().String("symbol", "", "Underlying symbol (required)")
+	expectedMoveCmd.Flags().String("expiration", "", "Expiration date YYYY-MM-DD (required)")
+	expectedMoveCmd.Flags().String("levels", "", "Comma-separated price levels to estimate probabilities for")
+	addRateFlags(expectedMoveCmd)
+
+	marketsCmd.AddCommand(expectedMoveCmd)
+}
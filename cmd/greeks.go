@@ -0,0 +1,294 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/chain"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// defaultRiskFreeRate is the annual rate used for local pricing when --rate is not given.
+const defaultRiskFreeRate = 0.045
+
+// europeanRoots are cash-settled index option roots, which use European exercise.
+var europeanRoots = map[string]bool{
+	"SPX": true, "SPXW": true, "XSP": true, "NDX": true, "NDXP": true,
+	"RUT": true, "RUTW": true, "VIX": true, "VIXW": true, "DJX": true, "OEX": true, "XEO": true,
+}
+
+// pricingModel holds the market inputs shared by every contract priced locally.
+type pricingModel struct {
+	Rate     float64
+	Dividend float64
+	Style    string
+}
+
+// greeksReport is the result of pricing a single option locally, alongside Tradier's greeks.
+type greeksReport struct {
+	Symbol      string                 `json:"symbol"`
+	Underlying  string                 `json:"underlying"`
+	Style       string                 `json:"style"`
+	Spot        float64                `json:"underlying_price"`
+	Strike      float64                `json:"strike"`
+	Expiration  string                 `json:"expiration_date"`
+	Years       float64                `json:"years_to_expiry"`
+	Rate        float64                `json:"rate"`
+	Dividend    float64                `json:"dividend_yield"`
+	MarketPrice float64                `json:"market_price"`
+	ImpliedVol  float64                `json:"implied_volatility"`
+	ProbITM     float64                `json:"prob_itm"`
+	Local       pricing.Greeks         `json:"local"`
+	Tradier     map[string]interface{} `json:"tradier,omitempty"`
+}
+
+// greeksCmd prices an option locally and compares the result with Tradier's greeks.
+var greeksCmd = &cobra.Command{
+	Use:   "greeks",
+	Short: "Compute theoretical price, greeks, and implied volatility for an option",
+	Long: `Compute an option's implied volatility from its current mid price, then its theoretical
+value and first- and second-order greeks, using Black-Scholes-Merton for European options
+and a binomial tree for American options. Tradier's greeks are shown alongside for comparison.
+
+Index options such as SPX, NDX, and RUT are priced as European and everything else as
+American unless --style is given. Theta and charm are per day, vega, vanna, and vomma are
+per volatility point, and rho is per one percent change in rates.
+
+Examples:
+  tradier markets greeks --option-symbol AAPL261120C00250000
+  tradier markets greeks --option-symbol SPXW261120P05800000 --rate 0.04
+  tradier markets greeks --option-symbol AAPL261120C00250000 --dividend-yield 0.005 --vol 0.3`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		symbol, _ := cmd.Flags().GetString("option-symbol")
+		if symbol == "" {
+			return fmt.Errorf("--option-symbol is required")
+		}
+		model, err := pricingModelFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		vol, _ := cmd.Flags().GetFloat64("vol")
+
+		symbol = strings.ToUpper(symbol)
+		parsed, err := occ.Parse(symbol)
+		if err != nil {
+			return err
+		}
+		underlying := parsed.Root
+		data, err := c.GetQuotes(symbol, "true")
+		if err != nil {
+			return err
+		}
+		quotes := toSlice(nested(parseJSON(data), "quotes")["quote"])
+		if len(quotes) == 0 {
+			return fmt.Errorf("no quote found for %s", symbol)
+		}
+		q := quotes[0]
+		if u := str(q, "underlying"); u != "" {
+			underlying = u
+		}
+		spot, err := underlyingPrice(c, underlying)
+		if err != nil {
+			return err
+		}
+
+		p := model.params(parsed.Root, spot, parsed.Strike, parsed.Call, pricing.YearsToExpiry(parsed.Expiration, time.Now()))
+		report := greeksReport{
+			Symbol:      symbol,
+			Underlying:  underlying,
+			Style:       model.style(parsed.Root),
+			Spot:        spot,
+			Strike:      parsed.Strike,
+			Expiration:  parsed.Expiration.Format("2006-01-02"),
+			Years:       p.Years,
+			Rate:        model.Rate,
+			Dividend:    model.Dividend,
+			MarketPrice: quoteMark(q),
+			Tradier:     nested(q, "greeks"),
+		}
+
+		if vol > 0 {
+			p.Vol = vol
+		} else {
+			p.Vol, err = pricing.ImpliedVol(p, report.MarketPrice)
+			if err != nil {
+				return fmt.Errorf("unable to solve implied volatility for %s at %.2f: %w (use --vol to price with a fixed volatility)", symbol, report.MarketPrice, err)
+			}
+		}
+		report.ImpliedVol = p.Vol
+		report.ProbITM = pricing.ProbITM(p)
+		report.Local = pricing.Compute(p)
+
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayGreeks)
+		return nil
+	},
+}
+
+// pricingModelFromFlags reads the --rate, --dividend-yield, and --style flags.
+func pricingModelFromFlags(cmd *cobra.Command) (pricingModel, error) {
+	rate, _ := cmd.Flags().GetFloat64("rate")
+	dividend, _ := cmd.Flags().GetFloat64("dividend-yield")
+	style, _ := cmd.Flags().GetString("style")
+	style = strings.ToLower(style)
+	if style != "auto" && style != "american" && style != "european" {
+		return pricingModel{}, fmt.Errorf("--style must be auto, american, or european")
+	}
+	return pricingModel{Rate: rate, Dividend: dividend, Style: style}, nil
+}
+
+// style returns the exercise style used for an option root.
+func (m pricingModel) style(root string) string {
+	if m.Style != "auto" && m.Style != "" {
+		return m.Style
+	}
+	if europeanRoots[strings.ToUpper(root)] {
+		return "european"
+	}
+	return "american"
+}
+
+// params builds pricing inputs for one contract. Volatility is left for the caller to fill in.
+func (m pricingModel) params(root string, spot, strike float64, call bool, years float64) pricing.Params {
+	return pricing.Params{
+		Spot:     spot,
+		Strike:   strike,
+		Years:    years,
+		Rate:     m.Rate,
+		Dividend: m.Dividend,
+		Call:     call,
+		American: m.style(root) == "american",
+	}
+}
+
+// applyLocalGreeks replaces each contract's greeks with locally computed values so filters,
+// probabilities, and display all use them. Contracts that cannot be priced keep Tradier's greeks.
+func (m pricingModel) applyLocalGreeks(contracts []chain.Contract, spot float64, now time.Time) {
+	for i, c := range contracts {
+		g := m.localGreeks(c, spot, now)
+		if g == nil {
+			continue
+		}
+		contracts[i].Greeks = &chain.Greeks{
+			Delta: num(g, "delta"),
+			Gamma: num(g, "gamma"),
+			Theta: num(g, "theta"),
+			Vega:  num(g, "vega"),
+			Rho:   num(g, "rho"),
+			MidIV: num(g, "mid_iv"),
+		}
+		contracts[i].Raw["greeks"] = g
+	}
+}
+
+// localGreeks solves a chain contract's implied volatility from its mid price and returns greeks in
+// the same shape as Tradier's. Tradier's mid IV is used when the mid price has no solution.
+// Returns nil when the contract cannot be priced.
+func (m pricingModel) localGreeks(c chain.Contract, spot float64, now time.Time) map[string]interface{} {
+	root := c.Underlying
+	if s, err := occ.Parse(c.Symbol); err == nil {
+		root = s.Root
+	}
+	p := m.params(root, spot, c.Strike, c.IsCall(), c.YearsToExpiry(now))
+	if p.Years <= 0 || spot <= 0 {
+		return nil
+	}
+
+	vol, err := pricing.ImpliedVol(p, c.Mid())
+	if err != nil {
+		if c.Greeks == nil || c.Greeks.MidIV <= 0 {
+			return nil
+		}
+		vol = c.Greeks.MidIV
+	}
+	p.Vol = vol
+	g := pricing.Compute(p)
+	return map[string]interface{}{
+		"delta":  g.Delta,
+		"gamma":  g.Gamma,
+		"theta":  g.Theta,
+		"vega":   g.Vega,
+		"rho":    g.Rho,
+		"vanna":  g.Vanna,
+		"charm":  g.Charm,
+		"vomma":  g.Vomma,
+		"mid_iv": vol,
+		"theo":   g.Price,
+		"source": "local",
+	}
+}
+
+// displayGreeks renders a locally priced option with Tradier's greeks for comparison.
+func displayGreeks(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	printKV([][2]string{
+		{"Option", formatOptionSymbol(str(root, "symbol"))},
+		{"Style", str(root, "style")},
+		{"Underlying", fmt.Sprintf("%s @ %.2f", str(root, "underlying"), num(root, "underlying_price"))},
+		{"Days to Expiry", fmt.Sprintf("%.1f", num(root, "years_to_expiry")*365)},
+		{"Rate / Dividend", fmt.Sprintf("%.2f%% / %.2f%%", num(root, "rate")*100, num(root, "dividend_yield")*100)},
+		{"Market Price", fmt.Sprintf("%.2f", num(root, "market_price"))},
+		{"Implied Vol", fmt.Sprintf("%.2f%%", num(root, "implied_volatility")*100)},
+		{"Prob. ITM", fmt.Sprintf("%.1f%%", num(root, "prob_itm")*100)},
+	})
+	fmt.Println()
+
+	local := nested(root, "local")
+	tradier := nested(root, "tradier")
+	tradierField := func(key string) string {
+		return optField(tradier, key, "%.4f")
+	}
+	rows := [][]string{
+		{"Theoretical", fmt.Sprintf("%.4f", num(local, "price")), ""},
+		{"Delta", fmt.Sprintf("%.4f", num(local, "delta")), tradierField("delta")},
+		{"Gamma", fmt.Sprintf("%.4f", num(local, "gamma")), tradierField("gamma")},
+		{"Theta", fmt.Sprintf("%.4f", num(local, "theta")), tradierField("theta")},
+		{"Vega", fmt.Sprintf("%.4f", num(local, "vega")), tradierField("vega")},
+		{"Rho", fmt.Sprintf("%.4f", num(local, "rho")), tradierField("rho")},
+		{"Vanna", fmt.Sprintf("%.4f", num(local, "vanna")), ""},
+		{"Charm", fmt.Sprintf("%.4f", num(local, "charm")), ""},
+		{"Vomma", fmt.Sprintf("%.4f", num(local, "vomma")), ""},
+		{"Mid IV", fmt.Sprintf("%.4f", num(root, "implied_volatility")), tradierField("mid_iv")},
+	}
+	printTable([]string{"GREEK", "LOCAL", "TRADIER"}, rows)
+}
+
+// addPricingFlags registers the shared local pricing flags on a command.
+func addPricingFlags(cmd *cobra.Command) {
+	addRateFlags(cmd)
+	cmd.Flags().String("style", "auto", "Exercise style for local pricing: auto, american, or european")
+}
+
+// addRateFlags registers the risk-free rate and dividend yield flags for commands that price
+// with a model but have no exercise style to choose.
+func addRateFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64("rate", defaultRiskFreeRate, "Annual risk-free rate for local pricing")
+	cmd.Flags().Float64("dividend-yield", 0, "Annual continuous dividend yield for local pricing")
+}
+
+func init() {
+	greeksCmd.Flags().String("option-symbol", "", "OCC option symbol (required)")
+	greeksCmd.Flags().Float64("vol", 0, "Price with this volatility instead of solving from the market price")
+	addPricingFlags(greeksCmd)
+
+	marketsCmd.AddCommand(greeksCmd)
+}
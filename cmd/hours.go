@@ -0,0 +1,192 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// hoursSession is a session's start and end in Eastern time.
+type hoursSession struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// hoursDay is one calendar day's status and sessions.
+type hoursDay struct {
+	Date        string        `json:"date"`
+	Open        bool          `json:"open"`
+	HalfDay     bool          `json:"half_day"`
+	Description string        `json:"description,omitempty"`
+	Premarket   *hoursSession `json:"premarket,omitempty"`
+	Regular     *hoursSession `json:"regular,omitempty"`
+	Postmarket  *hoursSession `json:"postmarket,omitempty"`
+}
+
+// hoursReport is the current market phase, the next regular open and close, and upcoming days.
+type hoursReport struct {
+	Now       time.Time  `json:"now"`
+	Phase     string     `json:"phase"`
+	NextOpen  time.Time  `json:"next_open"`
+	NextClose time.Time  `json:"next_close"`
+	Days      []hoursDay `json:"days"`
+}
+
+// hoursCmd prints the market's current status and the sessions of the coming days.
+var hoursCmd = &cobra.Command{
+	Use:   "hours",
+	Short: "Show market status and today's and upcoming trading sessions",
+	Long: `Show whether the market is in pre-market, the regular session, post-market, or closed, the
+time to the next open and close, and the pre-market, regular, and post-market hours (Eastern) of
+today and the following days, with holidays and early closes marked.
+
+Calendar months are cached locally; use --no-cache to fetch them fresh.
+
+Examples:
+  tradier markets hours
+  tradier markets hours --days 14
+  tradier markets hours --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		days, _ := cmd.Flags().GetInt("days")
+		if days < 1 {
+			return fmt.Errorf("--days must be at least 1")
+		}
+
+		report, err := buildHoursReport(marketCalendar(c, openCache(cmd)), time.Now(), days)
+		if err != nil {
+			return err
+		}
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayHours)
+		return nil
+	},
+}
+
+// buildHoursReport describes the market at now and the sessions of the given number of days from today.
+func buildHoursReport(cal *calendar.Calendar, now time.Time, days int) (hoursReport, error) {
+	now = now.In(pricing.Eastern())
+	report := hoursReport{Now: now}
+
+	phase, err := cal.Phase(now)
+	if err != nil {
+		return report, err
+	}
+	report.Phase = string(phase)
+	if report.NextOpen, err = cal.NextOpen(now); err != nil {
+		return report, err
+	}
+	if report.NextClose, err = cal.NextClose(now); err != nil {
+		return report, err
+	}
+
+	list, err := cal.Days(now, now.AddDate(0, 0, days-1))
+	if err != nil {
+		return report, err
+	}
+	session := func(s calendar.Session) *hoursSession {
+		if s.IsZero() {
+			return nil
+		}
+		return &hoursSession{Start: s.Start, End: s.End}
+	}
+	for _, d := range list {
+		report.Days = append(report.Days, hoursDay{
+			Date:        d.Date.Format("2006-01-02"),
+			Open:        d.Open,
+			HalfDay:     d.IsHalfDay(),
+			Description: d.Description,
+			Premarket:   session(d.Premarket),
+			Regular:     session(d.Regular),
+			Postmarket:  session(d.Postmarket),
+		})
+	}
+	return report, nil
+}
+
+// displayHours renders the market status followed by a table of upcoming sessions.
+func displayHours(data []byte) {
+	var r hoursReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	phases := map[string]string{
+		string(calendar.PhasePremarket):  "Pre-market",
+		string(calendar.PhaseOpen):       "Open",
+		string(calendar.PhasePostmarket): "Post-market",
+		string(calendar.PhaseClosed):     "Closed",
+	}
+	eastern := pricing.Eastern()
+	printKV([][2]string{
+		{"Now", r.Now.In(eastern).Format("Mon Jan 2 15:04 MST")},
+		{"Status", phases[r.Phase]},
+		{"Next Open", fmt.Sprintf("%s (in %s)", r.NextOpen.In(eastern).Format("Mon Jan 2 15:04"), untilString(r.NextOpen.Sub(r.Now)))},
+		{"Next Close", fmt.Sprintf("%s (in %s)", r.NextClose.In(eastern).Format("Mon Jan 2 15:04"), untilString(r.NextClose.Sub(r.Now)))},
+	})
+	fmt.Println()
+
+	span := func(s *hoursSession) string {
+		if s == nil {
+			return "-"
+		}
+		return s.Start.In(eastern).Format("15:04") + "-" + s.End.In(eastern).Format("15:04")
+	}
+	var rows [][]string
+	for _, d := range r.Days {
+		status := "Closed"
+		if d.HalfDay {
+			status = "Half day"
+		} else if d.Open {
+			status = "Open"
+		}
+		date, _ := time.Parse("2006-01-02", d.Date)
+		rows = append(rows, []string{
+			date.Format("Mon Jan 2"),
+			status,
+			span(d.Premarket),
+			span(d.Regular),
+			span(d.Postmarket),
+			d.Description,
+		})
+	}
+	printTable([]string{"Date", "Status", "Pre-market", "Regular", "Post-market", "Note"}, rows)
+}
+
+// untilString formats a positive duration as days, hours, and minutes, e.g. "2d 3h 15m".
+func untilString(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days, hours, minutes := int(d.Hours())/24, int(d.Hours())%24, int(d.Minutes())%60
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
+
+func init() {
+	hoursCmd.Flags().Int("days", 7, "Number of days to show, starting today")
+	addCacheFlags(hoursCmd)
+	marketsCmd.AddCommand(hoursCmd)
+}
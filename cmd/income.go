@@ -0,0 +1,388 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// incomeDividend is the category for cash dividends and capital gain distributions.
+	incomeDividend = "dividend"
+
+	// incomeInterest is the category for credit interest paid on cash balances.
+	incomeInterest = "interest"
+
+	// incomePremium is the category for premium collected from selling options.
+	incomePremium = "option_premium"
+)
+
+// incomeReport is the computed result of the income command.
+type incomeReport struct {
+	AccountID      string             `json:"account_id"`
+	Start          string             `json:"start"`
+	End            string             `json:"end"`
+	Totals         incomeTotals       `json:"totals"`
+	ByMonth        []incomeGroup      `json:"by_month"`
+	BySymbol       []incomeGroup      `json:"by_symbol"`
+	Projection     []incomeProjection `json:"projection"`
+	ProjectedTotal float64            `json:"projected_total"`
+	YieldOnCost    float64            `json:"yield_on_cost"`
+	Events         []incomeEvent      `json:"events"`
+}
+
+// incomeTotals holds income amounts split by category.
+type incomeTotals struct {
+	Dividends float64 `json:"dividends"`
+	Interest  float64 `json:"interest"`
+	Premium   float64 `json:"option_premium"`
+	Total     float64 `json:"total"`
+}
+
+// incomeGroup is income for a single month or symbol.
+type incomeGroup struct {
+	Key string `json:"key"`
+	incomeTotals
+}
+
+// incomeEvent is a single income-producing history event.
+type incomeEvent struct {
+	Date     string  `json:"date"`
+	Symbol   string  `json:"symbol"`
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
+}
+
+// incomeProjection estimates the next 12 months of dividends for a current holding.
+type incomeProjection struct {
+	Symbol           string  `json:"symbol"`
+	Quantity         float64 `json:"quantity"`
+	CostBasis        float64 `json:"cost_basis"`
+	TrailingPerShare float64 `json:"trailing_per_share"`
+	ProjectedAnnual  float64 `json:"projected_annual"`
+	YieldOnCost      float64 `json:"yield_on_cost"`
+}
+
+// incomeCmd summarizes dividend, interest, and option premium income from account history.
+var incomeCmd = &cobra.Command{
+	Use:   "income",
+	Short: "Summarize dividend, interest, and option premium income",
+	Long: `Extract dividend, interest, and option premium events from account history, group them by
+month and symbol, and project the next 12 months of dividend income for current positions.
+
+Projections use the trailing 12 months of dividends per share for each symbol currently held.
+Option premium is the gross credit received from option sales.
+
+Examples:
+  tradier accounts income
+  tradier accounts income --start 2025-01-01 --end 2025-12-31`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+		if start == "" {
+			start = now.AddDate(-1, 0, 0).Format("2006-01-02")
+		}
+		if end == "" {
+			end = now.Format("2006-01-02")
+		}
+
+		events, err := fetchIncomeEvents(c, accountID, start, end)
+		if err != nil {
+			return err
+		}
+
+		// Projections use the year up to today whatever the report range, so fetch that year's
+		// dividends separately unless the report range already covers it
+		today := now.Format("2006-01-02")
+		trailingStart := now.AddDate(-1, 0, 0).Format("2006-01-02")
+		trailing := events
+		if trailingStart < start || end < today {
+			if trailing, err = fetchDividendEvents(c, accountID, trailingStart, today); err != nil {
+				return err
+			}
+		}
+		positions, err := c.GetPositions(accountID)
+		if err != nil {
+			return err
+		}
+
+		report := buildIncomeReport(events, trailing, positions, start, end, trailingStart)
+		report.AccountID = accountID
+
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayIncome)
+		return nil
+	},
+}
+
+// fetchIncomeEvents pulls dividend, interest, and trade history and classifies the income-producing events.
+// Events carry the per-share quantity (when Tradier reports one) alongside the amount for projections.
+func fetchIncomeEvents(c *client.Client, accountID, start, end string) ([]incomeSourceEvent, error) {
+	var out []incomeSourceEvent
+	for _, activityType := range []string{"dividend", "interest", "trade"} {
+		events, err := fetchHistoryEvents(c, accountID, activityType, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if ev, ok := classifyIncomeEvent(e); ok {
+				out = append(out, ev)
+			}
+		}
+	}
+	return out, nil
+}
+
+// fetchDividendEvents pulls dividend history between start and end as income events.
+func fetchDividendEvents(c *client.Client, accountID, start, end string) ([]incomeSourceEvent, error) {
+	events, err := fetchHistoryEvents(c, accountID, "dividend", start, end)
+	if err != nil {
+		return nil, err
+	}
+	var out []incomeSourceEvent
+	for _, e := range events {
+		if ev, ok := classifyIncomeEvent(e); ok {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+// incomeSourceEvent is an income event along with the share quantity it was paid on, if known.
+type incomeSourceEvent struct {
+	incomeEvent
+	Quantity float64
+}
+
+// classifyIncomeEvent maps a raw history event to an income category. Trades only count when
+// they are option sales, which bring in premium; everything else is ignored.
+func classifyIncomeEvent(e map[string]interface{}) (incomeSourceEvent, bool) {
+	eventType := str(e, "type")
+	detail := nested(e, eventType)
+	ev := incomeSourceEvent{
+		incomeEvent: incomeEvent{
+			Date:   shortDate(str(e, "date")),
+			Symbol: str(detail, "symbol"),
+			Amount: num(e, "amount"),
+		},
+		Quantity: num(detail, "quantity"),
+	}
+
+	switch eventType {
+	case "dividend":
+		ev.Category = incomeDividend
+	case "interest":
+		ev.Category = incomeInterest
+	case "trade":
+		if !occ.IsOption(ev.Symbol) || num(detail, "quantity") >= 0 || ev.Amount <= 0 {
+			return ev, false
+		}
+		ev.Category = incomePremium
+		ev.Symbol = occ.Underlying(ev.Symbol)
+	default:
+		return ev, false
+	}
+	return ev, true
+}
+
+// buildIncomeReport groups events inside the report range and projects dividends from the
+// trailing events on or after trailingStart.
+func buildIncomeReport(events, trailing []incomeSourceEvent, positionsData []byte, start, end, trailingStart string) *incomeReport {
+	report := &incomeReport{Start: start, End: end}
+	byMonth := map[string]*incomeGroup{}
+	bySymbol := map[string]*incomeGroup{}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Date < events[j].Date })
+	for _, ev := range events {
+		if ev.Date < start || ev.Date > end {
+			continue
+		}
+		report.Events = append(report.Events, ev.incomeEvent)
+		addIncome(&report.Totals, ev.incomeEvent)
+		addIncome(groupFor(byMonth, ev.Date[:7]), ev.incomeEvent)
+		sym := ev.Symbol
+		if sym == "" {
+			sym = "(cash)"
+		}
+		addIncome(groupFor(bySymbol, sym), ev.incomeEvent)
+	}
+	report.ByMonth = sortedGroups(byMonth)
+	report.BySymbol = sortedGroups(bySymbol)
+
+	// Project forward dividends from the trailing year for each current equity position
+	totalCost := 0.0
+	for _, pos := range toSlice(nested(parseJSON(positionsData), "positions")["position"]) {
+		sym := str(pos, "symbol")
+		qty := num(pos, "quantity")
+		if occ.IsOption(sym) || qty <= 0 {
+			continue
+		}
+
+		perShare := 0.0
+		for _, ev := range trailing {
+			if ev.Category != incomeDividend || ev.Symbol != sym || ev.Date < trailingStart {
+				continue
+			}
+			// Prefer the share count on the event; otherwise assume the current holding earned it
+			shares := ev.Quantity
+			if shares <= 0 {
+				shares = qty
+			}
+			perShare += ev.Amount / shares
+		}
+		if perShare <= 0 {
+			continue
+		}
+
+		cost := num(pos, "cost_basis")
+		proj := incomeProjection{
+			Symbol:           sym,
+			Quantity:         qty,
+			CostBasis:        cost,
+			TrailingPerShare: perShare,
+			ProjectedAnnual:  perShare * qty,
+		}
+		if cost > 0 {
+			proj.YieldOnCost = proj.ProjectedAnnual / cost
+		}
+		report.Projection = append(report.Projection, proj)
+		report.ProjectedTotal += proj.ProjectedAnnual
+		totalCost += cost
+	}
+	sort.Slice(report.Projection, func(i, j int) bool {
+		return report.Projection[i].ProjectedAnnual > report.Projection[j].ProjectedAnnual
+	})
+	if totalCost > 0 {
+		report.YieldOnCost = report.ProjectedTotal / totalCost
+	}
+	return report
+}
+
+// addIncome adds an event's amount to the matching category and the running total.
+func addIncome(t *incomeTotals, ev incomeEvent) {
+	switch ev.Category {
+	case incomeDividend:
+		t.Dividends += ev.Amount
+	case incomeInterest:
+		t.Interest += ev.Amount
+	case incomePremium:
+		t.Premium += ev.Amount
+	}
+	t.Total += ev.Amount
+}
+
+// groupFor returns the group for key, creating it on first use.
+func groupFor(groups map[string]*incomeGroup, key string) *incomeTotals {
+	g, ok := groups[key]
+	if !ok {
+		g = &incomeGroup{Key: key}
+		groups[key] = g
+	}
+	return &g.incomeTotals
+}
+
+// sortedGroups flattens a group map into a slice sorted by key.
+func sortedGroups(groups map[string]*incomeGroup) []incomeGroup {
+	out := make([]incomeGroup, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// displayIncome renders income by month and symbol, totals, and the forward dividend projection.
+func displayIncome(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Printf("Income: %s (%s to %s)\n\n", str(root, "account_id"), str(root, "start"), str(root, "end"))
+
+	groupHeaders := func(first string) []string {
+		return []string{first, "DIVIDENDS", "INTEREST", "OPTION PREMIUM", "TOTAL"}
+	}
+	groupRows := func(groups []map[string]interface{}) [][]string {
+		rows := make([][]string, 0, len(groups)+1)
+		for _, g := range groups {
+			rows = append(rows, []string{
+				str(g, "key"),
+				money(num(g, "dividends")),
+				money(num(g, "interest")),
+				money(num(g, "option_premium")),
+				money(num(g, "total")),
+			})
+		}
+		t := nested(root, "totals")
+		return append(rows, []string{
+			"TOTAL",
+			money(num(t, "dividends")),
+			money(num(t, "interest")),
+			money(num(t, "option_premium")),
+			money(num(t, "total")),
+		})
+	}
+
+	fmt.Println("By Month:")
+	printTable(groupHeaders("MONTH"), groupRows(toSlice(root["by_month"])))
+	fmt.Println()
+	fmt.Println("By Symbol:")
+	printTable(groupHeaders("SYMBOL"), groupRows(toSlice(root["by_symbol"])))
+
+	projections := toSlice(root["projection"])
+	if len(projections) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Projected Dividends (next 12 months):")
+	headers := []string{"SYMBOL", "QTY", "COST BASIS", "TTM/SHARE", "PROJECTED", "YIELD ON COST"}
+	rows := make([][]string, 0, len(projections)+1)
+	for _, p := range projections {
+		rows = append(rows, []string{
+			str(p, "symbol"),
+			str(p, "quantity"),
+			money(num(p, "cost_basis")),
+			fmt.Sprintf("%.4f", num(p, "trailing_per_share")),
+			money(num(p, "projected_annual")),
+			fmt.Sprintf("%.2f%%", num(p, "yield_on_cost")*100),
+		})
+	}
+	rows = append(rows, []string{
+		"TOTAL", "", "", "",
+		money(num(root, "projected_total")),
+		fmt.Sprintf("%.2f%%", num(root, "yield_on_cost")*100),
+	})
+	printTable(headers, rows)
+}
+
+func init() {
+	incomeCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	incomeCmd.Flags().String("start", "", "Start date YYYY-MM-DD (defaults to one year ago)")
+	incomeCmd.Flags().String("end", "", "End date YYYY-MM-DD (defaults to today)")
+
+	accountsCmd.AddCommand(incomeCmd)
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/cache"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/indicators"
+	"github.com/spf13/cobra"
+)
+
+// barColumns are the price columns that lead every indicator row.
+var barColumns = []string{"time", "open", "high", "low", "close", "volume"}
+
+// indicatorReport is a bar series with computed indicator columns. Each row maps a column
+// name to its value; indicator values still warming up are null.
+type indicatorReport struct {
+	Symbol   string                   `json:"symbol"`
+	Interval string                   `json:"interval"`
+	Columns  []string                 `json:"columns"`
+	Rows     []map[string]interface{} `json:"rows"`
+}
+
+// indicatorsCmd computes technical indicators over historical or intraday bars.
+var indicatorsCmd = &cobra.Command{
+	Use:   "indicators",
+	Short: "Compute technical indicators over price bars",
+	Long: `Compute technical indicators over historical or intraday price bars and append them as columns.
+
+Supported indicators (parameters are optional and separated by colons):
+  sma:N           Simple moving average of the close (default 20)
+  ema:N           Exponential moving average of the close (default 20)
+  rsi:N           Wilder's relative strength index (default 14)
+  macd:F:S:G      MACD line, signal, and histogram (default 12:26:9; other parameters are
+                  added to the column names, e.g. macd_5_35_5)
+  bbands:N:K      Bollinger Bands, K standard deviations around an N-period SMA (default 20:2)
+  atr:N           Wilder's average true range (default 14)
+  vwap            Volume-weighted average price, reset each session for intraday bars
+
+Daily, weekly, and monthly intervals use historical pricing; tick, 1min, 5min, and 15min use
+time and sales. Indicators are computed over every bar fetched, so request enough history to
+cover the longest warm-up period.
+
+Examples:
+  tradier markets indicators --symbol AAPL --interval daily --start 2026-01-01 --ind sma:20,ema:50,rsi:14
+  tradier markets indicators --symbol AAPL --interval daily --ind macd,bbands:20:2,atr:14 --limit 20
+  tradier markets indicators --symbol SPY --interval 5min --start "2026-10-16 09:30" --ind vwap,ema:9
+  tradier markets indicators --symbol AAPL --ind sma:200 --start 2025-01-01 --csv > aapl.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		symbol, _ := cmd.Flags().GetString("symbol")
+		if symbol == "" {
+			return fmt.Errorf("--symbol is required")
+		}
+		symbol = strings.ToUpper(symbol)
+		interval, _ := cmd.Flags().GetString("interval")
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+		sessionFilter, _ := cmd.Flags().GetString("session-filter")
+		ind, _ := cmd.Flags().GetString("ind")
+		limit, _ := cmd.Flags().GetInt("limit")
+		asCSV, _ := cmd.Flags().GetBool("csv")
+
+		specs, err := indicators.ParseSpecs(ind)
+		if err != nil {
+			return err
+		}
+		b, err := fetchBars(c, openCache(cmd), symbol, interval, start, end, sessionFilter)
+		if err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			return fmt.Errorf("no price data found for %s", symbol)
+		}
+
+		report := buildIndicatorReport(symbol, interval, b, specs, limit)
+		if asCSV {
+			return writeIndicatorsCSV(report)
+		}
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayIndicators)
+		return nil
+	},
+}
+
+// fetchBars retrieves bars for the interval, using historical pricing for daily and longer
+// intervals and time and sales for intraday ones.
+func fetchBars(c *client.Client, store *cache.Store, symbol, interval, start, end, sessionFilter string) ([]bars.Bar, error) {
+	switch interval {
+	case "", "daily", "weekly", "monthly":
+		data, err := fetchHistory(c, store, symbol, interval, start, end)
+		if err != nil {
+			return nil, err
+		}
+		return bars.ParseHistory(data)
+	case "tick", "1min", "5min", "15min":
+		data, err := fetchTimeSales(c, store, symbol, interval, start, end, sessionFilter)
+		if err != nil {
+			return nil, err
+		}
+		return bars.ParseTimeSales(data)
+	}
+	return nil, fmt.Errorf("invalid --interval %q (daily, weekly, monthly, tick, 1min, 5min, 15min)", interval)
+}
+
+// buildIndicatorReport computes every indicator over all bars and keeps the last limit rows.
+func buildIndicatorReport(symbol, interval string, b []bars.Bar, specs []indicators.Spec, limit int) indicatorReport {
+	if interval == "" {
+		interval = "daily"
+	}
+	report := indicatorReport{Symbol: symbol, Interval: interval, Columns: append([]string(nil), barColumns...)}
+
+	var cols []indicators.Column
+	for _, spec := range specs {
+		for _, col := range indicators.Compute(spec, b) {
+			cols = append(cols, col)
+			report.Columns = append(report.Columns, col.Name)
+		}
+	}
+
+	layout := "2006-01-02"
+	if bars.Intraday(b) {
+		layout = "2006-01-02 15:04"
+	}
+	first := 0
+	if limit > 0 && len(b) > limit {
+		first = len(b) - limit
+	}
+	for i := first; i < len(b); i++ {
+		row := map[string]interface{}{
+			"time":   b[i].Time.Format(layout),
+			"open":   b[i].Open,
+			"high":   b[i].High,
+			"low":    b[i].Low,
+			"close":  b[i].Close,
+			"volume": b[i].Volume,
+		}
+		for _, col := range cols {
+			if math.IsNaN(col.Values[i]) {
+				row[col.Name] = nil
+			} else {
+				row[col.Name] = col.Values[i]
+			}
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	return report
+}
+
+// writeIndicatorsCSV writes the report to stdout as CSV, leaving warm-up values empty.
+func writeIndicatorsCSV(r indicatorReport) error {
+	w := csv.NewWriter(os.Stdout)
+	if err := w.Write(r.Columns); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		record := make([]string, len(r.Columns))
+		for i, name := range r.Columns {
+			switch v := row[name].(type) {
+			case string:
+				record[i] = v
+			case float64:
+				record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// displayIndicators renders the bars and indicator columns as a table.
+func displayIndicators(data []byte) {
+	var r indicatorReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Printf("Symbol: %s  Interval: %s\n\n", r.Symbol, r.Interval)
+	headers := make([]string, len(r.Columns))
+	for i, name := range r.Columns {
+		headers[i] = strings.ToUpper(name)
+	}
+	rows := make([][]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		cells := make([]string, len(r.Columns))
+		for i, name := range r.Columns {
+			switch v := row[name].(type) {
+			case string:
+				cells[i] = v
+			case float64:
+				if name == "volume" {
+					cells[i] = strconv.FormatFloat(v, 'f', 0, 64)
+				} else {
+					cells[i] = fmt.Sprintf("%.2f", v)
+				}
+			default:
+				cells[i] = "-"
+			}
+		}
+		rows = append(rows, cells)
+	}
+	printTable(headers, rows)
+}
+
+func init() {
+	indicatorsCmd.Flags().String("symbol", "", "Security symbol (required)")
+	indicatorsCmd.Flags().String("interval", "daily", "Interval: daily, weekly, monthly, tick, 1min, 5min, 15min")
+	indicatorsCmd.Flags().String("start", "", "Start date YYYY-MM-DD (or datetime YYYY-MM-DD HH:MM for intraday)")
+	indicatorsCmd.Flags().String("end", "", "End date YYYY-MM-DD (or datetime YYYY-MM-DD HH:MM for intraday)")
+	indicatorsCmd.Flags().String("session-filter", "", "Session filter for intraday intervals: open, all")
+	indicatorsCmd.Flags().String("ind", "sma:20,rsi:14", "Comma-separated indicators, e.g. sma:20,ema:50,rsi:14,macd,bbands:20:2,atr:14,vwap")
+	indicatorsCmd.Flags().Int("limit", 0, "Only show the most recent N bars (0 for all)")
+	indicatorsCmd.Flags().Bool("csv", false, "Write the bars and indicators as CSV")
+	addCacheFlags(indicatorsCmd)
+
+	marketsCmd.AddCommand(indicatorsCmd)
+}
@@ -81,6 +81,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 		SandboxAPIKey:       sandboxAPIKey,
 		SandboxAccountID:    sandboxAccountID,
 	}
+	// Keep settings that init doesn't prompt for
+	if existing, err := config.Load(); err == nil {
+		cfg.Journal = existing.Journal
+	}
 
 	if err := config.Save(cfg); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
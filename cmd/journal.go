@@ -0,0 +1,488 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/config"
+	"github.com/cloudmanic/tradier/journal"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// journalCmd is the parent command for browsing and annotating the trade journal.
+var journalCmd = &cobra.Command{
+	Use:   "journal",
+	Short: "Local trade journal commands",
+	Long: `Commands for the local trade journal kept in ~/.config/tradier/journal.jsonl.
+
+Orders placed, changed, or canceled with 'tradier trading' are journaled when journaling is
+enabled with 'tradier journal enable', or for one order with --journal or --note. Each entry
+holds the request parameters, Tradier's response (or the error), the quotes of the order's
+symbols when it was sent, and the note. Entries are never rewritten; annotate them afterwards
+with how the trade turned out.`,
+}
+
+// listJournalCmd lists journal entries.
+var listJournalCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List journal entries",
+	Long: `List journal entries, most recent last.
+
+Examples:
+  tradier journal list
+  tradier journal list --symbol AAPL --since 2026-10-01
+  tradier journal list --action cancel --limit 20`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := loadJournal(cmd)
+		if err != nil {
+			return err
+		}
+		if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 && len(entries) > limit {
+			entries = entries[len(entries)-limit:]
+		}
+		if entries == nil {
+			entries = []journal.Entry{}
+		}
+		out, err := json.Marshal(map[string]interface{}{"journal": entries})
+		if err != nil {
+			return err
+		}
+		printResult(out, displayJournal)
+		return nil
+	},
+}
+
+// showJournalCmd shows one journal entry in full.
+var showJournalCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show a journal entry with its request, response, quotes, and annotations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt("id")
+		if id <= 0 {
+			return fmt.Errorf("--id is required")
+		}
+		path, err := journal.DefaultPath()
+		if err != nil {
+			return err
+		}
+		entries, err := journal.Load(path)
+		if err != nil {
+			return err
+		}
+		e, ok := journal.Find(entries, id)
+		if !ok {
+			return fmt.Errorf("no journal entry with ID %d", id)
+		}
+		out, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayJournalEntry)
+		return nil
+	},
+}
+
+// annotateJournalCmd adds a note, outcome, or P&L to a journal entry.
+var annotateJournalCmd = &cobra.Command{
+	Use:   "annotate",
+	Short: "Annotate a journal entry with a note, outcome, or P&L",
+	Long: `Add an annotation to a journal entry. Annotations accumulate; the latest outcome and P&L are
+the ones listed and exported.
+
+Examples:
+  tradier journal annotate --id 12 --outcome win --pnl 245.50 --note "sold into the gap up"
+  tradier journal annotate --id 13 --note "filled late; should have used a limit"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt("id")
+		if id <= 0 {
+			return fmt.Errorf("--id is required")
+		}
+		note, _ := cmd.Flags().GetString("note")
+		outcome, _ := cmd.Flags().GetString("outcome")
+		a := journal.Annotation{Note: note, Outcome: outcome}
+		if cmd.Flags().Changed("pnl") {
+			pnl, _ := cmd.Flags().GetFloat64("pnl")
+			a.PnL = &pnl
+		}
+
+		path, err := journal.DefaultPath()
+		if err != nil {
+			return err
+		}
+		if err := journal.Annotate(path, id, a); err != nil {
+			return err
+		}
+		fmt.Printf("Annotated journal entry %d\n", id)
+		return nil
+	},
+}
+
+// exportJournalCmd writes journal entries as CSV or JSON Lines.
+var exportJournalCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export journal entries as CSV or JSON Lines",
+	Long: `Export journal entries, with annotations merged, to a file or standard output. CSV has one
+row per entry with the common order fields, the quote of the order's symbol, the notes, and the
+latest outcome and P&L; JSON Lines keeps every field.
+
+Examples:
+  tradier journal export --output journal.csv
+  tradier journal export --format jsonl --since 2026-01-01 > journal.jsonl`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		if format != "csv" && format != "jsonl" {
+			return fmt.Errorf("--format must be csv or jsonl")
+		}
+		entries, err := loadJournal(cmd)
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		output, _ := cmd.Flags().GetString("output")
+		if output != "" {
+			f, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("unable to create %s: %w", output, err)
+			}
+			defer f.Close()
+			w = f
+		}
+		if format == "csv" {
+			err = journal.WriteCSV(w, entries)
+		} else {
+			err = journal.WriteJSONL(w, entries)
+		}
+		if err != nil {
+			return err
+		}
+		if output != "" {
+			fmt.Fprintf(os.Stderr, "Exported %d journal entries to %s\n", len(entries), output)
+		}
+		return nil
+	},
+}
+
+// enableJournalCmd turns on journaling of every trading command.
+var enableJournalCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Journal every order placed, changed, or canceled",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setJournaling(true)
+	},
+}
+
+// disableJournalCmd turns off journaling except for orders given --journal or --note.
+var disableJournalCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Only journal orders given --journal or --note",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setJournaling(false)
+	},
+}
+
+// setJournaling saves the journaling setting in the config file.
+func setJournaling(on bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	cfg.Journal = on
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	if on {
+		fmt.Println("Trade journaling enabled")
+	} else {
+		fmt.Println("Trade journaling disabled; use --journal or --note to journal an order")
+	}
+	return nil
+}
+
+// loadJournal reads the journal and applies the --symbol, --action, --since, and --until flags.
+func loadJournal(cmd *cobra.Command) ([]journal.Entry, error) {
+	var f journal.Filter
+	f.Symbol, _ = cmd.Flags().GetString("symbol")
+	action, _ := cmd.Flags().GetString("action")
+	f.Action = journal.Action(strings.ToLower(action))
+	for flag, t := range map[string]*time.Time{"since": &f.Since, "until": &f.Until} {
+		value, _ := cmd.Flags().GetString(flag)
+		if value == "" {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", value, pricing.Eastern())
+		if err != nil {
+			return nil, fmt.Errorf("--%s must be a date (YYYY-MM-DD): %w", flag, err)
+		}
+		if flag == "until" {
+			// Include the whole day
+			day = day.AddDate(0, 0, 1)
+		}
+		*t = day
+	}
+
+	path, err := journal.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := journal.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return f.Apply(entries), nil
+}
+
+// orderJournal is a trading command being recorded in the journal.
+type orderJournal struct {
+	path  string
+	entry journal.Entry
+}
+
+// startOrderJournal returns nil unless journaling is enabled in the config or by the command's
+// --journal or --note flags. Otherwise it captures the request and, before the order is sent,
+// the quotes of its symbols. Change and cancel requests carry only the order ID, so the order is
+// looked up to find what it trades.
+func startOrderJournal(cmd *cobra.Command, c *client.Client, cfg *config.Config, action journal.Action, accountID, orderID string, params map[string]string) *orderJournal {
+	note, _ := cmd.Flags().GetString("note")
+	forced, _ := cmd.Flags().GetBool("journal")
+	if !cfg.Journal && !forced && note == "" {
+		return nil
+	}
+	path, err := journal.DefaultPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: not journaled: %v\n", err)
+		return nil
+	}
+
+	e := journal.Entry{
+		Time:      time.Now(),
+		Action:    action,
+		AccountID: accountID,
+		Sandbox:   sandboxMode,
+		Paper:     paperMode,
+		OrderID:   orderID,
+		Symbol:    strings.ToUpper(params["symbol"]),
+		Params:    map[string]string{},
+		Note:      note,
+	}
+	for k, v := range params {
+		e.Params[k] = v
+	}
+	if action == journal.ActionPlace && params["preview"] == "true" {
+		e.Action = journal.ActionPreview
+	}
+
+	symbols := journal.Symbols(e)
+	if orderID != "" {
+		data, err := c.GetOrder(accountID, orderID, "true")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: journal: unable to look up order %s: %v\n", orderID, err)
+		}
+		o := nested(parseJSON(data), "order")
+		e.Symbol = str(o, "symbol")
+		legs := toSlice(o["leg"])
+		for _, leg := range append([]map[string]interface{}{o}, legs...) {
+			symbols = append(symbols, str(leg, "symbol"), str(leg, "option_symbol"))
+		}
+	}
+	if len(symbols) > 0 {
+		quotes, err := fetchQuoteMap(c, symbols, false)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: journal: unable to fetch quotes: %v\n", err)
+		}
+		for symbol, q := range quotes {
+			if e.Quotes == nil {
+				e.Quotes = map[string]journal.Quote{}
+			}
+			e.Quotes[symbol] = journal.Quote{Bid: num(q, "bid"), Ask: num(q, "ask"), Last: num(q, "last")}
+		}
+	}
+	return &orderJournal{path: path, entry: e}
+}
+
+// finish records the response or error of the request. A journal that can't be written is
+// reported as a warning; the order has already been sent.
+func (j *orderJournal) finish(data []byte, err error) {
+	if j == nil {
+		return
+	}
+	if err != nil {
+		j.entry.Error = err.Error()
+	}
+	j.entry.SetResponse(data)
+	if j.entry.OrderID == "" {
+		j.entry.OrderID = str(nested(parseJSON(data), "order"), "id")
+	}
+	if _, err := journal.Append(j.path, j.entry); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: not journaled: %v\n", err)
+	}
+}
+
+// addJournalFlags adds the per-command journaling flags to a trading command.
+func addJournalFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("journal", false, "Record this request in the trade journal")
+	cmd.Flags().String("note", "", "Note for the trade journal (implies --journal)")
+}
+
+// displayJournal renders journal entries as a table.
+func displayJournal(data []byte) {
+	var out struct {
+		Entries []journal.Entry `json:"journal"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	if len(out.Entries) == 0 {
+		fmt.Println("No journal entries.")
+		return
+	}
+
+	eastern := pricing.Eastern()
+	var rows [][]string
+	for _, e := range out.Entries {
+		order := "-"
+		if len(e.Params) > 0 {
+			order = orderSummary(e.Params)
+		}
+		status := journal.Status(e)
+		if status == "" {
+			status = "-"
+		}
+		outcome, pnl := e.Outcome()
+		if pnl != nil {
+			outcome = strings.TrimSpace(outcome + " " + money(*pnl))
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(e.ID),
+			e.Time.In(eastern).Format("2006-01-02 15:04"),
+			string(e.Action),
+			formatOptionSymbol(e.Symbol),
+			e.OrderID,
+			order,
+			status,
+			e.Note,
+			outcome,
+		})
+	}
+	printTable([]string{"ID", "Time", "Action", "Symbol", "Order ID", "Order", "Status", "Note", "Outcome"}, rows)
+}
+
+// displayJournalEntry renders one journal entry: its details, request, quotes, response, and
+// annotations.
+func displayJournalEntry(data []byte) {
+	var e journal.Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	eastern := pricing.Eastern()
+	account := e.AccountID
+	if e.Sandbox {
+		account += " (sandbox)"
+	}
+	if e.Paper {
+		account += " (paper)"
+	}
+	kv := [][2]string{
+		{"ID", strconv.Itoa(e.ID)},
+		{"Time", e.Time.In(eastern).Format("2006-01-02 15:04:05 MST")},
+		{"Action", string(e.Action)},
+		{"Account", account},
+		{"Symbol", formatOptionSymbol(e.Symbol)},
+		{"Order ID", e.OrderID},
+		{"Status", journal.Status(e)},
+	}
+	if e.Note != "" {
+		kv = append(kv, [2]string{"Note", e.Note})
+	}
+	if e.Error != "" {
+		kv = append(kv, [2]string{"Error", e.Error})
+	}
+	printKV(kv)
+
+	if len(e.Params) > 0 {
+		keys := make([]string, 0, len(e.Params))
+		for k := range e.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var rows [][]string
+		for _, k := range keys {
+			rows = append(rows, []string{k, e.Params[k]})
+		}
+		fmt.Println()
+		printTable([]string{"Parameter", "Value"}, rows)
+	}
+
+	if len(e.Quotes) > 0 {
+		symbols := make([]string, 0, len(e.Quotes))
+		for s := range e.Quotes {
+			symbols = append(symbols, s)
+		}
+		sort.Strings(symbols)
+		var rows [][]string
+		for _, s := range symbols {
+			q := e.Quotes[s]
+			rows = append(rows, []string{formatOptionSymbol(s), money(q.Bid), money(q.Ask), money(q.Last)})
+		}
+		fmt.Println()
+		printTable([]string{"Symbol", "Bid", "Ask", "Last"}, rows)
+	}
+
+	if len(e.Response) > 0 {
+		fmt.Println()
+		displayOrderResult(e.Response)
+	}
+
+	if len(e.Annotations) > 0 {
+		var rows [][]string
+		for _, a := range e.Annotations {
+			pnl := "-"
+			if a.PnL != nil {
+				pnl = money(*a.PnL)
+			}
+			rows = append(rows, []string{a.Time.In(eastern).Format("2006-01-02 15:04"), a.Outcome, pnl, a.Note})
+		}
+		fmt.Println()
+		printTable([]string{"Annotated", "Outcome", "P&L", "Note"}, rows)
+	}
+}
+
+func init() {
+	// Filter flags shared by list and export
+	for _, c := range []*cobra.Command{listJournalCmd, exportJournalCmd} {
+		c.Flags().String("symbol", "", "Only entries for this symbol, underlying, or option leg")
+		c.Flags().String("action", "", "Only entries with this action: place, preview, change, cancel")
+		c.Flags().String("since", "", "Only entries on or after this date (YYYY-MM-DD)")
+		c.Flags().String("until", "", "Only entries on or before this date (YYYY-MM-DD)")
+	}
+	listJournalCmd.Flags().Int("limit", 0, "Only the most recent N entries")
+
+	// Show and annotate flags
+	showJournalCmd.Flags().Int("id", 0, "Journal entry ID (required)")
+	annotateJournalCmd.Flags().Int("id", 0, "Journal entry ID (required)")
+	annotateJournalCmd.Flags().String("note", "", "Free-text note")
+	annotateJournalCmd.Flags().String("outcome", "", "Outcome of the trade, e.g. win, loss, scratch")
+	annotateJournalCmd.Flags().Float64("pnl", 0, "Realized P&L of the trade in dollars")
+
+	// Export flags
+	exportJournalCmd.Flags().String("format", "csv", "Export format: csv or jsonl")
+	exportJournalCmd.Flags().String("output", "", "File to write (defaults to standard output)")
+
+	// Build command tree
+	journalCmd.AddCommand(listJournalCmd, showJournalCmd, annotateJournalCmd, exportJournalCmd, enableJournalCmd, disableJournalCmd)
+	rootCmd.AddCommand(journalCmd)
+}
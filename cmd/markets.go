@@ -4,8 +4,13 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/cloudmanic/tradier/chain"
 	"github.com/spf13/cobra"
 )
 
@@ -66,6 +71,22 @@ var postQuotesCmd = &cobra.Command{
 var optionsChainsCmd = &cobra.Command{
 	Use:   "options-chains",
 	Short: "Get option chains for a symbol and expiration date",
+	Long: `Get the option chain for a symbol and expiration with computed analytics: mid price,
+spread percentage, intrinsic and extrinsic value, and probability of expiring in the money.
+
+Filters can be combined to narrow the chain, and --side-by-side shows calls and puts on the
+same row with the strike in the middle. --local-greeks solves implied volatility from each
+mid price and computes greeks locally instead of using Tradier's hourly ORATS values.
+
+With --json and none of these options, the chain is printed as Tradier returns it. The
+analytics need the underlying's price; if it can't be fetched, the table shows the chain
+without them.
+
+Examples:
+  tradier markets options-chains --symbol SPY --expiration 2026-11-20
+  tradier markets options-chains --symbol SPY --expiration 2026-11-20 --strikes-around 10 --side-by-side
+  tradier markets options-chains --symbol AAPL --expiration 2026-11-20 --type put --delta-range 0.2-0.4 --min-oi 100 --max-spread-pct 10
+  tradier markets options-chains --symbol SPY --expiration 2026-11-20 --strikes-around 5 --local-greeks --rate 0.04`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, _, err := loadClientFromConfig()
 		if err != nil {
@@ -77,11 +98,74 @@ var optionsChainsCmd = &cobra.Command{
 			return fmt.Errorf("--symbol and --expiration are required")
 		}
 		greeks, _ := cmd.Flags().GetString("greeks")
+		deltaRange, _ := cmd.Flags().GetString("delta-range")
+		optionType, _ := cmd.Flags().GetString("type")
+		strikesAround, _ := cmd.Flags().GetInt("strikes-around")
+		minOI, _ := cmd.Flags().GetFloat64("min-oi")
+		maxSpread, _ := cmd.Flags().GetFloat64("max-spread-pct")
+		sideBySide, _ := cmd.Flags().GetBool("side-by-side")
+		localGreeks, _ := cmd.Flags().GetBool("local-greeks")
+		model, err := pricingModelFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		filter := chain.Filter{
+			Type:          strings.ToLower(optionType),
+			MinOpenInt:    minOI,
+			MaxSpreadPct:  maxSpread,
+			StrikesAround: strikesAround,
+		}
+		if filter.Type != "" && filter.Type != "call" && filter.Type != "put" {
+			return fmt.Errorf("--type must be call or put")
+		}
+		if deltaRange != "" {
+			filter.MinDelta, filter.MaxDelta, err = parseRange(deltaRange)
+			if err != nil {
+				return fmt.Errorf("invalid --delta-range: %w", err)
+			}
+			// Delta filtering needs greeks in the response
+			greeks = "true"
+		}
+
 		data, err := c.GetOptionsChains(symbol, expiration, greeks)
 		if err != nil {
 			return err
 		}
-		printResult(data, displayOptionsChains)
+		required := filter != (chain.Filter{}) || localGreeks || sideBySide
+		if !required && jsonOutput {
+			printResult(data, displayOptionsChains)
+			return nil
+		}
+
+		spot, err := underlyingPrice(c, symbol)
+		if err != nil && !required {
+			fmt.Fprintf(os.Stderr, "Warning: showing the chain without analytics: %v\n", err)
+			printResult(data, displayOptionsChains)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		filter.Spot = spot
+
+		contracts, err := chain.Parse(data)
+		if err != nil {
+			return err
+		}
+		if localGreeks {
+			model.applyLocalGreeks(contracts, spot, time.Now())
+		}
+		out, err := json.Marshal(analyzedChain(symbol, spot, filter.Apply(contracts), time.Now()))
+		if err != nil {
+			return err
+		}
+
+		if sideBySide {
+			printResult(out, displayOptionsChainsSideBySide)
+		} else {
+			printResult(out, displayOptionsChains)
+		}
 		return nil
 	},
 }
@@ -164,6 +248,19 @@ var optionsLookupCmd = &cobra.Command{
 var marketHistoryCmd = &cobra.Command{
 	Use:   "history",
 	Short: "Get historical pricing for a security",
+	Long: `Get historical OHLCV pricing for a security.
+
+Use --chart to draw candlesticks (or --chart-style line) with a volume panel, sized to the
+terminal width, instead of a table. Moving averages, Bollinger Bands, and VWAP can be overlaid.
+
+Daily bars requested with --start are kept in a local cache (under the user cache directory,
+e.g. ~/.cache/tradier) and only days not already cached are downloaded. Use --no-cache to
+bypass it.
+
+Examples:
+  tradier markets history --symbol AAPL --start 2026-01-01
+  tradier markets history --symbol AAPL --start 2026-06-01 --chart --overlay sma:20,sma:50
+  tradier markets history --symbol SPY --interval weekly --start 2024-01-01 --chart --chart-style line`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, _, err := loadClientFromConfig()
 		if err != nil {
@@ -176,11 +273,14 @@ var marketHistoryCmd = &cobra.Command{
 		interval, _ := cmd.Flags().GetString("interval")
 		start, _ := cmd.Flags().GetString("start")
 		end, _ := cmd.Flags().GetString("end")
-		data, err := c.GetHistoricalPricing(symbol, interval, start, end)
+		if err := validateChartFlags(); err != nil {
+			return err
+		}
+		data, err := fetchHistory(c, openCache(cmd), symbol, interval, start, end)
 		if err != nil {
 			return err
 		}
-		printResult(data, displayMarketHistory)
+		printChartResult(data, displayMarketHistory, displayHistoryChart)
 		return nil
 	},
 }
@@ -189,6 +289,19 @@ var marketHistoryCmd = &cobra.Command{
 var timesalesCmd = &cobra.Command{
 	Use:   "timesales",
 	Short: "Get time and sales data for a symbol",
+	Long: `Get time and sales data for a symbol.
+
+Tradier only provides tick, 1min, 5min, and 15min intervals. Use --resample to aggregate them
+into any other bar width (2min, 30min, 1h, 4h), aligned to the market calendar's sessions.
+
+1min, 5min, and 15min bars requested with --start are cached locally a day at a time, so
+repeated requests only download days not already cached. Use --no-cache to bypass it.
+
+Examples:
+  tradier markets timesales --symbol AAPL --interval 5min --start "2026-10-16 09:30"
+  tradier markets timesales --symbol AAPL --resample 1h --start "2026-10-12 09:30" --end "2026-10-16 16:00"
+  tradier markets timesales --symbol SPY --interval 1min --resample 2min --session-filter open
+  tradier markets timesales --symbol SPY --interval 5min --start "2026-10-16 09:30" --chart --overlay vwap`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, _, err := loadClientFromConfig()
 		if err != nil {
@@ -202,11 +315,23 @@ var timesalesCmd = &cobra.Command{
 		start, _ := cmd.Flags().GetString("start")
 		end, _ := cmd.Flags().GetString("end")
 		sessionFilter, _ := cmd.Flags().GetString("session-filter")
-		data, err := c.GetTimeSales(symbol, interval, start, end, sessionFilter)
+		resample, _ := cmd.Flags().GetString("resample")
+		if err := validateChartFlags(); err != nil {
+			return err
+		}
+		if resample != "" {
+			data, err := resampleTimeSales(c, openCache(cmd), symbol, interval, start, end, sessionFilter, resample)
+			if err != nil {
+				return err
+			}
+			printChartResult(data, displayTimeSales, displayTimeSalesChart)
+			return nil
+		}
+		data, err := fetchTimeSales(c, openCache(cmd), symbol, interval, start, end, sessionFilter)
 		if err != nil {
 			return err
 		}
-		printResult(data, displayTimeSales)
+		printChartResult(data, displayTimeSales, displayTimeSalesChart)
 		return nil
 	},
 }
@@ -327,6 +452,14 @@ func init() {
 	optionsChainsCmd.Flags().String("symbol", "", "Underlying symbol (required)")
 	optionsChainsCmd.Flags().String("expiration", "", "Expiration date YYYY-MM-DD (required)")
 	optionsChainsCmd.Flags().String("greeks", "", "Include greeks: true/false")
+	optionsChainsCmd.Flags().String("delta-range", "", "Absolute delta range, e.g. 0.2-0.4 (fetches greeks)")
+	optionsChainsCmd.Flags().Int("strikes-around", 0, "Only show N strikes either side of the underlying price")
+	optionsChainsCmd.Flags().String("type", "", "Option type: call, put")
+	optionsChainsCmd.Flags().Float64("min-oi", 0, "Minimum open interest")
+	optionsChainsCmd.Flags().Float64("max-spread-pct", 0, "Maximum bid/ask spread as a percentage of mid")
+	optionsChainsCmd.Flags().Bool("side-by-side", false, "Show calls and puts side by side with the strike in the middle")
+	optionsChainsCmd.Flags().Bool("local-greeks", false, "Compute implied volatility and greeks locally from mid prices")
+	addPricingFlags(optionsChainsCmd)
 
 	// Options expirations flags
 	optionsExpirationsCmd.Flags().String("symbol", "", "Underlying symbol (required)")
@@ -350,6 +483,8 @@ func init() {
 	marketHistoryCmd.Flags().String("interval", "", "Interval: daily, weekly, monthly")
 	marketHistoryCmd.Flags().String("start", "", "Start date YYYY-MM-DD")
 	marketHistoryCmd.Flags().String("end", "", "End date YYYY-MM-DD")
+	addChartFlags(marketHistoryCmd)
+	addCacheFlags(marketHistoryCmd)
 
 	// Timesales flags
 	timesalesCmd.Flags().String("symbol", "", "Security symbol (required)")
@@ -357,6 +492,9 @@ func init() {
 	timesalesCmd.Flags().String("start", "", "Start datetime YYYY-MM-DD HH:MM")
 	timesalesCmd.Flags().String("end", "", "End datetime YYYY-MM-DD HH:MM")
 	timesalesCmd.Flags().String("session-filter", "", "Session filter: open, all")
+	timesalesCmd.Flags().String("resample", "", "Aggregate into bars of this width: e.g. 2min, 30min, 1h, 4h")
+	addChartFlags(timesalesCmd)
+	addCacheFlags(timesalesCmd)
 
 	// Calendar flags
 	calendarCmd.Flags().String("month", "", "Month (1-12)")
@@ -0,0 +1,204 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/config"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// paperCmd is the parent command for managing the paper-trading account.
+var paperCmd = &cobra.Command{
+	Use:   "paper",
+	Short: "Paper trading account commands",
+	Long: `Commands for the local paper-trading account kept in ~/.config/tradier/paper.json.
+
+Add --paper to any account or trading command to use the paper account instead of a Tradier
+account. Orders fill against live production quotes, so a production API key is required:
+market orders take the bid or ask, limit orders fill at the touch once it reaches the limit,
+stops trigger on the last price, and fills are limited to the displayed size, so large orders
+fill in parts. Equity orders and option contracts are charged the configured commissions. Day
+orders expire at the close, and options left open past expiration are exercised or assigned
+when at least a cent in the money (index options settle in cash) or expire worthless.
+
+The account is brought up to date whenever it is used; run 'tradier paper run' to fill working
+orders as the market moves. Equity, option, and multileg orders are supported. Buys need the
+cash to pay for them; short sales have no margin requirement. The account is created with
+$100,000 on first use.
+
+Examples:
+  tradier trading place --paper --class equity --symbol AAPL --side buy --quantity 10 --type market --duration day
+  tradier accounts positions --paper
+  tradier paper run
+  tradier paper reset --cash 25000`,
+}
+
+// resetPaperCmd replaces the paper account with a new one.
+var resetPaperCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Start the paper account over with new cash and commissions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cash, _ := cmd.Flags().GetFloat64("cash")
+		if cash < 0 {
+			return fmt.Errorf("--cash must not be negative")
+		}
+		commission := paper.DefaultCommission
+		commission.PerOrder, _ = cmd.Flags().GetFloat64("commission-per-order")
+		commission.PerContract, _ = cmd.Flags().GetFloat64("commission-per-contract")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		path, err := paper.DefaultPath()
+		if err != nil {
+			return err
+		}
+		existing, err := paper.Load(path)
+		if err != nil {
+			return err
+		}
+		if existing != nil && (len(existing.Positions) > 0 || len(existing.Orders) > 0) && !yes {
+			if jsonOutput {
+				return fmt.Errorf("the paper account has positions or orders; add --yes to reset it")
+			}
+			fmt.Printf("Discard the paper account's %d positions and %d orders? [y/N]: ", len(existing.Positions), len(existing.Orders))
+			answer, err := readLine(bufio.NewReader(os.Stdin))
+			if err != nil {
+				return err
+			}
+			if !strings.EqualFold(answer, "y") && !strings.EqualFold(answer, "yes") {
+				fmt.Println("Paper account unchanged.")
+				return nil
+			}
+		}
+
+		if err := paper.Reset(path, cash, commission); err != nil {
+			return err
+		}
+		fmt.Printf("Paper account reset with %s cash\n", money(cash))
+		return nil
+	},
+}
+
+// runPaperCmd fills paper orders as quotes change until interrupted.
+var runPaperCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Fill paper orders as the market moves until interrupted",
+	Long: `Bring the paper account up to date every --interval, so working orders fill, day orders
+expire, and expired options settle close to when they would at a broker, and print each fill
+and settlement. With --json each event is printed as one JSON object per line.
+
+Examples:
+  tradier paper run
+  tradier paper run --interval 2s`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if sandboxMode {
+			return fmt.Errorf("the paper account fills against production quotes; don't combine it with --sandbox")
+		}
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w\nRun 'tradier init' to configure your API key", err)
+		}
+		engine, err := paperEngine(cfg)
+		if err != nil {
+			return err
+		}
+		interval, _ := cmd.Flags().GetDuration("interval")
+		if interval <= 0 {
+			return fmt.Errorf("--interval must be positive")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		fmt.Fprintf(os.Stderr, "Filling paper orders every %s. Press Ctrl+C to stop.\n", interval)
+
+		for {
+			events, err := engine.Process()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+			for _, ev := range events {
+				printPaperEvent(ev)
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(interval):
+			}
+		}
+	},
+}
+
+// loadPaperClient returns a client that reads market data from production and answers
+// account and order requests from the paper account.
+func loadPaperClient(cfg *config.Config) (*client.Client, *config.Config, error) {
+	if sandboxMode {
+		return nil, nil, fmt.Errorf("--paper and --sandbox can't be combined; paper trading fills against production quotes")
+	}
+	engine, err := paperEngine(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := client.NewClient(cfg.BaseURL(false), cfg.APIKey(false))
+	c.HTTPClient.Transport = engine.Transport(nil)
+	return c, cfg, nil
+}
+
+// paperEngine returns the paper account, priced with production quotes and open during the
+// sessions on the production market calendar.
+func paperEngine(cfg *config.Config) (*paper.Engine, error) {
+	apiKey := cfg.APIKey(false)
+	if apiKey == "" {
+		return nil, fmt.Errorf("paper trading needs a production API key for market data. Run 'tradier init' to set it up")
+	}
+	path, err := paper.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	data := client.NewClient(cfg.BaseURL(false), apiKey)
+	return paper.New(path, paper.ClientQuotes(data), marketCalendar(data, nil).IsOpen), nil
+}
+
+// printPaperEvent prints a paper fill or option settlement as one line, or as JSON with --json.
+func printPaperEvent(ev paper.Event) {
+	if jsonOutput {
+		line, _ := json.Marshal(ev)
+		fmt.Println(string(line))
+		return
+	}
+	detail := ev.Description
+	if ev.Type == "option" {
+		detail = fmt.Sprintf("%s %s", formatOptionSymbol(ev.Symbol), strings.ToLower(ev.Description))
+	}
+	if ev.OrderID > 0 {
+		detail = fmt.Sprintf("order %d: %s", ev.OrderID, detail)
+	}
+	fmt.Printf("%s  %s  %s\n", ev.Date.In(pricing.Eastern()).Format("2006-01-02 15:04:05"), detail, money(ev.Amount))
+}
+
+func init() {
+	// Reset flags
+	resetPaperCmd.Flags().Float64("cash", paper.DefaultCash, "Starting cash")
+	resetPaperCmd.Flags().Float64("commission-per-order", paper.DefaultCommission.PerOrder, "Commission per equity order")
+	resetPaperCmd.Flags().Float64("commission-per-contract", paper.DefaultCommission.PerContract, "Commission per option contract")
+	resetPaperCmd.Flags().Bool("yes", false, "Reset without prompting")
+
+	// Run flags
+	runPaperCmd.Flags().Duration("interval", 5*time.Second, "How often to check working orders against quotes")
+
+	// Build command tree
+	paperCmd.AddCommand(resetPaperCmd, runPaperCmd)
+	rootCmd.AddCommand(paperCmd)
+}
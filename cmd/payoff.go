@@ -0,0 +1,407 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/chart"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/payoff"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// payoffReport is a trade's legs, priced from current quotes, and its P&L profile.
+type payoffReport struct {
+	Underlying string       `json:"underlying"`
+	Spot       float64      `json:"underlying_price"`
+	Legs       []payoff.Leg `json:"legs"`
+	payoff.Analysis
+}
+
+// payoffOptions are the user-chosen range, valuation date, and model inputs for a payoff. Net,
+// when set, is an order's net price per unit, which the legs are priced to open at.
+type payoffOptions struct {
+	Low      float64
+	High     float64
+	Date     time.Time
+	Rate     float64
+	Dividend float64
+	Net      *float64
+}
+
+// payoffCmd renders the risk profile of a multi-leg trade.
+var payoffCmd = &cobra.Command{
+	Use:   "payoff",
+	Short: "Show the P&L profile of an option trade at expiration and before",
+	Long: `Compute a trade's profit and loss across a range of underlying prices at the first
+expiration, and optionally on an earlier date, using current quotes. Reports max profit,
+max loss, breakevens, and the probability of profit implied by the options' volatility.
+
+Each --leg is "QTY SYMBOL" or "QTY SYMBOL@PRICE", with a negative quantity for short legs.
+Legs without a price use the current mid. Stock legs use a plain ticker.
+
+Examples:
+  tradier markets payoff --leg "+1 AAPL261120C00250000" --leg "-1 AAPL261120C00260000"
+  tradier markets payoff --leg "100 AAPL@231.50" --leg "-1 AAPL261120C00250000@3.20" --date 2026-11-06
+  tradier markets payoff --leg "-1 SPY261120P00550000" --leg "+1 SPY261120P00540000" --svg spread.svg --csv spread.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		legFlags, _ := cmd.Flags().GetStringArray("leg")
+		if len(legFlags) == 0 {
+			return fmt.Errorf("at least one --leg is required")
+		}
+		var legs []payoff.Leg
+		for _, s := range legFlags {
+			leg, err := payoff.ParseLeg(s)
+			if err != nil {
+				return err
+			}
+			legs = append(legs, leg)
+		}
+
+		opts := payoffOptions{}
+		opts.Low, _ = cmd.Flags().GetFloat64("low")
+		opts.High, _ = cmd.Flags().GetFloat64("high")
+		opts.Rate, _ = cmd.Flags().GetFloat64("rate")
+		opts.Dividend, _ = cmd.Flags().GetFloat64("dividend-yield")
+		if date, _ := cmd.Flags().GetString("date"); date != "" {
+			d, ok := parseDay(date)
+			if !ok {
+				return fmt.Errorf("invalid --date %q (use YYYY-MM-DD)", date)
+			}
+			opts.Date = d
+		}
+		csvPath, _ := cmd.Flags().GetString("csv")
+		svgPath, _ := cmd.Flags().GetString("svg")
+
+		report, err := buildPayoff(c, legs, opts)
+		if err != nil {
+			return err
+		}
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayPayoff)
+
+		if csvPath != "" {
+			if err := writePayoffCSV(csvPath, report); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", csvPath)
+		}
+		if svgPath != "" {
+			// The SVG draws its own zero line, so the break-even series is left out
+			if err := os.WriteFile(svgPath, []byte(chart.SVG(chart.Options{XLabels: payoffXLabels(report.Prices)}, payoffSeries(report)[1:]...)), 0o644); err != nil {
+				return fmt.Errorf("unable to write %s: %w", svgPath, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", svgPath)
+		}
+		return nil
+	},
+}
+
+// buildPayoff prices the legs from current quotes and analyzes the trade. Legs without an entry
+// price use the quote's mid, and options without a Tradier IV have one solved from their mid.
+func buildPayoff(c *client.Client, legs []payoff.Leg, opts payoffOptions) (payoffReport, error) {
+	underlying := legs[0].Underlying
+	symbols := []string{underlying}
+	for _, l := range legs {
+		if l.Underlying != underlying {
+			return payoffReport{}, fmt.Errorf("all legs must share one underlying (found %s and %s)", underlying, l.Underlying)
+		}
+		symbols = append(symbols, l.Symbol)
+	}
+
+	quotes, err := fetchQuoteMap(c, symbols, true)
+	if err != nil {
+		return payoffReport{}, err
+	}
+	spot := quoteMark(quotes[underlying])
+	if spot <= 0 {
+		return payoffReport{}, fmt.Errorf("no price available for %s", underlying)
+	}
+
+	now := time.Now()
+	var volSum float64
+	var volCount int
+	for i, l := range legs {
+		q := quotes[l.Symbol]
+		if l.Entry == 0 {
+			legs[i].Entry = quoteMark(q)
+		}
+		if !l.Option {
+			continue
+		}
+		legs[i].IV = num(nested(q, "greeks"), "mid_iv")
+		if legs[i].IV <= 0 {
+			p := pricing.Params{
+				Spot:     spot,
+				Strike:   l.Strike,
+				Years:    pricing.YearsToExpiry(l.Expiration, now),
+				Rate:     opts.Rate,
+				Dividend: opts.Dividend,
+				Call:     l.Call,
+			}
+			legs[i].IV, _ = pricing.ImpliedVol(p, quoteMark(q))
+		}
+		if legs[i].IV > 0 {
+			volSum += legs[i].IV
+			volCount++
+		}
+	}
+	vol := 0.0
+	if volCount > 0 {
+		vol = volSum / float64(volCount)
+	}
+	if opts.Net != nil {
+		payoff.PriceAtNet(legs, *opts.Net)
+	}
+
+	low, high := payoff.DefaultRange(legs, spot, vol, now)
+	if opts.Low > 0 {
+		low = opts.Low
+	}
+	if opts.High > 0 {
+		high = opts.High
+	}
+	if high <= low {
+		return payoffReport{}, fmt.Errorf("price range high (%.2f) must be above low (%.2f)", high, low)
+	}
+
+	analysis := payoff.Analyze(legs, payoff.Config{
+		Low:      low,
+		High:     high,
+		Date:     opts.Date,
+		Now:      now,
+		Spot:     spot,
+		Vol:      vol,
+		Rate:     opts.Rate,
+		Dividend: opts.Dividend,
+	})
+	return payoffReport{Underlying: underlying, Spot: spot, Legs: legs, Analysis: analysis}, nil
+}
+
+// showPreviewPayoff renders the payoff of the option legs in an order preview, opened at the
+// order's limit price when it has one. Orders that close positions are skipped, since their
+// legs aren't new positions. Failures are reported as warnings since the preview itself has
+// already succeeded.
+func showPreviewPayoff(c *client.Client, params map[string]string) {
+	var legs []payoff.Leg
+	closing := false
+	addLeg := func(symbol, side, quantity string) {
+		qty, err := strconv.ParseFloat(quantity, 64)
+		if symbol == "" || err != nil || qty == 0 {
+			return
+		}
+		if strings.HasSuffix(side, "_to_close") {
+			closing = true
+			return
+		}
+		if strings.HasPrefix(side, "sell") {
+			qty = -qty
+		}
+		if leg, err := payoff.NewLeg(symbol, qty); err == nil {
+			legs = append(legs, leg)
+		}
+	}
+
+	addLeg(params["option_symbol"], params["side"], params["quantity"])
+	if params["class"] == "combo" {
+		// A combo's equity leg, such as the stock of a covered call. Selling stock or buying to
+		// cover closes a position, like an option's *_to_close sides.
+		if side := params["side"]; side == "sell" || side == "buy_to_cover" {
+			closing = true
+		} else {
+			addLeg(params["symbol"], side, params["quantity"])
+		}
+	}
+	for i := 0; i <= 3; i++ {
+		addLeg(params[fmt.Sprintf("option_symbol[%d]", i)], params[fmt.Sprintf("side[%d]", i)], params[fmt.Sprintf("quantity[%d]", i)])
+	}
+	hasOption := false
+	for _, l := range legs {
+		hasOption = hasOption || occ.IsOption(l.Symbol)
+	}
+	if closing {
+		fmt.Fprintln(os.Stderr, "\nPayoff not shown: the order closes positions, and only opening trades are modelled.")
+		return
+	}
+	if !hasOption {
+		return
+	}
+
+	opts := payoffOptions{Rate: defaultRiskFreeRate}
+	if net, ok := orderNetPrice(params); ok {
+		opts.Net = &net
+	}
+	report, err := buildPayoff(c, legs, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: unable to compute payoff: %v\n", err)
+		return
+	}
+	out, err := json.Marshal(report)
+	if err != nil {
+		return
+	}
+	fmt.Println()
+	fmt.Println("Payoff")
+	displayPayoff(out)
+}
+
+// orderNetPrice returns an option order's limit as a net price per unit, positive for a debit
+// and negative for a credit, or false for market orders and order types it doesn't apply to.
+func orderNetPrice(params map[string]string) (float64, bool) {
+	price, err := strconv.ParseFloat(params["price"], 64)
+	switch {
+	case params["class"] == "multileg" && params["type"] == "even":
+		return 0, true
+	case params["class"] == "multileg" && params["type"] == "debit" && err == nil:
+		return price, true
+	case params["class"] == "multileg" && params["type"] == "credit" && err == nil:
+		return -price, true
+	case params["class"] == "option" && (params["type"] == "limit" || params["type"] == "stop_limit") && err == nil:
+		if strings.HasPrefix(params["side"], "sell") {
+			return -price, true
+		}
+		return price, true
+	}
+	return 0, false
+}
+
+// payoffSeries returns the chart series for a payoff: a zero line, the expiration P&L, and the dated P&L.
+func payoffSeries(r payoffReport) []chart.Series {
+	zero := make([]float64, len(r.Prices))
+	series := []chart.Series{
+		{Name: "Break-even", Values: zero, Glyph: '·'},
+		{Name: "At expiration", Values: r.AtExpiration, Glyph: '*'},
+	}
+	if len(r.AtDate) > 0 {
+		series = append(series, chart.Series{Name: "On " + r.Date, Values: r.AtDate, Glyph: '+'})
+	}
+	return series
+}
+
+// payoffXLabels returns five evenly spaced price labels for the chart's x-axis.
+func payoffXLabels(prices []float64) []string {
+	if len(prices) == 0 {
+		return nil
+	}
+	labels := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		labels = append(labels, fmt.Sprintf("%.2f", prices[i*(len(prices)-1)/4]))
+	}
+	return labels
+}
+
+// writePayoffCSV writes the P&L at each price to a CSV file.
+func writePayoffCSV(path string, r payoffReport) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to write %s: %w", path, err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	header := []string{"price", "pnl_at_expiration"}
+	if len(r.AtDate) > 0 {
+		header = append(header, "pnl_on_"+r.Date)
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for i, price := range r.Prices {
+		row := []string{strconv.FormatFloat(price, 'f', 2, 64), strconv.FormatFloat(r.AtExpiration[i], 'f', 2, 64)}
+		if len(r.AtDate) > 0 {
+			row = append(row, strconv.FormatFloat(r.AtDate[i], 'f', 2, 64))
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// displayPayoff renders the legs, risk summary, and an ASCII P&L chart.
+func displayPayoff(data []byte) {
+	var r payoffReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	rows := make([][]string, 0, len(r.Legs))
+	for _, l := range r.Legs {
+		name := l.Symbol
+		iv := ""
+		if l.Option {
+			name = formatOptionSymbol(l.Symbol)
+			iv = fmt.Sprintf("%.1f%%", l.IV*100)
+		}
+		rows = append(rows, []string{name, strconv.FormatFloat(l.Quantity, 'f', -1, 64), fmt.Sprintf("%.2f", l.Entry), iv})
+	}
+	printTable([]string{"LEG", "QTY", "ENTRY", "IV"}, rows)
+	fmt.Println()
+
+	cost := "Net Debit"
+	if r.NetCost < 0 {
+		cost = "Net Credit"
+	}
+	maxProfit, maxLoss := money(r.MaxProfit), money(r.MaxLoss)
+	if r.UnlimitedProfit {
+		maxProfit = "Unlimited"
+	}
+	if r.UnlimitedLoss {
+		maxLoss = "Unlimited"
+	}
+	breakevens := make([]string, 0, len(r.Breakevens))
+	for _, b := range r.Breakevens {
+		breakevens = append(breakevens, fmt.Sprintf("%.2f", b))
+	}
+	pop := "n/a"
+	if r.ProbProfit >= 0 {
+		pop = fmt.Sprintf("%.1f%%", r.ProbProfit*100)
+	}
+	printKV([][2]string{
+		{"Underlying", fmt.Sprintf("%s @ %.2f", r.Underlying, r.Spot)},
+		{"Expiration", r.Expiration},
+		{cost, money(abs(r.NetCost))},
+		{"Max Profit", maxProfit},
+		{"Max Loss", maxLoss},
+		{"Breakevens", strings.Join(breakevens, ", ")},
+		{"Prob. of Profit", pop},
+	})
+	fmt.Println()
+
+	fmt.Print(chart.Line(chart.Options{
+		Width:   chart.TerminalWidth(),
+		YFormat: money,
+		XLabels: payoffXLabels(r.Prices),
+	}, payoffSeries(r)...))
+}
+
+func init() {
+	payoffCmd.Flags().StringArray("leg", nil, `Trade leg as "QTY SYMBOL[@PRICE]" (repeatable, negative QTY for short)`)
+	payoffCmd.Flags().String("date", "", "Also value the trade on this date YYYY-MM-DD")
+	payoffCmd.Flags().Float64("low", 0, "Lowest underlying price to evaluate (default based on volatility)")
+	payoffCmd.Flags().Float64("high", 0, "Highest underlying price to evaluate (default based on volatility)")
+	addRateFlags(payoffCmd)
+	payoffCmd.Flags().String("csv", "", "Write the P&L curve to this CSV file")
+	payoffCmd.Flags().String("svg", "", "Write the P&L chart to this SVG file")
+
+	marketsCmd.AddCommand(payoffCmd)
+}
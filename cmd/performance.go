@@ -0,0 +1,365 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudmanic/tradier/analytics"
+	"github.com/cloudmanic/tradier/chart"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/spf13/cobra"
+)
+
+// historyPageSize is the number of events requested per page when walking account history.
+const historyPageSize = 500
+
+// performanceReport is the computed result of the performance command. Returns and
+// drawdowns are fractions (0.05 = 5%) so the JSON output is easy to consume downstream.
+type performanceReport struct {
+	AccountID          string             `json:"account_id"`
+	Period             string             `json:"period"`
+	Start              string             `json:"start"`
+	End                string             `json:"end"`
+	StartValue         float64            `json:"start_value"`
+	EndValue           float64            `json:"end_value"`
+	NetFlows           float64            `json:"net_flows"`
+	TimeWeightedReturn float64            `json:"time_weighted_return"`
+	AnnualizedReturn   float64            `json:"annualized_return"`
+	MaxDrawdown        float64            `json:"max_drawdown"`
+	DrawdownPeak       string             `json:"drawdown_peak"`
+	DrawdownTrough     string             `json:"drawdown_trough"`
+	Volatility         float64            `json:"volatility"`
+	Sharpe             float64            `json:"sharpe"`
+	RiskFreeRate       float64            `json:"risk_free_rate"`
+	Benchmark          *benchmarkStats    `json:"benchmark,omitempty"`
+	Series             []performancePoint `json:"series"`
+}
+
+// benchmarkStats holds the same statistics as the account, computed for the benchmark symbol.
+type benchmarkStats struct {
+	Symbol      string  `json:"symbol"`
+	Return      float64 `json:"return"`
+	MaxDrawdown float64 `json:"max_drawdown"`
+	Volatility  float64 `json:"volatility"`
+	Sharpe      float64 `json:"sharpe"`
+	Excess      float64 `json:"excess_return"`
+}
+
+// performancePoint is a single dated observation of account value and growth indexes (base 100).
+type performancePoint struct {
+	Date           string  `json:"date"`
+	Value          float64 `json:"value"`
+	AccountIndex   float64 `json:"account_index"`
+	BenchmarkIndex float64 `json:"benchmark_index,omitempty"`
+}
+
+// performanceCmd computes return, risk, and benchmark statistics from historical balances.
+var performanceCmd = &cobra.Command{
+	Use:   "performance",
+	Short: "Analyze account performance over a period",
+	Long: `Compute time-weighted return, max drawdown, volatility, and Sharpe ratio from historical balances.
+
+Deposits and withdrawals (ACH and wire events in account history) are removed from returns so
+that funding activity does not look like investment performance. Results are compared against
+a benchmark symbol using daily historical pricing.
+
+Examples:
+  tradier accounts performance --period YEAR
+  tradier accounts performance --period YTD --benchmark QQQ --risk-free 0.045`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		period, _ := cmd.Flags().GetString("period")
+		benchmark, _ := cmd.Flags().GetString("benchmark")
+		riskFree, _ := cmd.Flags().GetFloat64("risk-free")
+
+		data, err := c.GetHistoricalBalances(accountID, period)
+		if err != nil {
+			return err
+		}
+		points := parseBalancePoints(data)
+		if len(points) < 2 {
+			return fmt.Errorf("not enough historical balance data to compute performance")
+		}
+
+		start := points[0].Date.Format("2006-01-02")
+		end := points[len(points)-1].Date.Format("2006-01-02")
+		flows, err := fetchCashFlows(c, accountID, start, end)
+		if err != nil {
+			return err
+		}
+
+		report := buildPerformanceReport(points, flows, riskFree)
+		report.AccountID = accountID
+		report.Period = period
+
+		if benchmark != "" {
+			hist, err := c.GetHistoricalPricing(benchmark, "daily", start, end)
+			if err != nil {
+				return fmt.Errorf("failed to fetch benchmark %s: %w", benchmark, err)
+			}
+			addBenchmark(report, benchmark, points, parseClosePoints(hist), riskFree)
+		}
+
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayPerformance)
+		return nil
+	},
+}
+
+// buildPerformanceReport computes account statistics for a sorted balance series and its cash flows.
+func buildPerformanceReport(points []analytics.Point, flows []analytics.CashFlow, riskFree float64) *performanceReport {
+	returns := analytics.PeriodReturns(points, flows)
+	index := analytics.WealthIndex(returns)
+	perYear := analytics.PeriodsPerYear(points)
+	dd := analytics.MaxDrawdown(index)
+	days := points[len(points)-1].Date.Sub(points[0].Date).Hours() / 24
+
+	netFlows := 0.0
+	for _, f := range flows {
+		if f.Date.After(points[0].Date) && !f.Date.After(points[len(points)-1].Date) {
+			netFlows += f.Amount
+		}
+	}
+
+	twr := analytics.Compound(returns)
+	report := &performanceReport{
+		Start:              points[0].Date.Format("2006-01-02"),
+		End:                points[len(points)-1].Date.Format("2006-01-02"),
+		StartValue:         points[0].Value,
+		EndValue:           points[len(points)-1].Value,
+		NetFlows:           netFlows,
+		TimeWeightedReturn: twr,
+		AnnualizedReturn:   analytics.Annualize(twr, days),
+		MaxDrawdown:        dd.Depth,
+		DrawdownPeak:       points[dd.Peak].Date.Format("2006-01-02"),
+		DrawdownTrough:     points[dd.Trough].Date.Format("2006-01-02"),
+		Volatility:         analytics.Volatility(returns, perYear),
+		Sharpe:             analytics.Sharpe(returns, riskFree, perYear),
+		RiskFreeRate:       riskFree,
+	}
+
+	report.Series = make([]performancePoint, len(points))
+	for i, p := range points {
+		report.Series[i] = performancePoint{
+			Date:         p.Date.Format("2006-01-02"),
+			Value:        p.Value,
+			AccountIndex: index[i] * 100,
+		}
+	}
+	return report
+}
+
+// addBenchmark aligns benchmark closes to the account's balance dates and records its statistics.
+// Each balance date uses the most recent close on or before that date.
+func addBenchmark(report *performanceReport, symbol string, points, closes []analytics.Point, riskFree float64) {
+	if len(closes) == 0 {
+		return
+	}
+
+	aligned := make([]analytics.Point, 0, len(points))
+	j := 0
+	for _, p := range points {
+		for j+1 < len(closes) && !closes[j+1].Date.After(p.Date) {
+			j++
+		}
+		aligned = append(aligned, analytics.Point{Date: p.Date, Value: closes[j].Value})
+	}
+
+	returns := analytics.PeriodReturns(aligned, nil)
+	index := analytics.WealthIndex(returns)
+	perYear := analytics.PeriodsPerYear(points)
+	total := analytics.Compound(returns)
+
+	report.Benchmark = &benchmarkStats{
+		Symbol:      symbol,
+		Return:      total,
+		MaxDrawdown: analytics.MaxDrawdown(index).Depth,
+		Volatility:  analytics.Volatility(returns, perYear),
+		Sharpe:      analytics.Sharpe(returns, riskFree, perYear),
+		Excess:      report.TimeWeightedReturn - total,
+	}
+	for i := range report.Series {
+		report.Series[i].BenchmarkIndex = index[i] * 100
+	}
+}
+
+// parseBalancePoints extracts a date-sorted value series from a historical balances response.
+func parseBalancePoints(data []byte) []analytics.Point {
+	root := parseJSON(data)
+	if root == nil {
+		return nil
+	}
+
+	balances := toSlice(root["balances"])
+	if balances == nil {
+		if b := nested(root, "balances"); b != nil {
+			balances = toSlice(b["balance"])
+		}
+	}
+
+	points := make([]analytics.Point, 0, len(balances))
+	for _, b := range balances {
+		d, ok := parseDay(str(b, "date"))
+		if !ok {
+			continue
+		}
+		points = append(points, analytics.Point{Date: d, Value: num(b, "value")})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Date.Before(points[j].Date) })
+	return points
+}
+
+// parseClosePoints extracts a date-sorted series of closing prices from a market history response.
+func parseClosePoints(data []byte) []analytics.Point {
+	root := parseJSON(data)
+	h := nested(root, "history")
+	if h == nil {
+		return nil
+	}
+
+	days := toSlice(h["day"])
+	points := make([]analytics.Point, 0, len(days))
+	for _, d := range days {
+		t, ok := parseDay(str(d, "date"))
+		if !ok {
+			continue
+		}
+		points = append(points, analytics.Point{Date: t, Value: num(d, "close")})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Date.Before(points[j].Date) })
+	return points
+}
+
+// fetchCashFlows collects ACH and wire transfers between start and end as external cash flows.
+func fetchCashFlows(c *client.Client, accountID, start, end string) ([]analytics.CashFlow, error) {
+	var flows []analytics.CashFlow
+	for _, activityType := range []string{"ach", "wire"} {
+		events, err := fetchHistoryEvents(c, accountID, activityType, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			d, ok := parseDay(str(e, "date"))
+			if !ok {
+				continue
+			}
+			flows = append(flows, analytics.CashFlow{Date: d, Amount: num(e, "amount")})
+		}
+	}
+	return flows, nil
+}
+
+// fetchHistoryEvents pages through account history and returns every event of the given type
+// between start and end. An empty activity type returns all events.
+func fetchHistoryEvents(c *client.Client, accountID, activityType, start, end string) ([]map[string]interface{}, error) {
+	var events []map[string]interface{}
+	limit := strconv.Itoa(historyPageSize)
+	for page := 1; ; page++ {
+		data, err := c.GetHistory(accountID, strconv.Itoa(page), limit, activityType, start, end)
+		if err != nil {
+			return nil, err
+		}
+		h := nested(parseJSON(data), "history")
+		if h == nil {
+			break
+		}
+		batch := toSlice(h["event"])
+		events = append(events, batch...)
+		if len(batch) < historyPageSize {
+			break
+		}
+	}
+	return events, nil
+}
+
+// parseDay parses the date portion of an ISO 8601 date or datetime string.
+func parseDay(s string) (time.Time, bool) {
+	t, err := time.Parse("2006-01-02", shortDate(s))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// displayPerformance renders performance statistics and an ASCII chart of growth versus the benchmark.
+func displayPerformance(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Printf("Performance: %s (%s to %s)\n\n", str(root, "account_id"), str(root, "start"), str(root, "end"))
+
+	pairs := [][2]string{
+		{"Start Value", money(num(root, "start_value"))},
+		{"End Value", money(num(root, "end_value"))},
+		{"Net Deposits", money(num(root, "net_flows"))},
+		{"Time-Weighted Return", pct(num(root, "time_weighted_return") * 100)},
+		{"Annualized Return", pct(num(root, "annualized_return") * 100)},
+		{"Max Drawdown", fmt.Sprintf("%s (%s to %s)", pct(num(root, "max_drawdown")*100), str(root, "drawdown_peak"), str(root, "drawdown_trough"))},
+		{"Volatility (ann.)", fmt.Sprintf("%.2f%%", num(root, "volatility")*100)},
+		{"Sharpe Ratio", fmt.Sprintf("%.2f", num(root, "sharpe"))},
+	}
+	printKV(pairs)
+
+	bench := nested(root, "benchmark")
+	if bench != nil {
+		fmt.Println()
+		fmt.Printf("Benchmark: %s\n", str(bench, "symbol"))
+		printKV([][2]string{
+			{"Return", pct(num(bench, "return") * 100)},
+			{"Max Drawdown", pct(num(bench, "max_drawdown") * 100)},
+			{"Volatility (ann.)", fmt.Sprintf("%.2f%%", num(bench, "volatility")*100)},
+			{"Sharpe Ratio", fmt.Sprintf("%.2f", num(bench, "sharpe"))},
+			{"Excess Return", pct(num(bench, "excess_return") * 100)},
+		})
+	}
+
+	series := toSlice(root["series"])
+	if len(series) < 2 {
+		return
+	}
+	account := make([]float64, len(series))
+	benchmark := make([]float64, len(series))
+	for i, p := range series {
+		account[i] = num(p, "account_index")
+		benchmark[i] = num(p, "benchmark_index")
+	}
+
+	lines := []chart.Series{{Name: "Account", Values: account, Glyph: '*'}}
+	if bench != nil {
+		lines = append(lines, chart.Series{Name: str(bench, "symbol"), Values: benchmark, Glyph: '+'})
+	}
+
+	fmt.Println()
+	fmt.Println("Growth of 100:")
+	fmt.Print(chart.Line(chart.Options{
+		XLabels: []string{str(series[0], "date"), str(series[len(series)-1], "date")},
+	}, lines...))
+}
+
+func init() {
+	performanceCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	performanceCmd.Flags().String("period", "YEAR", "Period: WEEK, MONTH, YTD, YEAR, YEAR_3, YEAR_5, ALL")
+	performanceCmd.Flags().String("benchmark", "SPY", "Benchmark symbol to compare against (empty to skip)")
+	performanceCmd.Flags().Float64("risk-free", 0, "Annual risk-free rate for the Sharpe ratio (e.g. 0.04)")
+
+	accountsCmd.AddCommand(performanceCmd)
+}
@@ -0,0 +1,312 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cloudmanic/tradier/spreads"
+	"github.com/spf13/cobra"
+)
+
+// groupPnL is the profit and loss of one position group, computed from current quotes.
+type groupPnL struct {
+	ID          string   `json:"id,omitempty"`
+	Label       string   `json:"label"`
+	Symbols     []string `json:"symbols"`
+	MarketValue float64  `json:"market_value"`
+	CostBasis   float64  `json:"cost_basis"`
+	PnL         float64  `json:"pnl"`
+	PnLPercent  float64  `json:"pnl_percent"`
+	DayChange   float64  `json:"day_change"`
+}
+
+// detectPositionGroupsCmd finds option strategies in current positions and offers to group them.
+var detectPositionGroupsCmd = &cobra.Command{
+	Use:   "detect",
+	Short: "Detect option strategies in positions and create groups for them",
+	Long: `Scan current positions for verticals, iron condors, covered calls, collars, straddles,
+and strangles, and generate a descriptive label for each. With --create, prompts to create
+a position group for every detected strategy that does not already have one.
+
+Examples:
+  tradier accounts position-groups detect
+  tradier accounts position-groups detect --create
+  tradier accounts position-groups detect --create --yes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		create, _ := cmd.Flags().GetBool("create")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		data, err := c.GetPositions(accountID)
+		if err != nil {
+			return err
+		}
+		var positions []spreads.Position
+		for _, pos := range toSlice(nested(parseJSON(data), "positions")["position"]) {
+			positions = append(positions, spreads.Position{Symbol: str(pos, "symbol"), Quantity: num(pos, "quantity")})
+		}
+		detected := spreads.Detect(positions)
+
+		out, err := json.Marshal(map[string]interface{}{"groups": detected})
+		if err != nil {
+			return err
+		}
+		printResult(out, displayDetectedGroups)
+
+		if !create || len(detected) == 0 {
+			return nil
+		}
+
+		existing, err := c.GetPositionGroups(accountID)
+		if err != nil {
+			return err
+		}
+		labels := map[string]bool{}
+		for _, g := range positionGroupList(existing) {
+			labels[str(g, "label")] = true
+		}
+
+		// Keep stdout to the JSON document when --json is set
+		status := os.Stdout
+		if jsonOutput {
+			status = os.Stderr
+		}
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Fprintln(status)
+		for _, g := range detected {
+			if labels[g.Label] {
+				fmt.Fprintf(status, "Skipping %q: a position group with this label already exists.\n", g.Label)
+				continue
+			}
+			if !yes {
+				fmt.Fprintf(status, "Create position group %q? [y/N]: ", g.Label)
+				answer, err := readLine(reader)
+				if err != nil {
+					return err
+				}
+				if !strings.EqualFold(answer, "y") && !strings.EqualFold(answer, "yes") {
+					continue
+				}
+			}
+			if _, err := c.CreatePositionGroup(accountID, g.Label, strings.Join(g.Symbols, ",")); err != nil {
+				return fmt.Errorf("failed to create position group %q: %w", g.Label, err)
+			}
+			fmt.Fprintf(status, "Created position group %q.\n", g.Label)
+		}
+		return nil
+	},
+}
+
+// positionGroupsPnLCmd shows profit and loss per position group using live quotes.
+var positionGroupsPnLCmd = &cobra.Command{
+	Use:   "pnl",
+	Short: "Show profit and loss for each position group",
+	Long: `Join position groups with current positions and quotes to show market value, cost basis,
+unrealized P&L, and today's change for each group. Positions that do not belong to any
+group are summarized on an "Ungrouped" row.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+
+		groupsData, err := c.GetPositionGroups(accountID)
+		if err != nil {
+			return err
+		}
+		positionsData, err := c.GetPositions(accountID)
+		if err != nil {
+			return err
+		}
+
+		held := map[string]map[string]interface{}{}
+		var symbols []string
+		for _, pos := range toSlice(nested(parseJSON(positionsData), "positions")["position"]) {
+			held[str(pos, "symbol")] = pos
+			symbols = append(symbols, str(pos, "symbol"))
+		}
+		quotes, err := fetchQuoteMap(c, symbols, false)
+		if err != nil {
+			return err
+		}
+
+		grouped := map[string]bool{}
+		var results []groupPnL
+		for _, g := range positionGroupList(groupsData) {
+			syms := positionGroupSymbols(g)
+			results = append(results, computeGroupPnL(str(g, "id"), str(g, "label"), syms, held, quotes))
+			for _, s := range syms {
+				grouped[s] = true
+			}
+		}
+
+		var ungrouped []string
+		for _, s := range symbols {
+			if !grouped[s] {
+				ungrouped = append(ungrouped, s)
+			}
+		}
+		sort.Strings(ungrouped)
+		if len(ungrouped) > 0 {
+			results = append(results, computeGroupPnL("", "Ungrouped", ungrouped, held, quotes))
+		}
+
+		out, err := json.Marshal(map[string]interface{}{"groups": results})
+		if err != nil {
+			return err
+		}
+		printResult(out, displayGroupPnL)
+		return nil
+	},
+}
+
+// computeGroupPnL sums market value, cost basis, and day change for the held symbols in a group.
+func computeGroupPnL(id, label string, symbols []string, held, quotes map[string]map[string]interface{}) groupPnL {
+	g := groupPnL{ID: id, Label: label, Symbols: symbols}
+	for _, sym := range symbols {
+		pos, ok := held[sym]
+		if !ok {
+			continue
+		}
+		q := quotes[sym]
+		qty := num(pos, "quantity")
+		mult := contractMultiplier(sym, q)
+		g.MarketValue += quoteMark(q) * qty * mult
+		g.DayChange += num(q, "change") * qty * mult
+		g.CostBasis += num(pos, "cost_basis")
+	}
+	g.PnL = g.MarketValue - g.CostBasis
+	if g.CostBasis != 0 {
+		g.PnLPercent = g.PnL / abs(g.CostBasis) * 100
+	}
+	return g
+}
+
+// positionGroupList extracts position groups from either of the response shapes Tradier uses.
+func positionGroupList(data []byte) []map[string]interface{} {
+	root := parseJSON(data)
+	groups := toSlice(root["position_groups"])
+	if groups == nil {
+		if pg := nested(root, "positiongroups"); pg != nil {
+			groups = toSlice(pg["positiongroup"])
+		}
+	}
+	return groups
+}
+
+// positionGroupSymbols returns a group's symbols whether they are listed as an array or a comma-separated string.
+func positionGroupSymbols(g map[string]interface{}) []string {
+	if syms := toStringSlice(g["symbols"]); syms != nil {
+		return syms
+	}
+	var syms []string
+	for _, s := range strings.Split(str(g, "symbols"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			syms = append(syms, s)
+		}
+	}
+	return syms
+}
+
+// abs returns the absolute value of f.
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// displayDetectedGroups renders detected strategies with their generated labels.
+func displayDetectedGroups(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	groups := toSlice(root["groups"])
+	if len(groups) == 0 {
+		fmt.Println("No option strategies detected in current positions.")
+		return
+	}
+
+	headers := []string{"STRATEGY", "UNDERLYING", "EXPIRATION", "QTY", "LABEL", "SYMBOLS"}
+	rows := make([][]string, 0, len(groups))
+	for _, g := range groups {
+		rows = append(rows, []string{
+			strings.ReplaceAll(str(g, "strategy"), "_", " "),
+			str(g, "underlying"),
+			str(g, "expiration"),
+			str(g, "quantity"),
+			str(g, "label"),
+			strings.Join(toStringSlice(g["symbols"]), ", "),
+		})
+	}
+	printTable(headers, rows)
+}
+
+// displayGroupPnL renders profit and loss per position group with a total row.
+func displayGroupPnL(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	groups := toSlice(root["groups"])
+	headers := []string{"ID", "LABEL", "MARKET VALUE", "COST BASIS", "P/L", "P/L%", "DAY CHANGE"}
+	rows := make([][]string, 0, len(groups)+1)
+	var mv, cost, day float64
+	for _, g := range groups {
+		mv += num(g, "market_value")
+		cost += num(g, "cost_basis")
+		day += num(g, "day_change")
+		rows = append(rows, []string{
+			str(g, "id"),
+			str(g, "label"),
+			money(num(g, "market_value")),
+			money(num(g, "cost_basis")),
+			money(num(g, "pnl")),
+			pct(num(g, "pnl_percent")),
+			money(num(g, "day_change")),
+		})
+	}
+	if len(rows) == 0 {
+		fmt.Println("No position groups found.")
+		return
+	}
+	totalPct := 0.0
+	if cost != 0 {
+		totalPct = (mv - cost) / abs(cost) * 100
+	}
+	rows = append(rows, []string{"", "TOTAL", money(mv), money(cost), money(mv - cost), pct(totalPct), money(day)})
+	printTable(headers, rows)
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{detectPositionGroupsCmd, positionGroupsPnLCmd} {
+		cmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	}
+	detectPositionGroupsCmd.Flags().Bool("create", false, "Offer to create a position group for each detected strategy")
+	detectPositionGroupsCmd.Flags().Bool("yes", false, "Create groups without prompting (with --create)")
+
+	positionGroupsCmd.AddCommand(detectPositionGroupsCmd, positionGroupsPnLCmd)
+}
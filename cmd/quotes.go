@@ -0,0 +1,90 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/occ"
+)
+
+// quoteBatchSize is the number of symbols sent per PostQuotes request.
+const quoteBatchSize = 200
+
+// fetchQuoteMap retrieves quotes for any number of symbols in batches via PostQuotes
+// and returns them keyed by symbol. Duplicate and empty symbols are ignored.
+func fetchQuoteMap(c *client.Client, symbols []string, greeks bool) (map[string]map[string]interface{}, error) {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(symbols))
+	for _, s := range symbols {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		unique = append(unique, s)
+	}
+
+	withGreeks := ""
+	if greeks {
+		withGreeks = "true"
+	}
+
+	quotes := map[string]map[string]interface{}{}
+	for start := 0; start < len(unique); start += quoteBatchSize {
+		end := start + quoteBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+		data, err := c.PostQuotes(strings.Join(unique[start:end], ","), withGreeks)
+		if err != nil {
+			return nil, err
+		}
+		for _, q := range toSlice(nested(parseJSON(data), "quotes")["quote"]) {
+			quotes[str(q, "symbol")] = q
+		}
+	}
+	return quotes, nil
+}
+
+// underlyingPrice returns the current mark price for a single symbol.
+func underlyingPrice(c *client.Client, symbol string) (float64, error) {
+	data, err := c.GetQuotes(symbol, "")
+	if err != nil {
+		return 0, err
+	}
+	quotes := toSlice(nested(parseJSON(data), "quotes")["quote"])
+	if len(quotes) == 0 {
+		return 0, fmt.Errorf("no quote found for %s", symbol)
+	}
+	price := quoteMark(quotes[0])
+	if price <= 0 {
+		return 0, fmt.Errorf("no price available for %s", symbol)
+	}
+	return price, nil
+}
+
+// quoteMark returns the best estimate of a quote's current value: the bid/ask midpoint
+// when both sides are present, otherwise the last trade price.
+func quoteMark(q map[string]interface{}) float64 {
+	bid, ask := num(q, "bid"), num(q, "ask")
+	if bid > 0 && ask > 0 {
+		return (bid + ask) / 2
+	}
+	return num(q, "last")
+}
+
+// contractMultiplier returns the number of shares one unit of the symbol represents:
+// the quote's contract size for options (100 by default) and 1 for everything else.
+func contractMultiplier(symbol string, q map[string]interface{}) float64 {
+	if !occ.IsOption(symbol) {
+		return 1
+	}
+	if size := num(q, "contract_size"); size > 0 {
+		return size
+	}
+	return 100
+}
@@ -0,0 +1,328 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/rebalance"
+	"github.com/cloudmanic/tradier/tags"
+	"github.com/spf13/cobra"
+)
+
+// rebalanceOrder is the outcome of one submitted rebalancing order.
+type rebalanceOrder struct {
+	Symbol   string         `json:"symbol"`
+	Side     rebalance.Side `json:"side"`
+	Quantity float64        `json:"quantity"`
+	OrderID  string         `json:"order_id,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// rebalanceReport is a rebalancing plan and, once submitted, its orders.
+type rebalanceReport struct {
+	rebalance.Plan
+	AccountID string           `json:"account_id"`
+	Tag       string           `json:"tag"`
+	Orders    []rebalanceOrder `json:"orders,omitempty"`
+}
+
+// rebalanceCmd trades an account toward the target weights of a model portfolio.
+var rebalanceCmd = &cobra.Command{
+	Use:   "rebalance",
+	Short: "Trade an account toward model portfolio target weights",
+	Long: `Compare the account's stock positions with the target weights in a YAML (or JSON) file, show
+the whole-share trades that bring each holding within the drift tolerance, and, on confirmation,
+submit them as market day orders sharing one tag.
+
+  targets:
+    VTI: 55
+    VXUS: 25
+    BND: 18
+  drift: 3           # percentage points a holding may stray before it is traded
+  cash_buffer: 2     # percent of portfolio value kept in cash
+  min_trade: 100     # smallest trade worth placing, in dollars
+  sell_unlisted: false
+
+Weights are percentages of the portfolio value: the account's cash plus the positions being
+rebalanced, valued at the quote midpoint. Stock positions without a target are left alone and
+not counted unless sell_unlisted (or --sell-unlisted) is set; option and short positions are
+never traded. Sells are submitted before buys, and buys are limited to the cash they free up
+above the buffer. The --drift, --cash-buffer, and --min-trade flags override the file.
+
+With --json the plan is printed without prompting; add --yes to submit it.
+
+Examples:
+  tradier accounts rebalance --targets targets.yaml
+  tradier accounts rebalance --targets targets.yaml --drift 5 --min-trade 250
+  tradier accounts rebalance --targets targets.yaml --yes --tag rebalance-q4`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("targets")
+		if path == "" {
+			return fmt.Errorf("--targets is required")
+		}
+		targets, err := rebalance.LoadTargets(path)
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("drift") {
+			targets.Drift, _ = cmd.Flags().GetFloat64("drift")
+		}
+		if cmd.Flags().Changed("cash-buffer") {
+			targets.CashBuffer, _ = cmd.Flags().GetFloat64("cash-buffer")
+		}
+		if cmd.Flags().Changed("min-trade") {
+			targets.MinTrade, _ = cmd.Flags().GetFloat64("min-trade")
+		}
+		if sellUnlisted, _ := cmd.Flags().GetBool("sell-unlisted"); sellUnlisted {
+			targets.SellUnlisted = true
+		}
+		yes, _ := cmd.Flags().GetBool("yes")
+		tag, _ := cmd.Flags().GetString("tag")
+		if tag == "" {
+			tag = tags.New("rebalance", orderRunID())
+		}
+		if err := tags.Validate(tag); err != nil {
+			return err
+		}
+
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		plan, err := planRebalance(c, accountID, targets)
+		if err != nil {
+			return err
+		}
+		report := rebalanceReport{Plan: plan, AccountID: accountID, Tag: tag}
+		trades := plan.Trades()
+
+		if !jsonOutput {
+			if err := printRebalance(report); err != nil {
+				return err
+			}
+			if len(trades) == 0 {
+				fmt.Println("\nThe portfolio is within its targets; no trades needed.")
+				return nil
+			}
+			if !yes {
+				fmt.Printf("\nSubmit %d market orders to account %s? [y/N]: ", len(trades), accountID)
+				answer, err := readLine(bufio.NewReader(os.Stdin))
+				if err != nil {
+					return err
+				}
+				if !strings.EqualFold(answer, "y") && !strings.EqualFold(answer, "yes") {
+					fmt.Println("No orders submitted.")
+					return nil
+				}
+			}
+		}
+		if yes || !jsonOutput {
+			report.Orders = submitRebalance(c, accountID, tag, trades)
+		}
+		if jsonOutput {
+			if err := printRebalance(report); err != nil {
+				return err
+			}
+		} else {
+			fmt.Println()
+			displayRebalanceOrders(report.Orders)
+		}
+
+		failed := 0
+		for _, o := range report.Orders {
+			if o.Error != "" {
+				failed++
+			}
+		}
+		if failed > 0 {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%d of %d rebalancing orders were not placed", failed, len(trades))
+		}
+		return nil
+	},
+}
+
+// planRebalance prices the account's positions and the target symbols and computes the trades.
+func planRebalance(c *client.Client, accountID string, targets rebalance.Targets) (rebalance.Plan, error) {
+	posData, err := c.GetPositions(accountID)
+	if err != nil {
+		return rebalance.Plan{}, err
+	}
+	balData, err := c.GetBalances(accountID)
+	if err != nil {
+		return rebalance.Plan{}, err
+	}
+	cash := num(nested(parseJSON(balData), "balances"), "total_cash")
+
+	var holdings []rebalance.Holding
+	var symbols []string
+	held := map[string]bool{}
+	for _, p := range toSlice(nested(parseJSON(posData), "positions")["position"]) {
+		symbol := str(p, "symbol")
+		holdings = append(holdings, rebalance.Holding{Symbol: symbol, Quantity: num(p, "quantity")})
+		symbols = append(symbols, symbol)
+		held[symbol] = true
+	}
+	for symbol := range targets.Weights {
+		if !held[symbol] {
+			holdings = append(holdings, rebalance.Holding{Symbol: symbol})
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	quotes, err := fetchQuoteMap(c, symbols, false)
+	if err != nil {
+		return rebalance.Plan{}, err
+	}
+	for i, h := range holdings {
+		if q, ok := quotes[h.Symbol]; ok {
+			holdings[i].Price = quoteMark(q)
+		}
+	}
+	return rebalance.Compute(targets, holdings, cash)
+}
+
+// submitRebalance places the trades as market day orders, sells first. If any sell fails the
+// buys are not sent, since they may depend on its proceeds.
+func submitRebalance(c *client.Client, accountID, tag string, trades []rebalance.Line) []rebalanceOrder {
+	var out []rebalanceOrder
+	sellFailed := false
+	for _, t := range trades {
+		o := rebalanceOrder{Symbol: t.Symbol, Side: t.Side, Quantity: t.Shares}
+		if t.Side == rebalance.SideBuy && sellFailed {
+			o.Error = "not sent because a sell order failed"
+			out = append(out, o)
+			continue
+		}
+
+		data, err := c.PlaceOrder(accountID, map[string]string{
+			"class":    "equity",
+			"symbol":   t.Symbol,
+			"side":     string(t.Side),
+			"quantity": strconv.FormatFloat(t.Shares, 'f', -1, 64),
+			"type":     "market",
+			"duration": "day",
+			"tag":      tag,
+		})
+		order := nested(parseJSON(data), "order")
+		switch {
+		case err != nil:
+			o.Error = err.Error()
+		case str(order, "status") != "ok":
+			o.Error = fmt.Sprintf("order status %q: %s", str(order, "status"), data)
+		default:
+			o.OrderID = str(order, "id")
+		}
+		if o.Error != "" && t.Side == rebalance.SideSell {
+			sellFailed = true
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// printRebalance outputs the plan and any submitted orders as tables or, with --json, as one object.
+func printRebalance(report rebalanceReport) error {
+	out, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	printResult(out, displayRebalance)
+	return nil
+}
+
+// displayRebalance renders the account summary, a table of holdings with their trades, any
+// skipped positions, and any submitted orders.
+func displayRebalance(data []byte) {
+	var r rebalanceReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	printKV([][2]string{
+		{"Account", r.AccountID},
+		{"Portfolio Value", money(r.Value)},
+		{"Cash", money(r.Cash)},
+		{"Cash Buffer", money(r.CashBuffer)},
+		{"Cash After", money(r.CashAfter)},
+	})
+	fmt.Println()
+
+	weight := func(f float64) string { return fmt.Sprintf("%.2f%%", f) }
+	var rows [][]string
+	for _, l := range r.Lines {
+		trade := "-"
+		if l.Shares > 0 {
+			trade = fmt.Sprintf("%s %s", l.Side, strconv.FormatFloat(l.Shares, 'f', -1, 64))
+		}
+		amount := "-"
+		if l.Amount > 0 {
+			amount = money(l.Amount)
+		}
+		rows = append(rows, []string{
+			l.Symbol,
+			strconv.FormatFloat(l.Quantity, 'f', -1, 64),
+			money(l.Price),
+			money(l.Value),
+			weight(l.Weight),
+			weight(l.Target),
+			pct(l.Drift),
+			trade,
+			amount,
+			weight(l.NewWeight),
+			l.Note,
+		})
+	}
+	printTable([]string{"Symbol", "Shares", "Price", "Value", "Weight", "Target", "Drift", "Trade", "Amount", "New Weight", "Note"}, rows)
+
+	if len(r.Skipped) > 0 {
+		var skipped []string
+		for _, s := range r.Skipped {
+			skipped = append(skipped, fmt.Sprintf("%s (%s)", s.Symbol, s.Reason))
+		}
+		fmt.Printf("\nNot rebalanced: %s\n", strings.Join(skipped, ", "))
+	}
+
+	if len(r.Orders) > 0 {
+		fmt.Println()
+		displayRebalanceOrders(r.Orders)
+	}
+}
+
+// displayRebalanceOrders renders submitted rebalancing orders as a table.
+func displayRebalanceOrders(orders []rebalanceOrder) {
+	var rows [][]string
+	for _, o := range orders {
+		result := o.OrderID
+		if o.Error != "" {
+			result = o.Error
+		}
+		rows = append(rows, []string{o.Symbol, string(o.Side), strconv.FormatFloat(o.Quantity, 'f', -1, 64), result})
+	}
+	printTable([]string{"Symbol", "Side", "Quantity", "Order ID / Error"}, rows)
+}
+
+func init() {
+	rebalanceCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	rebalanceCmd.Flags().String("targets", "", "YAML or JSON file of target weights (required)")
+	rebalanceCmd.Flags().Float64("drift", 0, "Percentage points a holding may stray before it is traded (overrides the file)")
+	rebalanceCmd.Flags().Float64("cash-buffer", 0, "Percent of portfolio value to keep in cash (overrides the file)")
+	rebalanceCmd.Flags().Float64("min-trade", 0, "Smallest trade to place, in dollars (overrides the file)")
+	rebalanceCmd.Flags().Bool("sell-unlisted", false, "Sell stock positions that have no target")
+	rebalanceCmd.Flags().String("tag", "", "Tag for the submitted orders (default rebalance-<run ID>)")
+	rebalanceCmd.Flags().Bool("yes", false, "Submit the orders without prompting")
+	accountsCmd.AddCommand(rebalanceCmd)
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cloudmanic/tradier/reconcile"
+	"github.com/spf13/cobra"
+)
+
+// reconcileCmd compares a local trade ledger with the broker's positions and cash.
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Compare a local ledger against broker positions and cash",
+	Long: `Compare expected positions and cash from a local CSV ledger against the account's
+positions and balances. Reports missing, extra, and mismatched-quantity positions and
+cost basis differences. Exits with a non-zero status when anything differs, so it can
+run unattended from cron.
+
+The ledger is a CSV file with a header row. Required columns are symbol and quantity;
+cost_basis is optional. Rows for the same symbol are summed, and a row with symbol CASH
+holds the expected cash balance in its quantity column:
+
+  symbol,quantity,cost_basis
+  AAPL,100,15234.50
+  AAPL220617C00270000,-2,-640.00
+  CASH,2500.00
+
+Examples:
+  tradier accounts reconcile --ledger ledger.csv
+  tradier accounts reconcile --ledger ledger.csv --cost-tolerance 1.00 --cash-tolerance 5.00`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ledgerPath, _ := cmd.Flags().GetString("ledger")
+		if ledgerPath == "" {
+			return fmt.Errorf("--ledger is required")
+		}
+		costTol, _ := cmd.Flags().GetFloat64("cost-tolerance")
+		cashTol, _ := cmd.Flags().GetFloat64("cash-tolerance")
+
+		f, err := os.Open(ledgerPath)
+		if err != nil {
+			return fmt.Errorf("unable to open ledger: %w", err)
+		}
+		defer f.Close()
+		ledger, err := reconcile.ParseCSV(f)
+		if err != nil {
+			return err
+		}
+
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		positions, err := c.GetPositions(accountID)
+		if err != nil {
+			return err
+		}
+		balances, err := c.GetBalances(accountID)
+		if err != nil {
+			return err
+		}
+
+		var broker []reconcile.Holding
+		for _, pos := range toSlice(nested(parseJSON(positions), "positions")["position"]) {
+			broker = append(broker, reconcile.Holding{
+				Symbol:    str(pos, "symbol"),
+				Quantity:  num(pos, "quantity"),
+				CostBasis: num(pos, "cost_basis"),
+			})
+		}
+		cash := num(nested(parseJSON(balances), "balances"), "total_cash")
+
+		result := reconcile.Compare(ledger, broker, cash, reconcile.Tolerance{CostBasis: costTol, Cash: cashTol})
+		out, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayReconcile)
+
+		if result.Mismatches > 0 {
+			// The report has already been printed; skip the usage dump and just exit non-zero
+			cmd.SilenceUsage = true
+			return fmt.Errorf("reconciliation found %d difference(s)", result.Mismatches)
+		}
+		return nil
+	},
+}
+
+// displayReconcile renders the reconciliation as a table of symbols plus a cash comparison.
+func displayReconcile(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	lines := toSlice(root["lines"])
+	headers := []string{"SYMBOL", "STATUS", "LEDGER QTY", "BROKER QTY", "LEDGER COST", "BROKER COST", "COST DIFF"}
+	rows := make([][]string, 0, len(lines))
+	for _, l := range lines {
+		ledgerCost := ""
+		costDiff := ""
+		if l["cost_checked"] == true {
+			ledgerCost = money(num(l, "expected_cost_basis"))
+			costDiff = money(num(l, "actual_cost_basis") - num(l, "expected_cost_basis"))
+		}
+		rows = append(rows, []string{
+			formatOptionSymbol(str(l, "symbol")),
+			str(l, "status"),
+			str(l, "expected_quantity"),
+			str(l, "actual_quantity"),
+			ledgerCost,
+			money(num(l, "actual_cost_basis")),
+			costDiff,
+		})
+	}
+	printTable(headers, rows)
+
+	if root["cash_checked"] == true {
+		status := "ok"
+		if root["cash_matches"] != true {
+			status = "mismatch"
+		}
+		fmt.Println()
+		printKV([][2]string{
+			{"Ledger Cash", money(num(root, "expected_cash"))},
+			{"Broker Cash", money(num(root, "actual_cash"))},
+			{"Difference", money(num(root, "actual_cash") - num(root, "expected_cash"))},
+			{"Cash Status", status},
+		})
+	}
+
+	fmt.Println()
+	if n := num(root, "mismatches"); n > 0 {
+		fmt.Printf("%d difference(s) found.\n", int(n))
+	} else {
+		fmt.Println("Ledger matches broker.")
+	}
+}
+
+func init() {
+	reconcileCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	reconcileCmd.Flags().String("ledger", "", "Path to the ledger CSV file (required)")
+	reconcileCmd.Flags().Float64("cost-tolerance", 0.01, "Allowed cost basis difference per symbol in dollars")
+	reconcileCmd.Flags().Float64("cash-tolerance", 0.01, "Allowed cash balance difference in dollars")
+
+	accountsCmd.AddCommand(reconcileCmd)
+}
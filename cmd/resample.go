@@ -0,0 +1,94 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/cache"
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/client"
+)
+
+// resampleTimeSales fetches time and sales data and aggregates it into bars of the given width,
+// aligned to the market calendar's sessions. With no interval, the coarsest native interval that
+// divides the width evenly is fetched. The result has the same shape as the timesales endpoint.
+func resampleTimeSales(c *client.Client, store *cache.Store, symbol, interval, start, end, sessionFilter, resample string) ([]byte, error) {
+	width, err := bars.ParseInterval(resample)
+	if err != nil {
+		return nil, err
+	}
+	if interval == "" {
+		interval = baseInterval(width)
+	}
+	if base, ok := nativeIntervals[interval]; !ok {
+		return nil, fmt.Errorf("invalid --interval %q (tick, 1min, 5min, 15min)", interval)
+	} else if base > 0 && width%base != 0 {
+		return nil, fmt.Errorf("--resample %s is not a multiple of the %s interval", resample, interval)
+	}
+
+	data, err := fetchTimeSales(c, store, symbol, interval, start, end, sessionFilter)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bars.ParseTimeSales(data)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := marketSessions(c, store, b)
+	if err != nil {
+		return nil, err
+	}
+	return timeSalesJSON(bars.Resample(b, width, sessions))
+}
+
+// nativeIntervals are the time and sales intervals Tradier supports and their widths. Ticks
+// have no fixed width.
+var nativeIntervals = map[string]time.Duration{
+	"tick":  0,
+	"1min":  time.Minute,
+	"5min":  5 * time.Minute,
+	"15min": 15 * time.Minute,
+}
+
+// baseInterval returns the coarsest native interval that evenly divides width.
+func baseInterval(width time.Duration) string {
+	for _, name := range []string{"15min", "5min", "1min"} {
+		if width%nativeIntervals[name] == 0 {
+			return name
+		}
+	}
+	return "tick"
+}
+
+// marketSessions returns the market calendar's sessions for every day spanned by the bars.
+func marketSessions(c *client.Client, store *cache.Store, b []bars.Bar) ([]calendar.Session, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return marketCalendar(c, store).Sessions(b[0].Time, b[len(b)-1].Time)
+}
+
+// timeSalesJSON encodes bars in the timesales endpoint's response shape so they render and
+// serialize exactly like native data.
+func timeSalesJSON(b []bars.Bar) ([]byte, error) {
+	data := make([]map[string]interface{}, 0, len(b))
+	for _, bar := range b {
+		data = append(data, map[string]interface{}{
+			"time":      bar.Time.Format("2006-01-02T15:04:05"),
+			"timestamp": bar.Time.Unix(),
+			"price":     bar.Close,
+			"open":      bar.Open,
+			"high":      bar.High,
+			"low":       bar.Low,
+			"close":     bar.Close,
+			"volume":    bar.Volume,
+			"vwap":      bar.VWAP,
+		})
+	}
+	return json.Marshal(map[string]interface{}{"series": map[string]interface{}{"data": data}})
+}
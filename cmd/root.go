@@ -9,15 +9,22 @@ import (
 
 	"github.com/cloudmanic/tradier/client"
 	"github.com/cloudmanic/tradier/config"
+	"github.com/cloudmanic/tradier/paper"
 	"github.com/spf13/cobra"
 )
 
 // jsonOutput controls whether output is rendered as JSON instead of tables.
 var jsonOutput bool
 
+// chartOutput controls whether commands that support --chart draw a chart instead of a table.
+var chartOutput bool
+
 // sandboxMode controls whether the CLI uses the sandbox environment.
 var sandboxMode bool
 
+// paperMode controls whether account and order commands use the local paper-trading account.
+var paperMode bool
+
 // version is the current version of the CLI, injected at build time
 // via -ldflags "-X github.com/cloudmanic/tradier/cmd.version=vX.Y.Z".
 // Defaults to "dev" for local development builds.
@@ -35,6 +42,7 @@ var rootCmd = &cobra.Command{
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output raw JSON instead of formatted tables")
 	rootCmd.PersistentFlags().BoolVar(&sandboxMode, "sandbox", false, "Use the Tradier sandbox environment")
+	rootCmd.PersistentFlags().BoolVar(&paperMode, "paper", false, "Trade a local paper account filled against production quotes")
 }
 
 // Execute runs the root command and exits on error.
@@ -45,12 +53,17 @@ func Execute() {
 }
 
 // loadClientFromConfig reads the config file and returns a configured API client.
-// Uses the --sandbox flag to determine which API key and base URL to use.
+// Uses the --sandbox flag to determine which API key and base URL to use. With --paper the
+// client reads market data from production and sends account and order requests to the
+// local paper account.
 func loadClientFromConfig() (*client.Client, *config.Config, error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to load config: %w\nRun 'tradier init' to configure your API key", err)
 	}
+	if paperMode {
+		return loadPaperClient(cfg)
+	}
 
 	apiKey := cfg.APIKey(sandboxMode)
 	if apiKey == "" {
@@ -79,10 +92,23 @@ func printResult(data []byte, tableFunc func([]byte)) {
 	tableFunc(data)
 }
 
+// printChartResult outputs data as JSON (with --json), a chart (with --chart), or a formatted table.
+func printChartResult(data []byte, tableFunc, chartFunc func([]byte)) {
+	if chartOutput && !jsonOutput {
+		chartFunc(data)
+		return
+	}
+	printResult(data, tableFunc)
+}
+
 // requireAccountID returns the account ID from the flag or config, erroring if neither is set.
-// Uses the --sandbox flag to determine which account ID to use from config.
+// Uses the --sandbox flag to determine which account ID to use from config, and defaults to the
+// paper account with --paper.
 func requireAccountID(cmd *cobra.Command, cfg *config.Config) (string, error) {
 	accountID, _ := cmd.Flags().GetString("account-id")
+	if accountID == "" && paperMode {
+		accountID = paper.AccountNumber
+	}
 	if accountID == "" {
 		accountID = cfg.AccountID(sandboxMode)
 	}
@@ -0,0 +1,397 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/cache"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/expr"
+	"github.com/cloudmanic/tradier/indicators"
+	"github.com/spf13/cobra"
+)
+
+// scanColumns are the quote fields shown for every match, ahead of the fields the filter uses.
+var scanColumns = []string{"last", "change_percentage", "volume"}
+
+// scanResult is the set of symbols that passed a scan filter, in sorted order.
+type scanResult struct {
+	Where   string                   `json:"where,omitempty"`
+	Sort    string                   `json:"sort,omitempty"`
+	Scanned int                      `json:"scanned"`
+	Columns []string                 `json:"columns"`
+	Matches []map[string]interface{} `json:"matches"`
+}
+
+// scanCmd filters a list of symbols by an expression over their quotes and indicators.
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Scan watchlists and symbol lists for quotes matching a filter",
+	Long: `Fetch quotes for every symbol in a watchlist or list and keep those matching a filter expression.
+
+The filter compares quote fields (last, change, change_percentage, volume, average_volume,
+bid, ask, open, high, low, prevclose, week_52_high, week_52_low, symbol, type, ...) using
+>, >=, <, <=, ==, !=, and/or/not, parentheses, arithmetic, and abs(), min(), max(), floor(), round().
+Numbers may use K, M, or B suffixes. Computed fields are also available:
+
+  mid               Bid/ask midpoint
+  spread_pct        Bid/ask spread as a percentage of the midpoint
+  dollar_volume     Last price times volume
+  relative_volume   Volume divided by average volume
+  range_pct         Day's high-low range as a percentage of the previous close
+
+Indicator columns such as sma_50, ema_20, rsi_14, atr_14, macd_hist, or bb_upper_20_2 are
+computed from daily history (served from the local bar cache) when the filter or sort uses them.
+
+Examples:
+  tradier markets scan --watchlist default --where "change_percentage > 3 and volume > 1M"
+  tradier markets scan --symbols AAPL,MSFT,NVDA,AMD --where "last > sma_50 and rsi_14 < 70" --sort rsi_14
+  tradier markets scan --watchlist tech --where "relative_volume > 2" --sort dollar_volume --limit 10
+  tradier markets scan --symbols SPY,QQQ,IWM --sort change_percentage --asc --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		watchlists, _ := cmd.Flags().GetStringSlice("watchlist")
+		symbolList, _ := cmd.Flags().GetString("symbols")
+		where, _ := cmd.Flags().GetString("where")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		asc, _ := cmd.Flags().GetBool("asc")
+		limit, _ := cmd.Flags().GetInt("limit")
+		if len(watchlists) == 0 && symbolList == "" {
+			return fmt.Errorf("--watchlist or --symbols is required")
+		}
+
+		var filter, order *expr.Expr
+		if where != "" {
+			if filter, err = expr.Parse(where); err != nil {
+				return fmt.Errorf("invalid --where: %w", err)
+			}
+		}
+		if order, err = expr.Parse(sortBy); err != nil {
+			return fmt.Errorf("invalid --sort: %w", err)
+		}
+
+		symbols := strings.Split(symbolList, ",")
+		for _, id := range watchlists {
+			wl, err := watchlistSymbols(c, id)
+			if err != nil {
+				return err
+			}
+			symbols = append(symbols, wl...)
+		}
+		quotes, err := fetchQuoteMap(c, symbols, false)
+		if err != nil {
+			return err
+		}
+		if len(quotes) == 0 {
+			return fmt.Errorf("no quotes found")
+		}
+
+		specs := scanIndicatorSpecs(filter, order)
+		values, err := latestIndicators(c, openCache(cmd), quotes, specs)
+		if err != nil {
+			return err
+		}
+
+		result, err := runScan(quotes, values, filter, order, asc, limit)
+		if err != nil {
+			return err
+		}
+		out, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayScan)
+		return nil
+	},
+}
+
+// watchlistSymbols returns the symbols in a watchlist.
+func watchlistSymbols(c *client.Client, id string) ([]string, error) {
+	data, err := c.GetWatchlist(id)
+	if err != nil {
+		return nil, err
+	}
+	out := parseWatchlistSymbols(data)
+	if len(out) == 0 {
+		return nil, fmt.Errorf("watchlist %s has no symbols", id)
+	}
+	return out, nil
+}
+
+// parseWatchlistSymbols reads the symbols from a watchlist response. Tradier returns a single
+// item as an object and several as an array.
+func parseWatchlistSymbols(data []byte) []string {
+	var out []string
+	for _, item := range toSlice(nested(parseJSON(data), "watchlist", "items")["item"]) {
+		if symbol := str(item, "symbol"); symbol != "" {
+			out = append(out, symbol)
+		}
+	}
+	return out
+}
+
+// scanIndicatorSpecs returns the indicators referenced by the filter and sort expressions.
+func scanIndicatorSpecs(exprs ...*expr.Expr) map[string]indicators.Spec {
+	specs := map[string]indicators.Spec{}
+	for _, e := range exprs {
+		if e == nil {
+			continue
+		}
+		for _, name := range e.Idents() {
+			if spec, ok := indicators.SpecForColumn(name); ok {
+				specs[name] = spec
+			}
+		}
+	}
+	return specs
+}
+
+// latestIndicators computes the most recent value of each indicator column for every quoted
+// symbol from daily history, fetched concurrently with enough lookback for the slowest indicator.
+func latestIndicators(c *client.Client, store *cache.Store, quotes map[string]map[string]interface{}, specs map[string]indicators.Spec) (map[string]map[string]float64, error) {
+	out := map[string]map[string]float64{}
+	if len(specs) == 0 {
+		return out, nil
+	}
+
+	lookback := 1
+	for _, spec := range specs {
+		lookback = max(lookback, indicators.Lookback(spec))
+	}
+	// Roughly 252 trading days a year, plus room for holidays
+	start := time.Now().AddDate(0, 0, -(lookback*365/252 + 10)).Format("2006-01-02")
+
+	symbols := make([]string, 0, len(quotes))
+	for symbol := range quotes {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	results, errs := fetchConcurrently(symbols, func(symbol string) ([]byte, error) {
+		return fetchHistory(c, store, symbol, "daily", start, "")
+	})
+
+	for i, symbol := range symbols {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("history for %s: %w", symbol, errs[i])
+		}
+		b, err := bars.ParseHistory(results[i])
+		if err != nil {
+			return nil, err
+		}
+		out[symbol] = map[string]float64{}
+		for name, spec := range specs {
+			out[symbol][name] = math.NaN()
+			for _, col := range indicators.Compute(spec, b) {
+				if col.Name == name && len(col.Values) > 0 {
+					out[symbol][name] = col.Values[len(col.Values)-1]
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// scanEnv resolves expression fields from indicator values, computed fields, and the quote.
+// Null or missing numeric quote fields resolve to NaN so comparisons against them fail.
+func scanEnv(q map[string]interface{}, ind map[string]float64) expr.Env {
+	computed := map[string]func() float64{
+		"mid": func() float64 { return quoteMark(q) },
+		"spread_pct": func() float64 {
+			bid, ask := num(q, "bid"), num(q, "ask")
+			if bid <= 0 || ask <= 0 {
+				return math.NaN()
+			}
+			return (ask - bid) / ((ask + bid) / 2) * 100
+		},
+		"dollar_volume": func() float64 { return num(q, "last") * num(q, "volume") },
+		"relative_volume": func() float64 {
+			if avg := num(q, "average_volume"); avg > 0 {
+				return num(q, "volume") / avg
+			}
+			return math.NaN()
+		},
+		"range_pct": func() float64 {
+			if prev := num(q, "prevclose"); prev > 0 {
+				return (num(q, "high") - num(q, "low")) / prev * 100
+			}
+			return math.NaN()
+		},
+	}
+
+	return func(name string) (expr.Value, bool) {
+		if v, ok := ind[name]; ok {
+			return expr.Number(v), true
+		}
+		if f, ok := computed[name]; ok {
+			return expr.Number(f()), true
+		}
+		v, ok := q[name]
+		if !ok {
+			return expr.Value{}, false
+		}
+		switch v := v.(type) {
+		case float64:
+			return expr.Number(v), true
+		case string:
+			return expr.String(v), true
+		}
+		return expr.Number(math.NaN()), true
+	}
+}
+
+// runScan filters the quotes, sorts the matches (missing sort values last), and keeps the
+// first limit rows. Each row carries the default columns plus every field the expressions use.
+func runScan(quotes map[string]map[string]interface{}, ind map[string]map[string]float64, filter, order *expr.Expr, asc bool, limit int) (scanResult, error) {
+	result := scanResult{Sort: order.String(), Scanned: len(quotes), Columns: append([]string(nil), scanColumns...)}
+	fields := order.Idents()
+	if filter != nil {
+		result.Where = filter.String()
+		fields = append(filter.Idents(), fields...)
+	}
+	for _, name := range fields {
+		if name != "symbol" && !containsString(result.Columns, name) {
+			result.Columns = append(result.Columns, name)
+		}
+	}
+
+	type match struct {
+		symbol string
+		key    float64
+		env    expr.Env
+	}
+	var matches []match
+	for symbol, q := range quotes {
+		env := scanEnv(q, ind[symbol])
+		if filter != nil {
+			ok, err := filter.Match(env)
+			if err != nil {
+				return scanResult{}, fmt.Errorf("%s: %w", symbol, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		key, err := order.Eval(env)
+		if err != nil {
+			return scanResult{}, fmt.Errorf("%s: %w", symbol, err)
+		}
+		k := key.Num
+		if key.IsStr {
+			k = math.NaN()
+		}
+		matches = append(matches, match{symbol, k, env})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case math.IsNaN(a.key) != math.IsNaN(b.key):
+			return math.IsNaN(b.key)
+		case a.key == b.key || math.IsNaN(a.key):
+			return a.symbol < b.symbol
+		case asc:
+			return a.key < b.key
+		}
+		return a.key > b.key
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	for _, m := range matches {
+		row := map[string]interface{}{"symbol": m.symbol, "description": str(quotes[m.symbol], "description")}
+		for _, name := range result.Columns {
+			v, _ := m.env(name)
+			switch {
+			case v.IsStr:
+				row[name] = v.Str
+			case math.IsNaN(v.Num) || math.IsInf(v.Num, 0):
+				row[name] = nil
+			default:
+				row[name] = v.Num
+			}
+		}
+		result.Matches = append(result.Matches, row)
+	}
+	return result, nil
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// displayScan renders the scan matches as a table.
+func displayScan(data []byte) {
+	var r scanResult
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	filter := r.Where
+	if filter == "" {
+		filter = "(none)"
+	}
+	fmt.Printf("Filter: %s  Sort: %s\n", filter, r.Sort)
+	fmt.Printf("Matched %d of %d symbols\n\n", len(r.Matches), r.Scanned)
+	if len(r.Matches) == 0 {
+		return
+	}
+
+	headers := []string{"SYMBOL"}
+	for _, name := range r.Columns {
+		headers = append(headers, strings.ToUpper(strings.ReplaceAll(name, "_", " ")))
+	}
+	rows := make([][]string, 0, len(r.Matches))
+	for _, m := range r.Matches {
+		row := []string{str(m, "symbol")}
+		for _, name := range r.Columns {
+			switch v := m[name].(type) {
+			case nil:
+				row = append(row, "-")
+			case string:
+				row = append(row, v)
+			case float64:
+				switch {
+				case name == "change_percentage":
+					row = append(row, pct(v))
+				case strings.Contains(name, "volume") && name != "relative_volume":
+					row = append(row, fmt.Sprintf("%.0f", v))
+				default:
+					row = append(row, fmt.Sprintf("%.2f", v))
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	printTable(headers, rows)
+}
+
+func init() {
+	scanCmd.Flags().StringSlice("watchlist", nil, "Watchlist ID to scan (repeatable or comma-separated)")
+	scanCmd.Flags().String("symbols", "", "Comma-separated symbols to scan")
+	scanCmd.Flags().String("where", "", "Filter expression, e.g. \"change_percentage > 3 and volume > 1M\"")
+	scanCmd.Flags().String("sort", "change_percentage", "Field or expression to sort matches by")
+	scanCmd.Flags().Bool("asc", false, "Sort ascending instead of descending")
+	scanCmd.Flags().Int("limit", 0, "Maximum number of matches to show (0 for all)")
+	addCacheFlags(scanCmd)
+
+	marketsCmd.AddCommand(scanCmd)
+}
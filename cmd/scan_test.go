@@ -0,0 +1,23 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseWatchlistSymbols verifies watchlists of one and several symbols are both read.
+func TestParseWatchlistSymbols(t *testing.T) {
+	tests := map[string][]string{
+		`{"watchlist":{"name":"Tech","id":"tech","items":{"item":[{"symbol":"AAPL","id":"aapl"},{"symbol":"MSFT","id":"msft"}]}}}`: {"AAPL", "MSFT"},
+		`{"watchlist":{"name":"Solo","id":"solo","items":{"item":{"symbol":"SPY","id":"spy"}}}}`:                                   {"SPY"},
+		`{"watchlist":{"name":"Empty","id":"empty","items":"null"}}`:                                                               nil,
+	}
+	for data, want := range tests {
+		if got := parseWatchlistSymbols([]byte(data)); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseWatchlistSymbols(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
@@ -0,0 +1,510 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/filelock"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/scheduler"
+	"github.com/spf13/cobra"
+)
+
+// schedulerIdleWait is the longest the scheduler daemon sleeps before re-reading the job file,
+// so orders scheduled while it runs are picked up promptly.
+const schedulerIdleWait = 15 * time.Second
+
+// scheduleOrderCmd stages an order from a file for submission at a later time.
+var scheduleOrderCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Schedule an order for submission at a later time",
+	Long: `Stage an order to be submitted by 'tradier scheduler run' at a set time.
+
+The order is read from a YAML (or JSON) file using the API's field names; multileg and
+OTO/OCO/OTOCO legs go in a legs list:
+
+  class: multileg
+  symbol: SPY
+  type: debit
+  duration: day
+  price: 1.25
+  legs:
+    - option_symbol: SPY261218C00600000
+      side: buy_to_open
+      quantity: 1
+    - option_symbol: SPY261218C00610000
+      side: sell_to_open
+      quantity: 1
+
+A strategy field (e.g. strategy: momentum) tags the order <strategy>-<run ID> for
+'tradier accounts tags', unless the file sets a tag itself.
+
+--at takes an Eastern time resolved against the market calendar, so weekends and holidays
+are skipped and early closes are honored:
+
+  15:55              That time on the next trading day it has not yet passed
+  2026-10-19 09:45   An exact date and time
+  next-open+5m       Five minutes after the next regular open
+  close-5m           Five minutes before today's close (12:55 on a half day)
+
+The order is previewed with the API when scheduled, so mistakes surface now rather than at
+submission time. Use --skip-preview to stage it without checking.
+
+Examples:
+  tradier trading schedule --at 15:55 --file order.yaml
+  tradier trading schedule --at "next-open+5m" --file spread.yaml
+  tradier trading schedule --at close-10m --file trim.yaml --account-id 6YA00001`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		spec, _ := cmd.Flags().GetString("at")
+		file, _ := cmd.Flags().GetString("file")
+		skipPreview, _ := cmd.Flags().GetBool("skip-preview")
+		if spec == "" || file == "" {
+			return fmt.Errorf("--at and --file are required")
+		}
+
+		params, err := scheduler.LoadOrder(file)
+		if err != nil {
+			return err
+		}
+		if err := applyStrategyTag(params); err != nil {
+			return err
+		}
+		at, err := scheduler.ResolveAt(spec, time.Now(), marketCalendar(c, openCache(cmd)))
+		if err != nil {
+			return err
+		}
+		if !skipPreview {
+			preview := maps.Clone(params)
+			preview["preview"] = "true"
+			if _, err := c.PlaceOrder(accountID, preview); err != nil {
+				return fmt.Errorf("order preview failed (use --skip-preview to schedule anyway): %w", err)
+			}
+		}
+
+		path, err := scheduler.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		jobs, err := scheduler.Load(path)
+		if err != nil {
+			return err
+		}
+		jobs, job := scheduler.Add(jobs, scheduler.Job{
+			At:        at,
+			Spec:      spec,
+			AccountID: accountID,
+			Sandbox:   sandboxMode,
+			Paper:     paperMode,
+			Params:    params,
+			Created:   time.Now(),
+		})
+		if err := scheduler.Save(path, jobs); err != nil {
+			return err
+		}
+		return printScheduledOrders([]scheduler.Job{job})
+	},
+}
+
+// schedulerCmd is the parent command for managing and running scheduled orders.
+var schedulerCmd = &cobra.Command{
+	Use:   "scheduler",
+	Short: "Scheduled order commands",
+	Long:  "Commands for listing and canceling orders staged with 'tradier trading schedule', and the daemon that submits them.",
+}
+
+// listScheduledCmd prints scheduled orders.
+var listScheduledCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled orders",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		path, err := scheduler.DefaultPath()
+		if err != nil {
+			return err
+		}
+		jobs, err := scheduler.Load(path)
+		if err != nil {
+			return err
+		}
+		var out []scheduler.Job
+		for _, j := range jobs {
+			if all || j.Status == scheduler.StatusPending {
+				out = append(out, j)
+			}
+		}
+		sort.SliceStable(out, func(a, b int) bool { return out[a].At.Before(out[b].At) })
+		return printScheduledOrders(out)
+	},
+}
+
+// cancelScheduledCmd cancels a pending scheduled order.
+var cancelScheduledCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel a pending scheduled order",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt("id")
+		if id <= 0 {
+			return fmt.Errorf("--id is required")
+		}
+		path, err := scheduler.DefaultPath()
+		if err != nil {
+			return err
+		}
+		unlock, err := filelock.Lock(path)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		jobs, err := scheduler.Load(path)
+		if err != nil {
+			return err
+		}
+		if err := scheduler.Cancel(jobs, id); err != nil {
+			return err
+		}
+		if err := scheduler.Save(path, jobs); err != nil {
+			return err
+		}
+		fmt.Printf("Canceled scheduled order %d\n", id)
+		return nil
+	},
+}
+
+// runSchedulerCmd submits scheduled orders as they come due until interrupted.
+var runSchedulerCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Submit scheduled orders at their times until interrupted",
+	Long: `Watch the scheduled orders and submit each with the API when its time arrives, recording the
+resulting order ID or error. Only orders scheduled in the same environment are submitted, so run
+with --sandbox to submit sandbox orders.
+
+An order more than --grace past its time when the daemon sees it (for example because the daemon
+was not running) is marked missed rather than sent late. Each order is marked submitting before
+it is sent; if the daemon stops mid-send, the next run looks for the order's tag in the account
+and records it as submitted or failed instead of sending it again. Orders scheduled while the daemon runs
+are picked up within 15 seconds.
+
+Examples:
+  tradier scheduler run
+  tradier scheduler run --grace 1m
+  tradier scheduler run --sandbox`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		grace, _ := cmd.Flags().GetDuration("grace")
+		path, err := scheduler.DefaultPath()
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		fmt.Fprintln(os.Stderr, "Waiting for scheduled orders. Press Ctrl+C to stop.")
+
+		if err := reconcileScheduledOrders(c, path); err != nil {
+			return err
+		}
+		for {
+			jobs, err := scheduler.Load(path)
+			if err != nil {
+				return err
+			}
+			for _, due := range scheduler.Due(jobs, sandboxMode, paperMode, time.Now()) {
+				job, ok := claimScheduledOrder(path, due.ID)
+				if !ok {
+					continue
+				}
+				outcome := submitScheduledOrder(c, job, job.SubmittedAt, grace)
+				if err := recordScheduledOutcome(path, outcome); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+				printScheduledOutcome(outcome)
+			}
+
+			// Re-read so the wait reflects what was just sent. A job still due here couldn't be
+			// claimed, so it is retried after a second rather than in a tight loop.
+			if jobs, err = scheduler.Load(path); err != nil {
+				return err
+			}
+			wait := schedulerIdleWait
+			if next, ok := scheduler.NextAt(jobs, sandboxMode, paperMode); ok && time.Until(next) < wait {
+				wait = time.Until(next)
+				if wait <= 0 {
+					wait = time.Second
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(wait):
+			}
+		}
+	},
+}
+
+// reconcileScheduledOrders settles jobs left submitting by a daemon that stopped while sending
+// them: each is marked submitted if the account has an order with its tag, and failed otherwise,
+// so no job is ever sent twice.
+func reconcileScheduledOrders(c *client.Client, path string) error {
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	jobs, err := scheduler.Load(path)
+	if err != nil {
+		return err
+	}
+	interrupted := scheduler.Interrupted(jobs, sandboxMode, paperMode)
+	if len(interrupted) == 0 {
+		return nil
+	}
+
+	placed := map[string][]map[string]interface{}{}
+	for _, j := range interrupted {
+		if _, ok := placed[j.AccountID]; ok {
+			continue
+		}
+		data, err := c.GetOrders(j.AccountID, "", "", "true")
+		if err != nil {
+			return fmt.Errorf("unable to check orders for interrupted scheduled orders: %w", err)
+		}
+		placed[j.AccountID] = toSlice(nested(parseJSON(data), "orders")["order"])
+	}
+
+	for _, j := range interrupted {
+		j.Status = scheduler.StatusFailed
+		j.Error = fmt.Sprintf("submission interrupted and no order tagged %s was found", j.Params["tag"])
+		if id, ok := taggedOrderID(placed[j.AccountID], j.Params["tag"], j.SubmittedAt); ok {
+			j.Status = scheduler.StatusSubmitted
+			j.OrderID = id
+			j.Error = ""
+		}
+		scheduler.Record(jobs, j)
+		printScheduledOutcome(j)
+	}
+	return scheduler.Save(path, jobs)
+}
+
+// claimScheduledOrder marks a due job submitting and saves that before anything is sent,
+// reporting false if the job was canceled meanwhile or the claim couldn't be saved.
+func claimScheduledOrder(path string, id int) (scheduler.Job, bool) {
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: scheduled order %d not sent: %v\n", id, err)
+		return scheduler.Job{}, false
+	}
+	defer unlock()
+	jobs, err := scheduler.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return scheduler.Job{}, false
+	}
+	job, err := scheduler.Claim(jobs, id, time.Now())
+	if err != nil {
+		return scheduler.Job{}, false
+	}
+	if err := scheduler.Save(path, jobs); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: scheduled order %d not sent: %v\n", id, err)
+		return scheduler.Job{}, false
+	}
+	return job, true
+}
+
+// submitScheduledOrder places a due order and returns the job with its outcome, or marks it
+// missed if it is more than grace past its time.
+func submitScheduledOrder(c *client.Client, job scheduler.Job, now time.Time, grace time.Duration) scheduler.Job {
+	job.SubmittedAt = now
+	if now.Sub(job.At) > grace {
+		job.Status = scheduler.StatusMissed
+		job.Error = fmt.Sprintf("%s late", now.Sub(job.At).Round(time.Second))
+		return job
+	}
+
+	data, err := c.PlaceOrder(job.AccountID, job.Params)
+	if err != nil {
+		job.Status = scheduler.StatusFailed
+		job.Error = err.Error()
+		return job
+	}
+	order := nested(parseJSON(data), "order")
+	if status := str(order, "status"); status != "ok" {
+		job.Status = scheduler.StatusFailed
+		job.Error = fmt.Sprintf("order status %q: %s", status, data)
+		return job
+	}
+	job.Status = scheduler.StatusSubmitted
+	job.OrderID = str(order, "id")
+	return job
+}
+
+// recordScheduledOutcome saves a submission outcome on the claimed job, re-reading the file
+// first so changes made while the order was being sent are kept.
+func recordScheduledOutcome(path string, outcome scheduler.Job) error {
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	jobs, err := scheduler.Load(path)
+	if err != nil {
+		return err
+	}
+	scheduler.Record(jobs, outcome)
+	return scheduler.Save(path, jobs)
+}
+
+// printScheduledOutcome reports a submission attempt as a line of text, or a JSON object with --json.
+func printScheduledOutcome(job scheduler.Job) {
+	if jsonOutput {
+		line, _ := json.Marshal(job)
+		fmt.Println(string(line))
+		return
+	}
+	result := "order " + job.OrderID
+	if job.Status != scheduler.StatusSubmitted {
+		result = job.Error
+	}
+	fmt.Printf("%s  #%d  %s  %s: %s\n", job.SubmittedAt.In(pricing.Eastern()).Format("2006-01-02 15:04:05"),
+		job.ID, orderSummary(job.Params), job.Status, result)
+}
+
+// orderSummary describes order parameters briefly, e.g. "buy 10 AAPL market" or "multileg SPY debit 1.25 (2 legs)".
+func orderSummary(p map[string]string) string {
+	legs := 0
+	for key := range p {
+		if strings.HasPrefix(key, "side[") {
+			legs++
+		}
+	}
+	symbol := p["symbol"]
+	if p["option_symbol"] != "" {
+		symbol = formatOptionSymbol(p["option_symbol"])
+	}
+
+	parts := []string{p["class"]}
+	if legs == 0 {
+		parts = []string{p["side"], p["quantity"], symbol}
+	} else {
+		parts = append(parts, symbol)
+	}
+	parts = append(parts, p["type"])
+	if p["price"] != "" {
+		parts = append(parts, p["price"])
+	}
+	if p["stop"] != "" {
+		parts = append(parts, "stop "+p["stop"])
+	}
+	if legs > 0 {
+		parts = append(parts, fmt.Sprintf("(%d legs)", legs))
+	}
+
+	var out []string
+	for _, s := range parts {
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// printScheduledOrders outputs jobs as a table or, with --json, as {"scheduled_orders": [...]}.
+func printScheduledOrders(jobs []scheduler.Job) error {
+	if jobs == nil {
+		jobs = []scheduler.Job{}
+	}
+	out, err := json.Marshal(map[string]interface{}{"scheduled_orders": jobs})
+	if err != nil {
+		return err
+	}
+	printResult(out, displayScheduledOrders)
+	return nil
+}
+
+// displayScheduledOrders renders scheduled orders as a table.
+func displayScheduledOrders(data []byte) {
+	var out struct {
+		Jobs []scheduler.Job `json:"scheduled_orders"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	if len(out.Jobs) == 0 {
+		fmt.Println("No scheduled orders.")
+		return
+	}
+
+	var rows [][]string
+	for _, j := range out.Jobs {
+		account := j.AccountID
+		if j.Sandbox {
+			account += " (sandbox)"
+		}
+		if j.Paper {
+			account += " (paper)"
+		}
+		result := j.OrderID
+		if j.Error != "" {
+			result = j.Error
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(j.ID),
+			j.At.In(pricing.Eastern()).Format("Mon Jan 2 15:04 MST"),
+			j.Spec,
+			account,
+			orderSummary(j.Params),
+			string(j.Status),
+			result,
+		})
+	}
+	printTable([]string{"ID", "Submit At", "Schedule", "Account", "Order", "Status", "Order ID / Error"}, rows)
+}
+
+func init() {
+	// Schedule order flags
+	scheduleOrderCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	scheduleOrderCmd.Flags().String("at", "", "When to submit, e.g. 15:55, next-open+5m, close-5m (required)")
+	scheduleOrderCmd.Flags().String("file", "", "YAML or JSON order file (required)")
+	scheduleOrderCmd.Flags().Bool("skip-preview", false, "Schedule without previewing the order first")
+
+	// List and cancel flags
+	listScheduledCmd.Flags().Bool("all", false, "Include submitted, failed, missed, and canceled orders")
+	cancelScheduledCmd.Flags().Int("id", 0, "Scheduled order ID (required)")
+
+	// Run flags
+	runSchedulerCmd.Flags().Duration("grace", 5*time.Minute, "Mark orders missed instead of submitting them when this late")
+
+	// Build command tree
+	tradingCmd.AddCommand(scheduleOrderCmd)
+	schedulerCmd.AddCommand(listScheduledCmd, cancelScheduledCmd, runSchedulerCmd)
+	rootCmd.AddCommand(schedulerCmd)
+}
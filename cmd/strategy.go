@@ -0,0 +1,182 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cloudmanic/tradier/backtest"
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/config"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/strategy"
+	"github.com/spf13/cobra"
+)
+
+// strategyCmd is the parent command for running Go strategies.
+var strategyCmd = &cobra.Command{
+	Use:   "strategy",
+	Short: "Run Go trading strategies live or on paper",
+	Long:  "Commands for running trading strategies written in Go against live market data.",
+}
+
+// runStrategyCmd runs a strategy plugin against the market event stream.
+var runStrategyCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a strategy plugin on streaming market data until interrupted",
+	Long: `Run a strategy written against the strategy package on trades and quotes from Tradier's
+market event stream, trading a Tradier account, or the paper account with --paper. The same
+plugin backtests with 'tradier backtest run --strategy FILE.so'.
+
+The plugin is built with 'go build -buildmode=plugin' from a main package exporting
+'func New() strategy.Strategy'. Its OnQuote is called for every trade and quote, OnBar as each
+--interval bar of regular-session trades closes, OnFill when an order fills (orders are checked
+every --poll), and OnTimer every --timer. Bars from before the start, --warmup of them or as
+many as the strategy's Lookback method asks for, are loaded so indicators work from the first
+bar. Log messages are printed to stderr. Plugins need a tradier binary built with cgo on Linux
+or macOS.
+
+Examples:
+  tradier strategy run --plugin ./momentum.so --symbols SPY,QQQ --interval 5min --paper
+  tradier strategy run --plugin ./momentum.so --symbols SPY,QQQ --interval 5min --timer 1m --warmup 100`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if sandboxMode {
+			return fmt.Errorf("the market event stream is not available in the sandbox; use --paper to trade without risking money")
+		}
+		pluginPath, _ := cmd.Flags().GetString("plugin")
+		symbolList, _ := cmd.Flags().GetString("symbols")
+		interval, _ := cmd.Flags().GetString("interval")
+		timer, _ := cmd.Flags().GetDuration("timer")
+		poll, _ := cmd.Flags().GetDuration("poll")
+		if pluginPath == "" || symbolList == "" {
+			return fmt.Errorf("--plugin and --symbols are required")
+		}
+		width, ok := backtestIntervals[interval]
+		if !ok {
+			return fmt.Errorf("invalid --interval %q (daily, 1min, 5min, 15min)", interval)
+		}
+		var symbols []string
+		for _, s := range strings.Split(symbolList, ",") {
+			if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+				symbols = append(symbols, s)
+			}
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w\nRun 'tradier init' to configure your API key", err)
+		}
+		apiKey := cfg.APIKey(false)
+		if apiKey == "" {
+			return fmt.Errorf("no production API key configured. Run 'tradier init' to set it up")
+		}
+		data := client.NewClient(cfg.BaseURL(false), apiKey)
+
+		mode := strategy.Live
+		var broker strategy.Broker
+		if paperMode {
+			engine, err := paperEngine(cfg)
+			if err != nil {
+				return err
+			}
+			mode, broker = strategy.Paper, strategy.NewAccountBroker(engine, paper.AccountNumber)
+		} else {
+			accountID, err := requireAccountID(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			broker = strategy.NewAccountBroker(data, accountID)
+		}
+
+		s, err := strategy.LoadPlugin(pluginPath)
+		if err != nil {
+			return err
+		}
+		warmup := 0
+		if w, ok := s.(backtest.Warmup); ok {
+			warmup = w.Lookback()
+		}
+		if cmd.Flags().Changed("warmup") {
+			warmup, _ = cmd.Flags().GetInt("warmup")
+		}
+
+		store := openCache(cmd)
+		cal := marketCalendar(data, store)
+		var history func(symbol string) ([]bars.Bar, error)
+		if warmup > 0 {
+			history = func(symbol string) ([]bars.Bar, error) {
+				now := time.Now().In(pricing.Eastern())
+				b, err := backtestBars(data, store, symbol, interval, warmupStart(now, warmup, width), now)
+				if err != nil {
+					return nil, err
+				}
+				return closedBars(b, width, now, cal), nil
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		fmt.Fprintf(os.Stderr, "Running %s on %s (%s, %s bars). Press Ctrl+C to stop.\n", pluginPath, strings.Join(symbols, ", "), mode, interval)
+
+		return strategy.Run(ctx, strategy.Config{
+			Mode:     mode,
+			Symbols:  symbols,
+			Broker:   broker,
+			Feed:     strategy.StreamFeed(data),
+			Interval: width,
+			Calendar: cal,
+			Timer:    timer,
+			Poll:     poll,
+			History:  history,
+			Logf: func(format string, args ...interface{}) {
+				fmt.Fprintf(os.Stderr, "%s %s\n", time.Now().Format("15:04:05"), fmt.Sprintf(format, args...))
+			},
+		}, s)
+	},
+}
+
+// closedBars drops the bars that haven't closed by now, so the bar in progress is built from
+// streamed trades instead. A daily bar closes with its day's session on the calendar, or at
+// 4 p.m. Eastern if the calendar can't be read.
+func closedBars(b []bars.Bar, width time.Duration, now time.Time, cal *calendar.Calendar) []bars.Bar {
+	for len(b) > 0 {
+		last := b[len(b)-1].Time.In(pricing.Eastern())
+		close := last.Add(width)
+		if width == 0 {
+			close = time.Date(last.Year(), last.Month(), last.Day(), 16, 0, 0, 0, pricing.Eastern())
+			if day, err := cal.Day(last); err == nil && !day.Regular.IsZero() {
+				close = day.Regular.End
+			}
+		}
+		if !close.After(now) {
+			break
+		}
+		b = b[:len(b)-1]
+	}
+	return b
+}
+
+func init() {
+	runStrategyCmd.Flags().String("plugin", "", "Strategy plugin (.so) exporting func New() strategy.Strategy (required)")
+	runStrategyCmd.Flags().String("symbols", "", "Comma-separated symbols to stream (required)")
+	runStrategyCmd.Flags().String("interval", "5min", "Bar interval: daily, 1min, 5min, 15min")
+	runStrategyCmd.Flags().Duration("timer", 0, "How often to call the strategy's OnTimer (0 for never)")
+	runStrategyCmd.Flags().Duration("poll", 5*time.Second, "How often to check orders for fills")
+	runStrategyCmd.Flags().Int("warmup", 0, "Bars of history to load before starting (defaults to the strategy's Lookback)")
+	runStrategyCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	addCacheFlags(runStrategyCmd)
+
+	// Build command tree
+	strategyCmd.AddCommand(runStrategyCmd)
+	rootCmd.AddCommand(strategyCmd)
+}
@@ -4,9 +4,20 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
 	"github.com/spf13/cobra"
 )
 
+// defaultStreamURL is Tradier's HTTP market event stream, used when a session does not name one.
+const defaultStreamURL = "https://stream.tradier.com/v1/markets/events"
+
 // streamingCmd is the parent command for streaming session subcommands.
 var streamingCmd = &cobra.Command{
 	Use:   "streaming",
@@ -50,6 +61,51 @@ var accountSessionCmd = &cobra.Command{
 	},
 }
 
+// streamQuotes passes trades and quotes for the given symbols from the market event stream to
+// handle until ctx is canceled, opening a new session after each disconnect. The symbols are
+// read again on every reconnect.
+func streamQuotes(ctx context.Context, c *client.Client, symbols func() []string, retry time.Duration, handle func(ev map[string]interface{})) {
+	for {
+		err := streamSession(ctx, c, symbols(), handle)
+		if ctx.Err() != nil {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Warning: quote stream stopped (%v); reconnecting in %s\n", err, retry)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
+
+// streamSession opens one market streaming session for the symbols and passes its events to handle.
+func streamSession(ctx context.Context, c *client.Client, symbols []string, handle func(ev map[string]interface{})) error {
+	data, err := c.CreateMarketSession()
+	if err != nil {
+		return err
+	}
+	session := nested(parseJSON(data), "stream")
+	url := str(session, "url")
+	if !strings.HasPrefix(url, "http") {
+		url = defaultStreamURL
+	}
+	return c.StreamMarketEvents(ctx, url, str(session, "sessionid"), strings.Join(symbols, ","), "trade,quote", func(event []byte) error {
+		handle(parseJSON(event))
+		return nil
+	})
+}
+
+// eventNumber returns a numeric stream event field. Stream events carry prices as strings or
+// numbers depending on the type.
+func eventNumber(ev map[string]interface{}, key string) float64 {
+	if v, ok := ev[key].(string); ok {
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return num(ev, key)
+}
+
 func init() {
 	streamingCmd.AddCommand(marketSessionCmd, accountSessionCmd)
 	rootCmd.AddCommand(streamingCmd)
@@ -0,0 +1,336 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/cloudmanic/tradier/tags"
+	"github.com/spf13/cobra"
+)
+
+// orderPageSize is the number of orders requested per page when walking order history.
+const orderPageSize = 100
+
+// tagReport is the computed result of the tags command.
+type tagReport struct {
+	AccountID string         `json:"account_id"`
+	Prefix    string         `json:"prefix,omitempty"`
+	Tags      []tags.Summary `json:"tags"`
+	Total     tags.Summary   `json:"total"`
+	Fills     []tags.Fill    `json:"fills"`
+}
+
+// tagReportCmd attributes fills, realized P&L, and commissions to order tags.
+var tagReportCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Report fills, realized P&L, and commissions per order tag",
+	Long: `Group the account's executed orders by tag to attribute trading results to strategies.
+
+For each tag the report shows the orders and fills, dollars bought and sold, realized P&L,
+commissions, and net P&L. Realized P&L matches each tag's fills first in, first out per symbol,
+so only round trips opened and closed under the same tag count; quantities still open are listed
+separately. Commissions come from the account's trade history, matched to fills by symbol, date,
+and quantity. Untagged orders are grouped under "(untagged)" unless --tag-prefix is given.
+
+Tag orders with 'tradier trading place --strategy <name>', which produces tags such as
+momentum-20261018-153000 so that --tag-prefix momentum- selects every run of a strategy.
+
+Examples:
+  tradier accounts tags
+  tradier accounts tags --tag-prefix momentum-
+  tradier accounts tags --start 2026-01-01 --fills`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, cfg, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		accountID, err := requireAccountID(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		prefix, _ := cmd.Flags().GetString("tag-prefix")
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+
+		orders, err := fetchAllOrders(c, accountID)
+		if err != nil {
+			return err
+		}
+		var fills []tags.Fill
+		for _, o := range filterOrdersByTag(orders, prefix) {
+			for _, f := range orderFills(o) {
+				date := f.Date.In(pricing.Eastern()).Format("2006-01-02")
+				if (start == "" || date >= start) && (end == "" || date <= end) {
+					fills = append(fills, f)
+				}
+			}
+		}
+		if err := addCommissions(c, accountID, fills); err != nil {
+			return err
+		}
+
+		summaries := tags.Summarize(fills)
+		report := tagReport{AccountID: accountID, Prefix: prefix, Tags: summaries, Total: tags.Total(summaries), Fills: fills}
+		if report.Fills == nil {
+			report.Fills = []tags.Fill{}
+		}
+		out, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		showFills, _ := cmd.Flags().GetBool("fills")
+		printResult(out, func(data []byte) { displayTagReport(data, showFills) })
+		return nil
+	},
+}
+
+// orderRunID returns the run ID for generated tags: $TRADIER_RUN_ID when set, so every order
+// from one scripted run shares it, otherwise the current time.
+func orderRunID() string {
+	if id := os.Getenv("TRADIER_RUN_ID"); id != "" {
+		return id
+	}
+	return tags.RunID(time.Now())
+}
+
+// applyStrategyTag replaces a strategy parameter with a generated tag, unless the order already
+// has a tag, and checks the tag is one Tradier accepts.
+func applyStrategyTag(params map[string]string) error {
+	if strategy, ok := params["strategy"]; ok {
+		delete(params, "strategy")
+		if params["tag"] == "" {
+			params["tag"] = tags.New(strategy, orderRunID())
+		}
+	}
+	if params["tag"] == "" {
+		return nil
+	}
+	return tags.Validate(params["tag"])
+}
+
+// fetchAllOrders walks every page of the account's orders, including tags.
+func fetchAllOrders(c *client.Client, accountID string) ([]map[string]interface{}, error) {
+	var orders []map[string]interface{}
+	limit := strconv.Itoa(orderPageSize)
+	firstID := ""
+	for page := 1; ; page++ {
+		data, err := c.GetOrders(accountID, strconv.Itoa(page), limit, "true")
+		if err != nil {
+			return nil, err
+		}
+		batch := toSlice(nested(parseJSON(data), "orders")["order"])
+		// Stop if the API ignores paging and returns the first page again
+		if len(batch) == 0 || (page > 1 && str(batch[0], "id") == firstID) {
+			break
+		}
+		if page == 1 {
+			firstID = str(batch[0], "id")
+		}
+		orders = append(orders, batch...)
+		if len(batch) < orderPageSize {
+			break
+		}
+	}
+	return orders, nil
+}
+
+// filterOrdersByTag returns the orders whose tag starts with prefix. An empty prefix keeps every order.
+func filterOrdersByTag(orders []map[string]interface{}, prefix string) []map[string]interface{} {
+	if prefix == "" {
+		return orders
+	}
+	var out []map[string]interface{}
+	for _, o := range orders {
+		if tag := str(o, "tag"); tag != "" && strings.HasPrefix(tag, prefix) {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// orderFills returns the executed quantity of an order, one fill per leg for multileg and
+// OTO/OCO orders.
+func orderFills(o map[string]interface{}) []tags.Fill {
+	legs := toSlice(o["leg"])
+	if len(legs) == 0 {
+		legs = []map[string]interface{}{o}
+	}
+
+	var out []tags.Fill
+	for _, leg := range legs {
+		qty := num(leg, "exec_quantity")
+		if qty <= 0 {
+			continue
+		}
+		symbol := str(leg, "option_symbol")
+		if symbol == "" {
+			symbol = str(leg, "symbol")
+		}
+		date, err := time.Parse(time.RFC3339, str(leg, "transaction_date"))
+		if err != nil {
+			date, _ = time.Parse(time.RFC3339, str(o, "transaction_date"))
+		}
+		mult := 1.0
+		if occ.IsOption(symbol) {
+			mult = 100
+		}
+		out = append(out, tags.Fill{
+			Tag:        str(o, "tag"),
+			OrderID:    str(o, "id"),
+			Symbol:     symbol,
+			Side:       str(leg, "side"),
+			Quantity:   qty,
+			Price:      num(leg, "avg_fill_price"),
+			Multiplier: mult,
+			Date:       date,
+		})
+	}
+	return out
+}
+
+// addCommissions sets each fill's commission from the trade history event with the same
+// symbol, date, and quantity, using each event once and preferring the closest price.
+func addCommissions(c *client.Client, accountID string, fills []tags.Fill) error {
+	if len(fills) == 0 {
+		return nil
+	}
+	eastern := pricing.Eastern()
+	start, end := fills[0].Date, fills[0].Date
+	for _, f := range fills {
+		start, end = minTime(start, f.Date), maxTime(end, f.Date)
+	}
+	events, err := fetchHistoryEvents(c, accountID, "trade", start.In(eastern).Format("2006-01-02"), end.In(eastern).Format("2006-01-02"))
+	if err != nil {
+		return err
+	}
+
+	used := make([]bool, len(events))
+	for i := range fills {
+		f := &fills[i]
+		date := f.Date.In(eastern).Format("2006-01-02")
+		best, bestDiff := -1, math.Inf(1)
+		for j, e := range events {
+			trade := nested(e, "trade")
+			if used[j] || str(trade, "symbol") != f.Symbol || shortDate(str(e, "date")) != date ||
+				math.Abs(math.Abs(num(trade, "quantity"))-f.Quantity) > 1e-9 {
+				continue
+			}
+			if diff := math.Abs(num(trade, "price") - f.Price); diff < bestDiff {
+				best, bestDiff = j, diff
+			}
+		}
+		if best >= 0 {
+			used[best] = true
+			f.Commission = num(nested(events[best], "trade"), "commission")
+		}
+	}
+	return nil
+}
+
+// minTime returns the earlier of two times.
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// maxTime returns the later of two times.
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// displayTagReport renders per-tag results with a total row and, when showFills is set, every fill.
+func displayTagReport(data []byte, showFills bool) {
+	var r tagReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	if len(r.Tags) == 0 {
+		fmt.Println("No filled orders found.")
+		return
+	}
+
+	eastern := pricing.Eastern()
+	row := func(s tags.Summary, name string) []string {
+		open := "-"
+		if len(s.Open) > 0 {
+			var parts []string
+			for _, symbol := range s.Symbols {
+				if q, ok := s.Open[symbol]; ok {
+					parts = append(parts, fmt.Sprintf("%s %s", strconv.FormatFloat(q, 'f', -1, 64), formatOptionSymbol(symbol)))
+				}
+			}
+			open = strings.Join(parts, ", ")
+		}
+		return []string{
+			name,
+			strconv.Itoa(s.Orders),
+			strconv.Itoa(s.Fills),
+			money(s.Bought),
+			money(s.Sold),
+			money(s.Realized),
+			money(s.Commissions),
+			money(s.Net),
+			open,
+			s.First.In(eastern).Format("2006-01-02") + " - " + s.Last.In(eastern).Format("2006-01-02"),
+		}
+	}
+	var rows [][]string
+	for _, s := range r.Tags {
+		name := s.Tag
+		if name == "" {
+			name = "(untagged)"
+		}
+		rows = append(rows, row(s, name))
+	}
+	if len(r.Tags) > 1 {
+		total := row(r.Total, "Total")
+		total[8] = ""
+		rows = append(rows, total)
+	}
+	printTable([]string{"Tag", "Orders", "Fills", "Bought", "Sold", "Realized", "Commissions", "Net", "Open", "Dates"}, rows)
+
+	if !showFills {
+		return
+	}
+	fmt.Println()
+	var fillRows [][]string
+	for _, f := range r.Fills {
+		fillRows = append(fillRows, []string{
+			f.Date.In(eastern).Format("2006-01-02 15:04"),
+			f.Tag,
+			f.OrderID,
+			formatOptionSymbol(f.Symbol),
+			f.Side,
+			strconv.FormatFloat(f.Quantity, 'f', -1, 64),
+			money(f.Price),
+			money(f.Commission),
+		})
+	}
+	printTable([]string{"Date", "Tag", "Order ID", "Symbol", "Side", "Quantity", "Price", "Commission"}, fillRows)
+}
+
+func init() {
+	tagReportCmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+	tagReportCmd.Flags().String("tag-prefix", "", "Only include orders whose tag starts with this prefix")
+	tagReportCmd.Flags().String("start", "", "Only include fills on or after this date (YYYY-MM-DD)")
+	tagReportCmd.Flags().String("end", "", "Only include fills on or before this date (YYYY-MM-DD)")
+	tagReportCmd.Flags().Bool("fills", false, "Also list every fill")
+	accountsCmd.AddCommand(tagReportCmd)
+}
@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/cloudmanic/tradier/journal"
 	"github.com/spf13/cobra"
 )
 
@@ -34,7 +35,13 @@ Examples:
     --option-symbol-0 AAPL220617C00270000 --side-0 buy_to_open --quantity-0 1 \
     --option-symbol-1 AAPL220617C00280000 --side-1 sell_to_open --quantity-1 1
 
-  # Preview an order (validates without submitting)
+  # Tag the order for per-strategy reporting (tag momentum-<run ID>; set TRADIER_RUN_ID to share one run ID)
+  tradier trading place --class equity --symbol AAPL --side buy --quantity 10 --type market --duration day --strategy momentum
+
+  # Record the order, its response, and the quotes at submission in the trade journal
+  tradier trading place --class equity --symbol AAPL --side buy --quantity 10 --type market --duration day --note "breakout over 250"
+
+  # Preview an order (validates without submitting; opening option orders also show a payoff chart at their limit price)
   tradier trading place --class equity --symbol AAPL --side buy --quantity 10 --type market --duration day --preview`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, cfg, err := loadClientFromConfig()
@@ -101,12 +108,25 @@ Examples:
 		if orderClass == "" {
 			return fmt.Errorf("--class is required (equity, option, multileg, combo, oto, oco, otoco)")
 		}
+		if strategy, _ := cmd.Flags().GetString("strategy"); strategy != "" {
+			params["strategy"] = strategy
+		}
+		if err := applyStrategyTag(params); err != nil {
+			return err
+		}
 
+		j := startOrderJournal(cmd, c, cfg, journal.ActionPlace, accountID, "", params)
 		data, err := c.PlaceOrder(accountID, params)
+		j.finish(data, err)
 		if err != nil {
 			return err
 		}
 		printResult(data, displayOrderResult)
+
+		// Show the risk profile of option orders being previewed
+		if params["preview"] == "true" && !jsonOutput {
+			showPreviewPayoff(c, params)
+		}
 		return nil
 	},
 }
@@ -135,7 +155,9 @@ var changeOrderCmd = &cobra.Command{
 				params[flag] = val
 			}
 		}
+		j := startOrderJournal(cmd, c, cfg, journal.ActionChange, accountID, orderID, params)
 		data, err := c.ChangeOrder(accountID, orderID, params)
+		j.finish(data, err)
 		if err != nil {
 			return err
 		}
@@ -161,7 +183,9 @@ var cancelOrderCmd = &cobra.Command{
 		if orderID == "" {
 			return fmt.Errorf("--order-id is required")
 		}
+		j := startOrderJournal(cmd, c, cfg, journal.ActionCancel, accountID, orderID, nil)
 		data, err := c.CancelOrder(accountID, orderID)
+		j.finish(data, err)
 		if err != nil {
 			return err
 		}
@@ -175,6 +199,7 @@ func init() {
 	tradingCmds := []*cobra.Command{placeOrderCmd, changeOrderCmd, cancelOrderCmd}
 	for _, cmd := range tradingCmds {
 		cmd.Flags().String("account-id", "", "Account ID (defaults to config value)")
+		addJournalFlags(cmd)
 	}
 
 	// Place order flags - common
@@ -187,6 +212,7 @@ func init() {
 	placeOrderCmd.Flags().String("price", "", "Limit price")
 	placeOrderCmd.Flags().String("stop", "", "Stop price")
 	placeOrderCmd.Flags().String("tag", "", "User-defined order tag")
+	placeOrderCmd.Flags().String("strategy", "", "Strategy name; tags the order <strategy>-<run ID> unless --tag is given")
 	placeOrderCmd.Flags().String("option-symbol", "", "OCC option symbol (for single option orders)")
 	placeOrderCmd.Flags().String("preview", "", "Preview order without submitting: true/false")
 
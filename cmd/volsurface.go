@@ -0,0 +1,279 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/chain"
+	"github.com/cloudmanic/tradier/pricing"
+	"github.com/spf13/cobra"
+)
+
+// volSurface is the implied volatility term structure, skew, and smile grid for one underlying.
+type volSurface struct {
+	Symbol      string          `json:"symbol"`
+	Spot        float64         `json:"underlying_price"`
+	Strikes     []float64       `json:"strikes"`
+	Expirations []volExpiration `json:"expirations"`
+}
+
+// volExpiration is the volatility summary and smile for one expiration.
+// Put25IV and Call25IV are the implied volatilities at 25 delta; Skew25 is their difference.
+type volExpiration struct {
+	Expiration string             `json:"expiration"`
+	Days       int                `json:"days"`
+	ATMIV      float64            `json:"atm_iv"`
+	Put25IV    float64            `json:"put_25d_iv"`
+	Call25IV   float64            `json:"call_25d_iv"`
+	Skew25     float64            `json:"skew_25d"`
+	Smile      []chain.SmilePoint `json:"smile"`
+}
+
+// volSurfaceCmd builds an implied volatility surface from every expiration's chain.
+var volSurfaceCmd = &cobra.Command{
+	Use:   "vol-surface",
+	Short: "Show implied volatility term structure, skew, and surface for a symbol",
+	Long: `Fetch the option chain with greeks for each expiration of a symbol and show the
+at-the-money implied volatility term structure, the 25-delta put/call skew for each
+expiration, and a strike by expiration grid of implied volatility.
+
+Implied volatilities are Tradier's mid IVs. The grid uses out-of-the-money options: puts
+below the underlying price and calls above it. Use --csv for a long-format file suitable
+for plotting, or --json for the full surface.
+
+Examples:
+  tradier markets vol-surface --symbol SPY
+  tradier markets vol-surface --symbol AAPL --max-expirations 6 --strikes-around 5
+  tradier markets vol-surface --symbol SPY --max-days 90 --csv > spy-surface.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, _, err := loadClientFromConfig()
+		if err != nil {
+			return err
+		}
+		symbol, _ := cmd.Flags().GetString("symbol")
+		if symbol == "" {
+			return fmt.Errorf("--symbol is required")
+		}
+		symbol = strings.ToUpper(symbol)
+		maxExpirations, _ := cmd.Flags().GetInt("max-expirations")
+		maxDays, _ := cmd.Flags().GetInt("max-days")
+		strikesAround, _ := cmd.Flags().GetInt("strikes-around")
+		asCSV, _ := cmd.Flags().GetBool("csv")
+
+		data, err := c.GetOptionsExpirations(symbol, "", "", "", "")
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		var expirations []string
+		for _, exp := range toStringSlice(nested(parseJSON(data), "expirations")["date"]) {
+			d, ok := parseDay(exp)
+			if !ok || pricing.YearsToExpiry(d, now) <= 0 {
+				continue
+			}
+			if maxDays > 0 && expirationDays(d, now) > maxDays {
+				continue
+			}
+			expirations = append(expirations, exp)
+			if maxExpirations > 0 && len(expirations) == maxExpirations {
+				break
+			}
+		}
+		if len(expirations) == 0 {
+			return fmt.Errorf("no option expirations found for %s", symbol)
+		}
+
+		spot, err := underlyingPrice(c, symbol)
+		if err != nil {
+			return err
+		}
+		chains, errs := fetchConcurrently(expirations, func(exp string) ([]byte, error) {
+			return c.GetOptionsChains(symbol, exp, "true")
+		})
+
+		surface := buildVolSurface(symbol, spot, expirations, chains, errs, strikesAround, now)
+		if asCSV {
+			return writeVolSurfaceCSV(surface)
+		}
+		out, err := json.Marshal(surface)
+		if err != nil {
+			return err
+		}
+		printResult(out, displayVolSurface)
+		return nil
+	},
+}
+
+// buildVolSurface summarizes each expiration's chain. Expirations that failed to load are reported
+// on stderr and skipped. The grid strikes are the n strikes either side of spot across all chains.
+func buildVolSurface(symbol string, spot float64, expirations []string, chains [][]byte, errs []error, n int, now time.Time) volSurface {
+	surface := volSurface{Symbol: symbol, Spot: spot}
+	var all []chain.Contract
+	for i, exp := range expirations {
+		if errs[i] != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", exp, errs[i])
+			continue
+		}
+		contracts, err := chain.Parse(chains[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", exp, err)
+			continue
+		}
+		all = append(all, contracts...)
+
+		d, _ := parseDay(exp)
+		e := volExpiration{
+			Expiration: exp,
+			Days:       expirationDays(d, now),
+			ATMIV:      chain.ATMIV(contracts, spot),
+			Put25IV:    chain.DeltaIV(contracts, 0.25, false),
+			Call25IV:   chain.DeltaIV(contracts, 0.25, true),
+			Smile:      chain.Smile(contracts, spot),
+		}
+		if e.Put25IV > 0 && e.Call25IV > 0 {
+			e.Skew25 = e.Put25IV - e.Call25IV
+		}
+		surface.Expirations = append(surface.Expirations, e)
+	}
+
+	surface.Strikes = chain.Strikes(all)
+	if n > 0 {
+		surface.Strikes = chain.StrikesAround(surface.Strikes, spot, n)
+	}
+	allowed := map[float64]bool{}
+	for _, k := range surface.Strikes {
+		allowed[k] = true
+	}
+	for i, e := range surface.Expirations {
+		smile := make([]chain.SmilePoint, 0, len(e.Smile))
+		for _, p := range e.Smile {
+			if allowed[p.Strike] {
+				smile = append(smile, p)
+			}
+		}
+		surface.Expirations[i].Smile = smile
+	}
+	return surface
+}
+
+// expirationDays returns the whole calendar days from now until the expiration date's close.
+func expirationDays(date, now time.Time) int {
+	return int(math.Round(pricing.YearsToExpiry(date, now) * 365))
+}
+
+// writeVolSurfaceCSV writes the surface in long format, one row per expiration and strike.
+func writeVolSurfaceCSV(s volSurface) error {
+	w := csv.NewWriter(os.Stdout)
+	if err := w.Write([]string{"expiration", "days", "strike", "moneyness", "iv", "atm_iv", "skew_25d"}); err != nil {
+		return err
+	}
+	for _, e := range s.Expirations {
+		for _, p := range e.Smile {
+			if err := w.Write([]string{
+				e.Expiration,
+				strconv.Itoa(e.Days),
+				strconv.FormatFloat(p.Strike, 'f', -1, 64),
+				strconv.FormatFloat(p.Strike/s.Spot, 'f', 4, 64),
+				strconv.FormatFloat(p.IV, 'f', 4, 64),
+				strconv.FormatFloat(e.ATMIV, 'f', 4, 64),
+				strconv.FormatFloat(e.Skew25, 'f', 4, 64),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// displayVolSurface renders the term structure and skew table followed by the strike by expiration grid.
+func displayVolSurface(data []byte) {
+	root := parseJSON(data)
+	if root == nil {
+		fmt.Println(string(data))
+		return
+	}
+
+	expirations := toSlice(root["expirations"])
+	if len(expirations) == 0 {
+		fmt.Println("No implied volatility data found.")
+		return
+	}
+	fmt.Printf("Underlying: %s @ %.2f\n\n", str(root, "symbol"), num(root, "underlying_price"))
+
+	volPct := func(m map[string]interface{}, key string) string {
+		if v := num(m, key); v > 0 {
+			return fmt.Sprintf("%.1f%%", v*100)
+		}
+		return ""
+	}
+
+	fmt.Println("Term Structure and 25-Delta Skew")
+	rows := make([][]string, 0, len(expirations))
+	for _, e := range expirations {
+		skew := ""
+		if num(e, "put_25d_iv") > 0 && num(e, "call_25d_iv") > 0 {
+			skew = fmt.Sprintf("%+.1f", num(e, "skew_25d")*100)
+		}
+		rows = append(rows, []string{
+			str(e, "expiration"),
+			str(e, "days"),
+			volPct(e, "atm_iv"),
+			volPct(e, "put_25d_iv"),
+			volPct(e, "call_25d_iv"),
+			skew,
+		})
+	}
+	printTable([]string{"EXPIRATION", "DAYS", "ATM IV", "25D PUT IV", "25D CALL IV", "SKEW (PTS)"}, rows)
+
+	strikes := root["strikes"]
+	strikeList, _ := strikes.([]interface{})
+	if len(strikeList) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Implied Volatility by Strike and Expiration")
+	headers := []string{"STRIKE"}
+	grid := make([]map[float64]float64, len(expirations))
+	for i, e := range expirations {
+		headers = append(headers, shortDate(str(e, "expiration")))
+		grid[i] = map[float64]float64{}
+		for _, p := range toSlice(e["smile"]) {
+			grid[i][num(p, "strike")] = num(p, "iv")
+		}
+	}
+	rows = make([][]string, 0, len(strikeList))
+	for _, k := range strikeList {
+		strike, _ := k.(float64)
+		row := []string{fmt.Sprintf("%.2f", strike)}
+		for i := range expirations {
+			cell := ""
+			if iv, ok := grid[i][strike]; ok {
+				cell = fmt.Sprintf("%.1f%%", iv*100)
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+	printTable(headers, rows)
+}
+
+func init() {
+	volSurfaceCmd.Flags().String("symbol", "", "Underlying symbol (required)")
+	volSurfaceCmd.Flags().Int("max-expirations", 12, "Maximum number of expirations to include (0 for all)")
+	volSurfaceCmd.Flags().Int("max-days", 0, "Only include expirations within this many days (0 for all)")
+	volSurfaceCmd.Flags().Int("strikes-around", 10, "Strikes either side of the underlying price in the grid (0 for all)")
+	volSurfaceCmd.Flags().Bool("csv", false, "Write the surface as CSV for plotting")
+
+	marketsCmd.AddCommand(volSurfaceCmd)
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package conditional
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/expr"
+)
+
+// Status is where a conditional order is in its life cycle.
+type Status string
+
+const (
+	// StatusPending is waiting for its trigger.
+	StatusPending Status = "pending"
+
+	// StatusSubmitting has triggered and is being sent. An order left in this state was
+	// interrupted mid-submission and must be reconciled against the account's orders
+	// before anything else happens to it.
+	StatusSubmitting Status = "submitting"
+
+	// StatusSubmitted was accepted by the API.
+	StatusSubmitted Status = "submitted"
+
+	// StatusFailed was rejected by the API or could not be sent.
+	StatusFailed Status = "failed"
+
+	// StatusExpired passed its expiration without triggering.
+	StatusExpired Status = "expired"
+
+	// StatusCanceled was canceled before it triggered.
+	StatusCanceled Status = "canceled"
+)
+
+// Order is an order held locally until its trigger expression turns true. Params are the
+// Tradier order parameters passed to PlaceOrder unchanged, and always include a tag unique
+// to the order so an interrupted submission can be found again.
+type Order struct {
+	ID          int               `json:"id"`
+	Trigger     string            `json:"trigger"`
+	AccountID   string            `json:"account_id"`
+	Sandbox     bool              `json:"sandbox"`
+	Paper       bool              `json:"paper,omitempty"`
+	Params      map[string]string `json:"params"`
+	Expires     time.Time         `json:"expires,omitzero"`
+	Status      Status            `json:"status"`
+	Created     time.Time         `json:"created"`
+	TriggeredAt time.Time         `json:"triggered_at,omitzero"`
+	OrderID     string            `json:"order_id,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// Fields are the quote fields a trigger can reference, as SYMBOL.field.
+var Fields = []string{"last", "bid", "ask", "mid", "open", "high", "low", "prevclose", "change", "change_percentage", "volume"}
+
+// Quote is the latest market data for one symbol.
+type Quote struct {
+	Last      float64 `json:"last"`
+	Bid       float64 `json:"bid"`
+	Ask       float64 `json:"ask"`
+	Open      float64 `json:"open"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	PrevClose float64 `json:"prevclose"`
+	Volume    float64 `json:"volume"`
+}
+
+// Field returns the named field. Prices the quote does not have are NaN, so comparisons
+// against them are false rather than comparisons against zero.
+func (q Quote) Field(name string) float64 {
+	price := func(f float64) float64 {
+		if f <= 0 {
+			return math.NaN()
+		}
+		return f
+	}
+	switch name {
+	case "last":
+		return price(q.Last)
+	case "bid":
+		return price(q.Bid)
+	case "ask":
+		return price(q.Ask)
+	case "mid":
+		if q.Bid <= 0 || q.Ask <= 0 {
+			return math.NaN()
+		}
+		return (q.Bid + q.Ask) / 2
+	case "open":
+		return price(q.Open)
+	case "high":
+		return price(q.High)
+	case "low":
+		return price(q.Low)
+	case "prevclose":
+		return price(q.PrevClose)
+	case "change":
+		return price(q.Last) - price(q.PrevClose)
+	case "change_percentage":
+		return (price(q.Last) - price(q.PrevClose)) / price(q.PrevClose) * 100
+	case "volume":
+		return q.Volume
+	}
+	return math.NaN()
+}
+
+// Trigger is a compiled trigger expression and the symbols it reads.
+type Trigger struct {
+	expr    *expr.Expr
+	symbols []string
+}
+
+// Compile parses a trigger such as "SPY.last > 600 and VIX.last < 20". Every field must be
+// written SYMBOL.field with a field from Fields.
+func Compile(src string) (*Trigger, error) {
+	e, err := expr.Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trigger: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, ident := range e.Idents() {
+		i := strings.LastIndexByte(ident, '.')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid trigger: %q must be written SYMBOL.field, e.g. spy.last", ident)
+		}
+		if field := ident[i+1:]; !validField(field) {
+			return nil, fmt.Errorf("invalid trigger: unknown field %q (valid: %s)", field, strings.Join(Fields, ", "))
+		}
+		seen[strings.ToUpper(ident[:i])] = true
+	}
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("invalid trigger: it must reference at least one quote, e.g. spy.last > 600")
+	}
+
+	t := &Trigger{expr: e}
+	for symbol := range seen {
+		t.symbols = append(t.symbols, symbol)
+	}
+	sort.Strings(t.symbols)
+	return t, nil
+}
+
+// Symbols returns the sorted, upper-case symbols the trigger reads.
+func (t *Trigger) Symbols() []string {
+	return t.symbols
+}
+
+// Eval reports whether the trigger is true for the quotes, keyed by upper-case symbol. It is
+// false until every symbol it reads has a quote, so a negated condition cannot fire on
+// missing data.
+func (t *Trigger) Eval(quotes map[string]Quote) (bool, error) {
+	for _, symbol := range t.symbols {
+		if _, ok := quotes[symbol]; !ok {
+			return false, nil
+		}
+	}
+	return t.expr.Match(func(name string) (expr.Value, bool) {
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return expr.Value{}, false
+		}
+		return expr.Number(quotes[strings.ToUpper(name[:i])].Field(name[i+1:])), true
+	})
+}
+
+// validField reports whether name is one of Fields.
+func validField(name string) bool {
+	for _, f := range Fields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Tag returns the order tag that identifies a conditional order's submission. Tradier tags
+// allow letters, digits, and dashes.
+func Tag(o Order) string {
+	return fmt.Sprintf("cond-%d-%d", o.ID, o.Created.Unix())
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package conditional
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// quotes is a sample set of quotes keyed by symbol.
+var quotes = map[string]Quote{
+	"SPY":   {Last: 601.5, Bid: 601.4, Ask: 601.6, PrevClose: 590, Volume: 50e6},
+	"VIX":   {Last: 26.2, PrevClose: 22},
+	"BRK.B": {Last: 480, Bid: 479.5, Ask: 480.5},
+}
+
+// TestCompile verifies symbols are collected and malformed triggers are rejected.
+func TestCompile(t *testing.T) {
+	trig, err := Compile("SPY.last > 600 and (vix.last > 25 or brk.b.mid < 400)")
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if want := []string{"BRK.B", "SPY", "VIX"}; !reflect.DeepEqual(trig.Symbols(), want) {
+		t.Errorf("Symbols() = %v, want %v", trig.Symbols(), want)
+	}
+
+	tests := map[string]string{
+		"last > 600":         "SYMBOL.field",
+		"spy.price > 600":    "unknown field",
+		"spy.last >":         "invalid trigger",
+		"1 > 0":              "at least one quote",
+		"abs(spy.bogus) > 1": "unknown field",
+	}
+	for src, want := range tests {
+		if _, err := Compile(src); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Compile(%q) error = %v, want containing %q", src, err, want)
+		}
+	}
+}
+
+// TestEval verifies triggers over several symbols, computed fields, and missing quotes.
+func TestEval(t *testing.T) {
+	tests := map[string]bool{
+		"spy.last > 600":                                   true,
+		"spy.last > 600 and vix.last > 30":                 false,
+		"vix.change_percentage >= 19":                      true,
+		"spy.mid == 601.5 and spy.volume > 10M":            true,
+		"brk.b.mid == 480":                                 true,
+		"vix.mid > 0 or vix.bid > 0":                       false,
+		"spy.last > 600 and qqq.last > 0":                  false,
+		"not (qqq.last > 500)":                             false,
+		"spy.change > 11 and spy.change < 12":              true,
+		"brk.b.change_percentage > -100 or brk.b.high > 0": false,
+	}
+	for src, want := range tests {
+		trig, err := Compile(src)
+		if err != nil {
+			t.Errorf("Compile(%q) error: %v", src, err)
+			continue
+		}
+		got, err := trig.Eval(quotes)
+		if err != nil {
+			t.Errorf("Eval(%q) error: %v", src, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Eval(%q) = %v, want %v", src, got, want)
+		}
+	}
+}
+
+// TestField verifies missing prices are NaN rather than zero.
+func TestField(t *testing.T) {
+	q := Quote{Last: 10, PrevClose: 8}
+	if got := q.Field("change_percentage"); got != 25 {
+		t.Errorf("Field(change_percentage) = %v, want 25", got)
+	}
+	for _, name := range []string{"bid", "mid", "open", "bogus"} {
+		if got := q.Field(name); !math.IsNaN(got) {
+			t.Errorf("Field(%s) = %v, want NaN", name, got)
+		}
+	}
+	if got := (Quote{}).Field("volume"); got != 0 {
+		t.Errorf("Field(volume) = %v, want 0", got)
+	}
+}
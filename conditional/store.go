@@ -0,0 +1,168 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package conditional
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudmanic/tradier/config"
+)
+
+// ordersFile is the file name for conditional orders in the tradier config directory.
+const ordersFile = "conditional_orders.json"
+
+// DefaultPath returns the path of the conditional orders file in the tradier config directory.
+func DefaultPath() (string, error) {
+	dir, err := config.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ordersFile), nil
+}
+
+// Load reads the orders stored at path. A missing file holds no orders.
+func Load(path string) ([]Order, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read conditional orders: %w", err)
+	}
+
+	var orders []Order
+	if err := json.Unmarshal(data, &orders); err != nil {
+		return nil, fmt.Errorf("unable to parse conditional orders: %w", err)
+	}
+	return orders, nil
+}
+
+// Save writes the orders to path, replacing the file atomically.
+func Save(path string, orders []Order) error {
+	if orders == nil {
+		orders = []Order{}
+	}
+	data, err := json.MarshalIndent(orders, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal conditional orders: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create config directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write conditional orders: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("unable to write conditional orders: %w", err)
+	}
+	return nil
+}
+
+// Add appends a pending order with the next unused ID, tagging it with Tag unless the order
+// file set its own tag, and returns the updated orders and the new order.
+func Add(orders []Order, o Order) ([]Order, Order) {
+	o.ID = 0
+	for _, existing := range orders {
+		o.ID = max(o.ID, existing.ID)
+	}
+	o.ID++
+	o.Status = StatusPending
+	if o.Params["tag"] == "" {
+		params := map[string]string{"tag": Tag(o)}
+		for k, v := range o.Params {
+			if k != "tag" {
+				params[k] = v
+			}
+		}
+		o.Params = params
+	}
+	return append(orders, o), o
+}
+
+// Cancel marks a pending order canceled. It fails if the order does not exist or already triggered.
+func Cancel(orders []Order, id int) error {
+	for i := range orders {
+		if orders[i].ID != id {
+			continue
+		}
+		if orders[i].Status != StatusPending {
+			return fmt.Errorf("conditional order %d is already %s", id, orders[i].Status)
+		}
+		orders[i].Status = StatusCanceled
+		return nil
+	}
+	return fmt.Errorf("no conditional order with ID %d", id)
+}
+
+// Claim moves a pending order to submitting and returns it. The caller saves the orders
+// before sending, so that once an order is claimed no later run can send it again. It fails
+// if the order is no longer pending, for example because it was canceled meanwhile.
+func Claim(orders []Order, id int, now time.Time) (Order, error) {
+	for i := range orders {
+		if orders[i].ID != id {
+			continue
+		}
+		if orders[i].Status != StatusPending {
+			return Order{}, fmt.Errorf("conditional order %d is already %s", id, orders[i].Status)
+		}
+		orders[i].Status = StatusSubmitting
+		orders[i].TriggeredAt = now
+		return orders[i], nil
+	}
+	return Order{}, fmt.Errorf("no conditional order with ID %d", id)
+}
+
+// Expire marks pending orders whose expiration has passed as expired and returns them.
+func Expire(orders []Order, now time.Time) []Order {
+	var out []Order
+	for i := range orders {
+		o := &orders[i]
+		if o.Status == StatusPending && !o.Expires.IsZero() && !o.Expires.After(now) {
+			o.Status = StatusExpired
+			out = append(out, *o)
+		}
+	}
+	return out
+}
+
+// Active returns the pending orders for the given environment (sandbox, paper, or production).
+func Active(orders []Order, sandbox, paper bool) []Order {
+	var out []Order
+	for _, o := range orders {
+		if o.Status == StatusPending && o.Sandbox == sandbox && o.Paper == paper {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// Interrupted returns the orders for the given environment left in submitting by a run that
+// stopped while sending them.
+func Interrupted(orders []Order, sandbox, paper bool) []Order {
+	var out []Order
+	for _, o := range orders {
+		if o.Status == StatusSubmitting && o.Sandbox == sandbox && o.Paper == paper {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// Record stores the outcome of a submission on the order with the same ID.
+func Record(orders []Order, outcome Order) {
+	for i := range orders {
+		if orders[i].ID == outcome.ID {
+			orders[i].Status = outcome.Status
+			orders[i].OrderID = outcome.OrderID
+			orders[i].Error = outcome.Error
+		}
+	}
+}
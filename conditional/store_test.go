@@ -0,0 +1,93 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package conditional
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestStore verifies orders round-trip through the file with increasing IDs and unique tags.
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "conditional.json")
+	orders, err := Load(path)
+	if err != nil || len(orders) != 0 {
+		t.Fatalf("Load(missing) = %v, %v, want empty", orders, err)
+	}
+
+	created := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	params := map[string]string{"class": "equity", "symbol": "AAPL"}
+	orders, first := Add(orders, Order{Trigger: "spy.last > 600", Params: params, Created: created})
+	orders, second := Add(orders, Order{ID: 9, Status: StatusFailed, Params: map[string]string{"class": "equity", "tag": "mine"}})
+	if first.ID != 1 || second.ID != 2 || second.Status != StatusPending {
+		t.Errorf("Add() = %+v, %+v", first, second)
+	}
+	if first.Params["tag"] != "cond-1-1792400400" || second.Params["tag"] != "mine" {
+		t.Errorf("Add() tags = %q, %q", first.Params["tag"], second.Params["tag"])
+	}
+	if _, ok := params["tag"]; ok {
+		t.Errorf("Add() modified the caller's params")
+	}
+
+	if err := Save(path, orders); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil || len(loaded) != 2 || loaded[0].Trigger != "spy.last > 600" || loaded[0].Params["symbol"] != "AAPL" {
+		t.Errorf("Load() = %+v, %v", loaded, err)
+	}
+}
+
+// TestClaim verifies an order can be claimed once, and claimed orders cannot be canceled.
+func TestClaim(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	orders := []Order{{ID: 1, Status: StatusPending}, {ID: 2, Status: StatusPending, Sandbox: true}}
+
+	claimed, err := Claim(orders, 1, now)
+	if err != nil || claimed.Status != StatusSubmitting || !orders[0].TriggeredAt.Equal(now) {
+		t.Fatalf("Claim(1) = %+v, %v", claimed, err)
+	}
+	if _, err := Claim(orders, 1, now); err == nil {
+		t.Errorf("Claim(claimed) error = nil, want error")
+	}
+	if _, err := Claim(orders, 7, now); err == nil {
+		t.Errorf("Claim(missing) error = nil, want error")
+	}
+	if err := Cancel(orders, 1); err == nil {
+		t.Errorf("Cancel(submitting) error = nil, want error")
+	}
+	if got := Interrupted(orders, false, false); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("Interrupted() = %+v, want order 1", got)
+	}
+	if got := Active(orders, true, false); len(got) != 1 || got[0].ID != 2 {
+		t.Errorf("Active(sandbox) = %+v, want order 2", got)
+	}
+	if got := Active(orders, false, true); len(got) != 0 {
+		t.Errorf("Active(paper) = %+v, want none", got)
+	}
+
+	Record(orders, Order{ID: 1, Status: StatusSubmitted, OrderID: "12345"})
+	if orders[0].Status != StatusSubmitted || orders[0].OrderID != "12345" || orders[0].TriggeredAt.IsZero() {
+		t.Errorf("Record() = %+v", orders[0])
+	}
+	if err := Cancel(orders, 2); err != nil || orders[1].Status != StatusCanceled {
+		t.Errorf("Cancel(2) = %v, status %s", err, orders[1].Status)
+	}
+}
+
+// TestExpire verifies only pending orders past their expiration expire.
+func TestExpire(t *testing.T) {
+	now := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	orders := []Order{
+		{ID: 1, Status: StatusPending, Expires: now.Add(-time.Minute)},
+		{ID: 2, Status: StatusPending, Expires: now.Add(time.Minute)},
+		{ID: 3, Status: StatusPending},
+		{ID: 4, Status: StatusCanceled, Expires: now.Add(-time.Hour)},
+	}
+	expired := Expire(orders, now)
+	if len(expired) != 1 || expired[0].ID != 1 || orders[0].Status != StatusExpired || orders[3].Status != StatusCanceled {
+		t.Errorf("Expire() = %+v, orders %+v", expired, orders)
+	}
+}
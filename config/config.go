@@ -32,6 +32,9 @@ type Config struct {
 	ProductionAccountID string `json:"production_account_id"`
 	SandboxAPIKey       string `json:"sandbox_api_key"`
 	SandboxAccountID    string `json:"sandbox_account_id"`
+
+	// Journal records every order placed, changed, or canceled in the local trade journal.
+	Journal bool `json:"journal,omitempty"`
 }
 
 // ConfigDirPath returns the full path to the tradier configuration directory.
@@ -0,0 +1,571 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package expr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The language is a small filter syntax over named fields:
+//
+//	change_percentage > 3 and volume > 1M
+//	(last - prevclose) / prevclose * 100 >= 2 or symbol == "SPY"
+//	not (rsi_14 > 70) && abs(change) > 1.5
+//
+// Field names may be dotted, such as spy.last, so an Env can address fields of several records.
+// Numbers may carry a K, M, or B suffix. Comparisons and logical operators produce 1 or 0,
+// and any non-zero number is true. Comparisons involving a missing (NaN) value are false.
+
+// Value is a number or a string.
+type Value struct {
+	Num   float64
+	Str   string
+	IsStr bool
+}
+
+// Number returns a numeric value.
+func Number(f float64) Value {
+	return Value{Num: f}
+}
+
+// String returns a string value.
+func String(s string) Value {
+	return Value{Str: s, IsStr: true}
+}
+
+// Env resolves a field name to its value. It returns false for names it does not know.
+type Env func(name string) (Value, bool)
+
+// Expr is a parsed expression.
+type Expr struct {
+	src  string
+	root node
+}
+
+// node is one element of the syntax tree.
+type node interface {
+	eval(env Env) (Value, error)
+}
+
+// Parse compiles an expression, returning an error that points at the offending token.
+func Parse(s string) (*Expr, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos+1)
+	}
+	return &Expr{src: s, root: root}, nil
+}
+
+// String returns the source text of the expression.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the expression against the environment.
+func (e *Expr) Eval(env Env) (Value, error) {
+	return e.root.eval(env)
+}
+
+// Match evaluates the expression and reports whether the result is true.
+func (e *Expr) Match(env Env) (bool, error) {
+	v, err := e.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// Idents returns the distinct field names the expression refers to, sorted.
+func (e *Expr) Idents() []string {
+	seen := map[string]bool{}
+	collectIdents(e.root, seen)
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// collectIdents walks the tree recording every identifier.
+func collectIdents(n node, seen map[string]bool) {
+	switch n := n.(type) {
+	case identNode:
+		seen[string(n)] = true
+	case unaryNode:
+		collectIdents(n.x, seen)
+	case binaryNode:
+		collectIdents(n.l, seen)
+		collectIdents(n.r, seen)
+	case callNode:
+		for _, a := range n.args {
+			collectIdents(a, seen)
+		}
+	}
+}
+
+// truthy reports whether a value counts as true: non-empty strings and non-zero numbers.
+func truthy(v Value) bool {
+	if v.IsStr {
+		return v.Str != ""
+	}
+	return v.Num != 0 && !math.IsNaN(v.Num)
+}
+
+// boolValue converts a boolean to 1 or 0.
+func boolValue(b bool) Value {
+	if b {
+		return Number(1)
+	}
+	return Number(0)
+}
+
+// numberNode is a numeric literal.
+type numberNode float64
+
+// eval implements node.
+func (n numberNode) eval(Env) (Value, error) {
+	return Number(float64(n)), nil
+}
+
+// stringNode is a quoted string literal.
+type stringNode string
+
+// eval implements node.
+func (n stringNode) eval(Env) (Value, error) {
+	return String(string(n)), nil
+}
+
+// identNode is a field reference.
+type identNode string
+
+// eval implements node.
+func (n identNode) eval(env Env) (Value, error) {
+	if env != nil {
+		if v, ok := env(string(n)); ok {
+			return v, nil
+		}
+	}
+	return Value{}, fmt.Errorf("unknown field %q", string(n))
+}
+
+// unaryNode is negation or logical not.
+type unaryNode struct {
+	op string
+	x  node
+}
+
+// eval implements node.
+func (n unaryNode) eval(env Env) (Value, error) {
+	v, err := n.x.eval(env)
+	if err != nil {
+		return Value{}, err
+	}
+	if n.op == "not" {
+		return boolValue(!truthy(v)), nil
+	}
+	if v.IsStr {
+		return Value{}, fmt.Errorf("cannot negate string %q", v.Str)
+	}
+	return Number(-v.Num), nil
+}
+
+// binaryNode is an arithmetic, comparison, or logical operation.
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+// eval implements node.
+func (n binaryNode) eval(env Env) (Value, error) {
+	l, err := n.l.eval(env)
+	if err != nil {
+		return Value{}, err
+	}
+
+	// Logical operators short-circuit
+	switch n.op {
+	case "and":
+		if !truthy(l) {
+			return Number(0), nil
+		}
+		r, err := n.r.eval(env)
+		return boolValue(truthy(r)), err
+	case "or":
+		if truthy(l) {
+			return Number(1), nil
+		}
+		r, err := n.r.eval(env)
+		return boolValue(truthy(r)), err
+	}
+
+	r, err := n.r.eval(env)
+	if err != nil {
+		return Value{}, err
+	}
+
+	if l.IsStr || r.IsStr {
+		if !l.IsStr || !r.IsStr {
+			return Value{}, fmt.Errorf("cannot compare a string and a number with %s", n.op)
+		}
+		switch n.op {
+		case "==":
+			return boolValue(strings.EqualFold(l.Str, r.Str)), nil
+		case "!=":
+			return boolValue(!strings.EqualFold(l.Str, r.Str)), nil
+		}
+		return Value{}, fmt.Errorf("operator %s is not supported for strings", n.op)
+	}
+
+	a, b := l.Num, r.Num
+	switch n.op {
+	case "+":
+		return Number(a + b), nil
+	case "-":
+		return Number(a - b), nil
+	case "*":
+		return Number(a * b), nil
+	case "/":
+		if b == 0 {
+			return Number(math.NaN()), nil
+		}
+		return Number(a / b), nil
+	case ">":
+		return boolValue(a > b), nil
+	case ">=":
+		return boolValue(a >= b), nil
+	case "<":
+		return boolValue(a < b), nil
+	case "<=":
+		return boolValue(a <= b), nil
+	case "==":
+		return boolValue(a == b), nil
+	case "!=":
+		return boolValue(a != b && !math.IsNaN(a) && !math.IsNaN(b)), nil
+	}
+	return Value{}, fmt.Errorf("unknown operator %s", n.op)
+}
+
+// functions are the built-in numeric functions and their argument counts (-1 for one or more).
+var functions = map[string]int{"abs": 1, "min": -1, "max": -1, "floor": 1, "round": 1}
+
+// callNode is a built-in function call.
+type callNode struct {
+	name string
+	args []node
+}
+
+// eval implements node.
+func (n callNode) eval(env Env) (Value, error) {
+	nums := make([]float64, len(n.args))
+	for i, a := range n.args {
+		v, err := a.eval(env)
+		if err != nil {
+			return Value{}, err
+		}
+		if v.IsStr {
+			return Value{}, fmt.Errorf("%s() expects numbers", n.name)
+		}
+		nums[i] = v.Num
+	}
+	switch n.name {
+	case "abs":
+		return Number(math.Abs(nums[0])), nil
+	case "floor":
+		return Number(math.Floor(nums[0])), nil
+	case "round":
+		return Number(math.Round(nums[0])), nil
+	case "min":
+		out := nums[0]
+		for _, v := range nums[1:] {
+			out = math.Min(out, v)
+		}
+		return Number(out), nil
+	case "max":
+		out := nums[0]
+		for _, v := range nums[1:] {
+			out = math.Max(out, v)
+		}
+		return Number(out), nil
+	}
+	return Value{}, fmt.Errorf("unknown function %s()", n.name)
+}
+
+// tokKind classifies a token.
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+// token is one lexical element with its position in the source.
+type token struct {
+	kind tokKind
+	text string
+	num  float64
+	pos  int
+}
+
+// keywordOps maps word operators to their canonical form.
+var keywordOps = map[string]string{"and": "and", "or": "or", "not": "not"}
+
+// symbolOps maps symbolic operators to their canonical form, longest first when matching.
+var symbolOps = []struct{ text, op string }{
+	{">=", ">="}, {"<=", "<="}, {"==", "=="}, {"!=", "!="}, {"&&", "and"}, {"||", "or"},
+	{">", ">"}, {"<", "<"}, {"=", "=="}, {"!", "not"},
+	{"+", "+"}, {"-", "-"}, {"*", "*"}, {"/", "/"}, {"(", "("}, {")", ")"}, {",", ","},
+}
+
+// suffixes scale numeric literals such as 1.5M.
+var suffixes = map[byte]float64{'k': 1e3, 'K': 1e3, 'm': 1e6, 'M': 1e6, 'b': 1e9, 'B': 1e9}
+
+// lex splits the source into tokens.
+func lex(s string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+				i++
+			}
+			f, err := strconv.ParseFloat(s[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", s[start:i], start+1)
+			}
+			if i < len(s) && suffixes[s[i]] != 0 && (i+1 == len(s) || !isIdentChar(rune(s[i+1]))) {
+				f *= suffixes[s[i]]
+				i++
+			}
+			toks = append(toks, token{kind: tokNumber, text: s[start:i], num: f, pos: start})
+
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			toks = append(toks, token{kind: tokString, text: s[i+1 : i+1+end], pos: i})
+			i += end + 2
+
+		case isIdentChar(rune(c)):
+			start := i
+			for i < len(s) && (isIdentChar(rune(s[i])) || s[i] == '.' && i+1 < len(s) && isIdentChar(rune(s[i+1]))) {
+				i++
+			}
+			word := s[start:i]
+			if op, ok := keywordOps[strings.ToLower(word)]; ok {
+				toks = append(toks, token{kind: tokOp, text: op, pos: start})
+			} else {
+				toks = append(toks, token{kind: tokIdent, text: strings.ToLower(word), pos: start})
+			}
+
+		default:
+			matched := false
+			for _, op := range symbolOps {
+				if strings.HasPrefix(s[i:], op.text) {
+					toks = append(toks, token{kind: tokOp, text: op.op, pos: i})
+					i += len(op.text)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+		}
+	}
+	return append(toks, token{kind: tokEOF, pos: len(s)}), nil
+}
+
+// isIdentChar reports whether r can appear in a field name.
+func isIdentChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// parser is a recursive descent parser over the token stream.
+type parser struct {
+	toks []token
+	pos  int
+}
+
+// peek returns the next token without consuming it.
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+// accept consumes the next token if it is one of the given operators.
+func (p *parser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+// parseOr parses a chain of "or" operations.
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "or")
+}
+
+// parseAnd parses a chain of "and" operations.
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseNot, "and")
+}
+
+// parseNot parses an optional "not" prefix.
+func (p *parser) parseNot() (node, error) {
+	if _, ok := p.accept("not"); ok {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "not", x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+// parseCompare parses a single, non-chained comparison.
+func (p *parser) parseCompare() (node, error) {
+	l, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if op, ok := p.accept(">", ">=", "<", "<=", "==", "!="); ok {
+		r, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{op: op, l: l, r: r}, nil
+	}
+	return l, nil
+}
+
+// parseSum parses addition and subtraction.
+func (p *parser) parseSum() (node, error) {
+	return p.parseBinary(p.parseProduct, "+", "-")
+}
+
+// parseProduct parses multiplication and division.
+func (p *parser) parseProduct() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+// parseBinary parses a left-associative chain of the given operators.
+func (p *parser) parseBinary(next func() (node, error), ops ...string) (node, error) {
+	l, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(ops...)
+		if !ok {
+			return l, nil
+		}
+		r, err := next()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op: op, l: l, r: r}
+	}
+}
+
+// parseUnary parses an optional leading minus.
+func (p *parser) parseUnary() (node, error) {
+	if _, ok := p.accept("-"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "-", x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a literal, field, function call, or parenthesised expression.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokNumber:
+		p.pos++
+		return numberNode(tok.num), nil
+	case tokString:
+		p.pos++
+		return stringNode(tok.text), nil
+	case tokIdent:
+		p.pos++
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(tok)
+		}
+		return identNode(tok.text), nil
+	case tokOp:
+		if tok.text == "(" {
+			p.pos++
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.accept(")"); !ok {
+				return nil, fmt.Errorf("missing ) at position %d", p.peek().pos+1)
+			}
+			return x, nil
+		}
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos+1)
+}
+
+// parseCall parses the arguments of a function call after its opening parenthesis.
+func (p *parser) parseCall(name token) (node, error) {
+	want, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s() at position %d", name.text, name.pos+1)
+	}
+	var args []node
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if _, ok := p.accept(","); !ok {
+			break
+		}
+	}
+	if _, ok := p.accept(")"); !ok {
+		return nil, fmt.Errorf("missing ) at position %d", p.peek().pos+1)
+	}
+	if want > 0 && len(args) != want {
+		return nil, fmt.Errorf("%s() takes %d argument(s), got %d", name.text, want, len(args))
+	}
+	return callNode{name: name.text, args: args}, nil
+}
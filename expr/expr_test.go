@@ -0,0 +1,138 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package expr
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// quoteEnv is a sample quote used as the evaluation environment.
+func quoteEnv(name string) (Value, bool) {
+	fields := map[string]Value{
+		"symbol":            String("AAPL"),
+		"last":              Number(250),
+		"prevclose":         Number(240),
+		"change":            Number(10),
+		"change_percentage": Number(4.17),
+		"volume":            Number(2500000),
+		"rsi_14":            Number(math.NaN()),
+	}
+	v, ok := fields[name]
+	return v, ok
+}
+
+// TestMatch verifies operators, precedence, suffixes, strings, and functions.
+func TestMatch(t *testing.T) {
+	tests := map[string]bool{
+		"change_percentage > 3 and volume > 1000000":        true,
+		"change_percentage > 3 AND volume > 5M":             false,
+		"volume >= 2.5m":                                    true,
+		"(last - prevclose) / prevclose * 100 >= 4":         true,
+		"last - prevclose * 2 > 0":                          false,
+		"symbol == 'aapl' && !(change < 0)":                 true,
+		`symbol != "AAPL" || last = 250`:                    true,
+		"not change > 50 or volume < 1":                     true,
+		"abs(-change) == 10 and max(1, last, 3) == 250":     true,
+		"min(last, prevclose) == 240":                       true,
+		"floor(last / 3) == 83 and round(2.5) == 3":         true,
+		"rsi_14 > 70 or rsi_14 < 30 or rsi_14 == 50":        false,
+		"rsi_14 != 50":                                      false,
+		"-change < 0":                                       true,
+		"last / 0 > 1":                                      false,
+		"change_percentage > 3 and (volume > 1M or last>1)": true,
+	}
+	for src, want := range tests {
+		e, err := Parse(src)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", src, err)
+			continue
+		}
+		got, err := e.Match(quoteEnv)
+		if err != nil {
+			t.Errorf("Match(%q) error: %v", src, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Match(%q) = %v, want %v", src, got, want)
+		}
+	}
+}
+
+// TestParseErrors verifies malformed expressions are rejected with a useful position.
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"":                  "unexpected end",
+		"last >":            "unexpected end",
+		"last > 3 )":        "position 10",
+		"(last > 3":         "missing )",
+		"last $ 3":          "position 6",
+		"'open":             "unterminated",
+		"foo(1)":            "unknown function",
+		"abs(1, 2)":         "takes 1",
+		"last > 3 volume":   "unexpected",
+		"1.2.3 > 0":         "invalid number",
+		"last > 1 and or 2": "unexpected",
+	}
+	for src, want := range tests {
+		_, err := Parse(src)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) error = %v, want containing %q", src, err, want)
+		}
+	}
+}
+
+// TestEvalErrors verifies unknown fields and mixed string/number comparisons fail at evaluation.
+func TestEvalErrors(t *testing.T) {
+	for _, src := range []string{"bogus > 1", "symbol > 1", "symbol > 'A'", "-symbol == 1"} {
+		e, err := Parse(src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", src, err)
+		}
+		if _, err := e.Match(quoteEnv); err == nil {
+			t.Errorf("Match(%q) error = nil, want error", src)
+		}
+	}
+
+	// Short-circuiting skips the unknown field
+	e, _ := Parse("last < 0 and bogus > 1")
+	if ok, err := e.Match(quoteEnv); ok || err != nil {
+		t.Errorf("Match(short circuit) = %v, %v, want false, nil", ok, err)
+	}
+}
+
+// TestDottedIdents verifies field names may contain dots between identifier characters.
+func TestDottedIdents(t *testing.T) {
+	e, err := Parse("SPY.last > 600 and brk.b.bid >= 1.5 and VIX.last < .2K")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	want := []string{"brk.b.bid", "spy.last", "vix.last"}
+	if got := e.Idents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Idents() = %v, want %v", got, want)
+	}
+
+	for _, src := range []string{"spy. last > 1", "spy.last. > 1"} {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", src)
+		}
+	}
+}
+
+// TestIdents verifies every referenced field is reported once, sorted, and lowercased.
+func TestIdents(t *testing.T) {
+	e, err := Parse("Volume > 1M and (sma_50 > sma_200 or abs(change) > volume)")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	want := []string{"change", "sma_200", "sma_50", "volume"}
+	if got := e.Idents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Idents() = %v, want %v", got, want)
+	}
+	if e.String() != "Volume > 1M and (sma_50 > sma_200 or abs(change) > volume)" {
+		t.Errorf("String() = %q", e.String())
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+// Package filelock serializes read-modify-write cycles on the CLI's state files across
+// processes, such as a daemon and an interactive command changing the same file.
+package filelock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Timeout bounds how long Lock waits for another process to release a file, and Stale is the
+// age after which a lock left by a crashed process is broken.
+const (
+	Timeout = 10 * time.Second
+	Stale   = time.Minute
+)
+
+// Lock takes an exclusive lock on the file at path, held as a path.lock file beside it, and
+// returns the function that releases it. Every process that changes the file must hold the
+// lock from before it reads the file until after it writes it back.
+func Lock(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("unable to create config directory: %w", err)
+	}
+	name := path + ".lock"
+	deadline := time.Now().Add(Timeout)
+	for {
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(name) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("unable to lock %s: %w", filepath.Base(path), err)
+		}
+		if info, err := os.Stat(name); err == nil && time.Since(info.ModTime()) > Stale {
+			os.Remove(name)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s is locked by another process (remove %s if none is running)", filepath.Base(path), name)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package filelock
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestLock verifies the lock excludes a second holder until released and breaks a stale lock.
+func TestLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "state.json")
+	unlock, err := Lock(path)
+	if err != nil {
+		t.Fatalf("Lock() error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	acquired := make(chan time.Time, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		second, err := Lock(path)
+		if err != nil {
+			t.Errorf("second Lock() error: %v", err)
+			return
+		}
+		acquired <- time.Now()
+		second()
+	}()
+	time.Sleep(200 * time.Millisecond)
+	released := time.Now()
+	unlock()
+	wg.Wait()
+	if got := <-acquired; got.Before(released) {
+		t.Errorf("second Lock() acquired at %v, before the first was released at %v", got, released)
+	}
+
+	// A lock left behind by a crashed process is broken once stale
+	if err := os.WriteFile(path+".lock", nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * Stale)
+	if err := os.Chtimes(path+".lock", old, old); err != nil {
+		t.Fatal(err)
+	}
+	unlock, err = Lock(path)
+	if err != nil {
+		t.Fatalf("Lock(stale) error: %v", err)
+	}
+	unlock()
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file left after unlock: %v", err)
+	}
+}
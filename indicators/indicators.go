@@ -0,0 +1,338 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package indicators
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudmanic/tradier/bars"
+)
+
+// Every indicator returns a slice the same length as its input, with NaN for the warm-up
+// periods where there is not yet enough data to compute a value.
+
+// Spec is one requested indicator and its numeric parameters, e.g. bbands:20:2.
+type Spec struct {
+	Name   string
+	Params []float64
+}
+
+// Column is a named series of indicator values aligned with the input bars.
+type Column struct {
+	Name   string
+	Values []float64
+}
+
+// defaults are the parameters used for each indicator when none are given.
+var defaults = map[string][]float64{
+	"sma":    {20},
+	"ema":    {20},
+	"rsi":    {14},
+	"macd":   {12, 26, 9},
+	"bbands": {20, 2},
+	"atr":    {14},
+	"vwap":   {},
+}
+
+// ParseSpecs parses a comma-separated indicator list such as "sma:20,ema:50,macd,bbands:20:2".
+// Missing parameters take each indicator's defaults.
+func ParseSpecs(s string) ([]Spec, error) {
+	var specs []Spec
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(strings.ToLower(item))
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		def, ok := defaults[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown indicator %q (supported: sma, ema, rsi, macd, bbands, atr, vwap)", parts[0])
+		}
+		if len(parts)-1 > len(def) {
+			return nil, fmt.Errorf("too many parameters for %s", parts[0])
+		}
+
+		spec := Spec{Name: parts[0], Params: append([]float64(nil), def...)}
+		for i, p := range parts[1:] {
+			v, err := strconv.ParseFloat(p, 64)
+			if err != nil || v <= 0 {
+				return nil, fmt.Errorf("invalid parameter %q for %s", p, parts[0])
+			}
+			spec.Params[i] = v
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no indicators given")
+	}
+	return specs, nil
+}
+
+// Compute evaluates the spec over the bars and returns one or more named columns.
+func Compute(spec Spec, b []bars.Bar) []Column {
+	closes := bars.Closes(b)
+	p := spec.Params
+	label := func(prefix string) string {
+		parts := []string{prefix}
+		for _, v := range p {
+			parts = append(parts, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		return strings.Join(parts, "_")
+	}
+
+	switch spec.Name {
+	case "sma":
+		return []Column{{label("sma"), SMA(closes, int(p[0]))}}
+	case "ema":
+		return []Column{{label("ema"), EMA(closes, int(p[0]))}}
+	case "rsi":
+		return []Column{{label("rsi"), RSI(closes, int(p[0]))}}
+	case "macd":
+		line, signal, hist := MACD(closes, int(p[0]), int(p[1]), int(p[2]))
+		// The default parameters keep the plain names; others carry them, e.g. macd_5_35_5
+		name := label
+		if slices.Equal(p, defaults["macd"]) {
+			name = func(prefix string) string { return prefix }
+		}
+		return []Column{{name("macd"), line}, {name("macd_signal"), signal}, {name("macd_hist"), hist}}
+	case "bbands":
+		upper, mid, lower := BollingerBands(closes, int(p[0]), p[1])
+		return []Column{{label("bb_upper"), upper}, {label("bb_mid"), mid}, {label("bb_lower"), lower}}
+	case "atr":
+		return []Column{{label("atr"), ATR(b, int(p[0]))}}
+	case "vwap":
+		return []Column{{"vwap", VWAP(b)}}
+	}
+	return nil
+}
+
+// SpecForColumn returns the spec that produces a column name such as sma_50, bb_upper_20_2,
+// macd_signal, or macd_hist_5_35_5, so expressions can refer to indicators by column.
+func SpecForColumn(name string) (Spec, bool) {
+	name = strings.ToLower(name)
+	spec := strings.SplitN(name, "_", 2)[0]
+	switch {
+	case spec == "bb":
+		parts := strings.SplitN(name, "_", 3)
+		if len(parts) < 3 {
+			return Spec{}, false
+		}
+		spec = "bbands:" + strings.ReplaceAll(parts[2], "_", ":")
+	case spec == "macd":
+		params := strings.TrimPrefix(name, "macd")
+		params = strings.TrimPrefix(strings.TrimPrefix(params, "_signal"), "_hist")
+		spec = "macd" + strings.ReplaceAll(params, "_", ":")
+	case spec == "vwap":
+	default:
+		spec = strings.ReplaceAll(name, "_", ":")
+	}
+
+	specs, err := ParseSpecs(spec)
+	if err != nil || len(specs) != 1 {
+		return Spec{}, false
+	}
+	for _, col := range Compute(specs[0], nil) {
+		if col.Name == name {
+			return specs[0], true
+		}
+	}
+	return Spec{}, false
+}
+
+// Lookback returns the number of bars needed before the spec's values are reliable. Exponential
+// and Wilder smoothing need several periods to forget their seed.
+func Lookback(spec Spec) int {
+	p := spec.Params
+	switch spec.Name {
+	case "sma", "bbands":
+		return int(p[0])
+	case "ema", "rsi", "atr":
+		return 3 * int(p[0])
+	case "macd":
+		return 3*int(math.Max(p[0], p[1])) + int(p[2])
+	}
+	return 1
+}
+
+// SMA returns the simple moving average over n periods.
+func SMA(values []float64, n int) []float64 {
+	out := nans(len(values))
+	if n <= 0 {
+		return out
+	}
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= n {
+			sum -= values[i-n]
+		}
+		if i >= n-1 {
+			out[i] = sum / float64(n)
+		}
+	}
+	return out
+}
+
+// EMA returns the exponential moving average over n periods, seeded with the SMA of the first n values.
+// Leading NaNs in values (from another indicator's warm-up) are skipped before seeding.
+func EMA(values []float64, n int) []float64 {
+	out := nans(len(values))
+	if n <= 0 {
+		return out
+	}
+	start := 0
+	for start < len(values) && math.IsNaN(values[start]) {
+		start++
+	}
+	if len(values)-start < n {
+		return out
+	}
+
+	k := 2 / float64(n+1)
+	seed := 0.0
+	for _, v := range values[start : start+n] {
+		seed += v
+	}
+	prev := seed / float64(n)
+	out[start+n-1] = prev
+	for i := start + n; i < len(values); i++ {
+		prev = values[i]*k + prev*(1-k)
+		out[i] = prev
+	}
+	return out
+}
+
+// RSI returns Wilder's relative strength index over n periods.
+func RSI(values []float64, n int) []float64 {
+	out := nans(len(values))
+	if n <= 0 || len(values) <= n {
+		return out
+	}
+
+	var gain, loss float64
+	for i := 1; i <= n; i++ {
+		change := values[i] - values[i-1]
+		gain += math.Max(change, 0)
+		loss += math.Max(-change, 0)
+	}
+	gain /= float64(n)
+	loss /= float64(n)
+	out[n] = rsiValue(gain, loss)
+
+	for i := n + 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		gain = (gain*float64(n-1) + math.Max(change, 0)) / float64(n)
+		loss = (loss*float64(n-1) + math.Max(-change, 0)) / float64(n)
+		out[i] = rsiValue(gain, loss)
+	}
+	return out
+}
+
+// rsiValue converts average gain and loss to an RSI between 0 and 100.
+func rsiValue(gain, loss float64) float64 {
+	if loss == 0 {
+		if gain == 0 {
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+// MACD returns the MACD line (fast EMA minus slow EMA), its signal-period EMA, and the histogram.
+func MACD(values []float64, fast, slow, signal int) ([]float64, []float64, []float64) {
+	fastEMA, slowEMA := EMA(values, fast), EMA(values, slow)
+	line := nans(len(values))
+	for i := range values {
+		if !math.IsNaN(fastEMA[i]) && !math.IsNaN(slowEMA[i]) {
+			line[i] = fastEMA[i] - slowEMA[i]
+		}
+	}
+	sig := EMA(line, signal)
+	hist := nans(len(values))
+	for i := range values {
+		if !math.IsNaN(line[i]) && !math.IsNaN(sig[i]) {
+			hist[i] = line[i] - sig[i]
+		}
+	}
+	return line, sig, hist
+}
+
+// BollingerBands returns the upper band, n-period SMA, and lower band, with bands k population
+// standard deviations from the average.
+func BollingerBands(values []float64, n int, k float64) ([]float64, []float64, []float64) {
+	mid := SMA(values, n)
+	upper, lower := nans(len(values)), nans(len(values))
+	for i := n - 1; i < len(values) && n > 0; i++ {
+		variance := 0.0
+		for _, v := range values[i-n+1 : i+1] {
+			variance += (v - mid[i]) * (v - mid[i])
+		}
+		sd := math.Sqrt(variance / float64(n))
+		upper[i] = mid[i] + k*sd
+		lower[i] = mid[i] - k*sd
+	}
+	return upper, mid, lower
+}
+
+// ATR returns Wilder's average true range over n periods.
+func ATR(b []bars.Bar, n int) []float64 {
+	out := nans(len(b))
+	if n <= 0 || len(b) < n {
+		return out
+	}
+
+	tr := make([]float64, len(b))
+	for i, bar := range b {
+		tr[i] = bar.High - bar.Low
+		if i > 0 {
+			prev := b[i-1].Close
+			tr[i] = math.Max(tr[i], math.Max(math.Abs(bar.High-prev), math.Abs(bar.Low-prev)))
+		}
+	}
+
+	atr := 0.0
+	for _, v := range tr[:n] {
+		atr += v
+	}
+	atr /= float64(n)
+	out[n-1] = atr
+	for i := n; i < len(b); i++ {
+		atr = (atr*float64(n-1) + tr[i]) / float64(n)
+		out[i] = atr
+	}
+	return out
+}
+
+// VWAP returns the cumulative volume-weighted average of the typical price (high+low+close)/3.
+// Intraday bars reset at the start of each trading day; daily bars accumulate over the whole series.
+func VWAP(b []bars.Bar) []float64 {
+	out := nans(len(b))
+	intraday := bars.Intraday(b)
+	var pv, vol float64
+	for i, bar := range b {
+		if intraday && i > 0 && bar.Time.YearDay() != b[i-1].Time.YearDay() {
+			pv, vol = 0, 0
+		}
+		pv += (bar.High + bar.Low + bar.Close) / 3 * bar.Volume
+		vol += bar.Volume
+		if vol > 0 {
+			out[i] = pv / vol
+		}
+	}
+	return out
+}
+
+// nans returns a slice of n NaN values.
+func nans(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package indicators
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+)
+
+// near reports whether two floats are within a small tolerance.
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+// TestSMA verifies the warm-up NaNs and the rolling average.
+func TestSMA(t *testing.T) {
+	got := SMA([]float64{1, 2, 3, 4, 5}, 3)
+	if !math.IsNaN(got[0]) || !math.IsNaN(got[1]) {
+		t.Errorf("SMA warm-up = %v, want NaN", got[:2])
+	}
+	for i, want := range map[int]float64{2: 2, 3: 3, 4: 4} {
+		if !near(got[i], want) {
+			t.Errorf("SMA[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+// TestEMA verifies the SMA seed and the exponential smoothing that follows.
+func TestEMA(t *testing.T) {
+	got := EMA([]float64{1, 2, 3, 4, 5}, 3)
+	// Seed is 2, k is 0.5: 4*0.5+2*0.5 = 3, then 5*0.5+3*0.5 = 4
+	if !math.IsNaN(got[1]) || !near(got[2], 2) || !near(got[3], 3) || !near(got[4], 4) {
+		t.Errorf("EMA() = %v, want [NaN NaN 2 3 4]", got)
+	}
+
+	skipped := EMA([]float64{math.NaN(), 2, 4}, 2)
+	if !near(skipped[2], 3) {
+		t.Errorf("EMA(leading NaN)[2] = %v, want 3", skipped[2])
+	}
+}
+
+// TestRSI verifies all-gain, all-loss, and flat series.
+func TestRSI(t *testing.T) {
+	up := RSI([]float64{1, 2, 3, 4, 5}, 3)
+	if !math.IsNaN(up[2]) || !near(up[3], 100) || !near(up[4], 100) {
+		t.Errorf("RSI(rising) = %v, want 100 after warm-up", up)
+	}
+	down := RSI([]float64{5, 4, 3, 2}, 3)
+	if !near(down[3], 0) {
+		t.Errorf("RSI(falling)[3] = %v, want 0", down[3])
+	}
+	flat := RSI([]float64{2, 2, 2, 2}, 3)
+	if !near(flat[3], 50) {
+		t.Errorf("RSI(flat)[3] = %v, want 50", flat[3])
+	}
+
+	// Gains of 1 and 1 and a loss of 1: avg gain 2/3, avg loss 1/3, RS 2
+	mixed := RSI([]float64{10, 11, 12, 11}, 3)
+	if !near(mixed[3], 100-100/3.0) {
+		t.Errorf("RSI(mixed)[3] = %v, want %v", mixed[3], 100-100/3.0)
+	}
+}
+
+// TestMACD verifies the line is fast minus slow EMA and the histogram is line minus signal.
+func TestMACD(t *testing.T) {
+	values := make([]float64, 60)
+	for i := range values {
+		values[i] = 100 + float64(i) + 3*math.Sin(float64(i)/4)
+	}
+	line, signal, hist := MACD(values, 12, 26, 9)
+	fast, slow := EMA(values, 12), EMA(values, 26)
+
+	if !math.IsNaN(line[24]) || math.IsNaN(line[25]) {
+		t.Errorf("MACD line warm-up ends at wrong index: %v, %v", line[24], line[25])
+	}
+	if !math.IsNaN(signal[32]) || math.IsNaN(signal[33]) {
+		t.Errorf("MACD signal warm-up ends at wrong index: %v, %v", signal[32], signal[33])
+	}
+	for i := 33; i < len(values); i++ {
+		if !near(line[i], fast[i]-slow[i]) || !near(hist[i], line[i]-signal[i]) {
+			t.Fatalf("MACD[%d] = %v, %v, %v", i, line[i], signal[i], hist[i])
+		}
+	}
+}
+
+// TestBollingerBands verifies the bands sit k population standard deviations from the mean.
+func TestBollingerBands(t *testing.T) {
+	upper, mid, lower := BollingerBands([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 8, 2)
+	// Mean 5, population standard deviation 2
+	if !near(mid[7], 5) || !near(upper[7], 9) || !near(lower[7], 1) {
+		t.Errorf("BollingerBands() = %v, %v, %v, want 9, 5, 1", upper[7], mid[7], lower[7])
+	}
+	if !math.IsNaN(upper[6]) {
+		t.Errorf("BollingerBands warm-up = %v, want NaN", upper[6])
+	}
+}
+
+// TestATR verifies the true range uses the previous close across gaps.
+func TestATR(t *testing.T) {
+	b := []bars.Bar{
+		{High: 11, Low: 9, Close: 10},
+		{High: 12, Low: 10, Close: 11},
+		{High: 16, Low: 14, Close: 15}, // gap up: true range is 16-11 = 5
+		{High: 15, Low: 14, Close: 14},
+	}
+	got := ATR(b, 3)
+	// Initial ATR (2+2+5)/3 = 3, then (3*2+1)/3
+	if !math.IsNaN(got[1]) || !near(got[2], 3) || !near(got[3], 7.0/3) {
+		t.Errorf("ATR() = %v, want [NaN NaN 3 2.333]", got)
+	}
+}
+
+// TestVWAP verifies the cumulative average and the reset at each new intraday session.
+func TestVWAP(t *testing.T) {
+	at := func(day, minute int) time.Time {
+		return time.Date(2026, 10, day, 9, 30+minute, 0, 0, time.UTC)
+	}
+	b := []bars.Bar{
+		{Time: at(15, 0), High: 10, Low: 10, Close: 10, Volume: 100},
+		{Time: at(15, 1), High: 20, Low: 20, Close: 20, Volume: 300},
+		{Time: at(16, 0), High: 30, Low: 30, Close: 30, Volume: 50},
+	}
+	got := VWAP(b)
+	if !near(got[0], 10) || !near(got[1], 17.5) || !near(got[2], 30) {
+		t.Errorf("VWAP(intraday) = %v, want [10 17.5 30]", got)
+	}
+
+	daily := []bars.Bar{
+		{Time: at(15, 0), High: 10, Low: 10, Close: 10, Volume: 100},
+		{Time: at(16, 0), High: 20, Low: 20, Close: 20, Volume: 100},
+	}
+	if got := VWAP(daily); !near(got[1], 15) {
+		t.Errorf("VWAP(daily)[1] = %v, want 15", got[1])
+	}
+}
+
+// TestParseSpecs verifies defaults, explicit parameters, and invalid input.
+func TestParseSpecs(t *testing.T) {
+	specs, err := ParseSpecs("sma:20, EMA:50,macd,bbands:20:2,vwap")
+	if err != nil {
+		t.Fatalf("ParseSpecs() error: %v", err)
+	}
+	if len(specs) != 5 || specs[1].Name != "ema" || specs[1].Params[0] != 50 {
+		t.Errorf("ParseSpecs() = %+v", specs)
+	}
+	if p := specs[2].Params; len(p) != 3 || p[0] != 12 || p[1] != 26 || p[2] != 9 {
+		t.Errorf("ParseSpecs(macd).Params = %v, want [12 26 9]", p)
+	}
+
+	for _, bad := range []string{"", "foo:3", "sma:x", "sma:0", "rsi:14:2"} {
+		if _, err := ParseSpecs(bad); err == nil {
+			t.Errorf("ParseSpecs(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+// TestCompute verifies column naming for each indicator.
+func TestCompute(t *testing.T) {
+	b := make([]bars.Bar, 30)
+	for i := range b {
+		b[i] = bars.Bar{High: 11, Low: 9, Close: 10, Volume: 100}
+	}
+	specs, _ := ParseSpecs("sma:20,rsi,macd,macd:5:35:5,bbands:20:2.5,atr:14,vwap")
+	var names []string
+	for _, s := range specs {
+		for _, col := range Compute(s, b) {
+			names = append(names, col.Name)
+			if len(col.Values) != len(b) {
+				t.Errorf("Compute(%s) length = %d, want %d", col.Name, len(col.Values), len(b))
+			}
+		}
+	}
+	want := []string{"sma_20", "rsi_14", "macd", "macd_signal", "macd_hist",
+		"macd_5_35_5", "macd_signal_5_35_5", "macd_hist_5_35_5", "bb_upper_20_2.5", "bb_mid_20_2.5", "bb_lower_20_2.5", "atr_14", "vwap"}
+	if len(names) != len(want) {
+		t.Fatalf("Compute() names = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("Compute() name[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+}
+
+// TestSpecForColumn verifies column names map back to the specs that produce them.
+func TestSpecForColumn(t *testing.T) {
+	tests := map[string]string{
+		"sma_50":           "sma [50]",
+		"RSI_14":           "rsi [14]",
+		"bb_upper_20_2.5":  "bbands [20 2.5]",
+		"macd_signal":      "macd [12 26 9]",
+		"macd_5_35_5":      "macd [5 35 5]",
+		"macd_hist_5_35_5": "macd [5 35 5]",
+		"vwap":             "vwap []",
+	}
+	for name, want := range tests {
+		spec, ok := SpecForColumn(name)
+		if got := fmt.Sprint(spec.Name, " ", spec.Params); !ok || got != want {
+			t.Errorf("SpecForColumn(%q) = %q, %v, want %q", name, got, ok, want)
+		}
+	}
+	for _, name := range []string{"volume", "sma", "bb_upper", "macd_12", "macd_12_26_9", "macd_signal_5", "last", "sma_x"} {
+		if _, ok := SpecForColumn(name); ok {
+			t.Errorf("SpecForColumn(%q) ok = true, want false", name)
+		}
+	}
+}
+
+// TestLookback verifies smoothed indicators ask for extra history.
+func TestLookback(t *testing.T) {
+	specs, _ := ParseSpecs("sma:200,ema:20,macd,vwap")
+	want := []int{200, 60, 87, 1}
+	for i, spec := range specs {
+		if got := Lookback(spec); got != want[i] {
+			t.Errorf("Lookback(%s) = %d, want %d", spec.Name, got, want[i])
+		}
+	}
+}
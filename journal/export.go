@@ -0,0 +1,193 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package journal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter selects journal entries. Zero fields match everything.
+type Filter struct {
+	Symbol string
+	Action Action
+	Since  time.Time
+	Until  time.Time
+}
+
+// Match reports whether the entry passes the filter. The symbol matches the entry's symbol or
+// any symbol in its order, such as an option leg or its underlying.
+func (f Filter) Match(e Entry) bool {
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Time.Before(f.Until) {
+		return false
+	}
+	if f.Symbol == "" {
+		return true
+	}
+	for _, s := range Symbols(e) {
+		if strings.EqualFold(s, f.Symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the entries that pass the filter.
+func (f Filter) Apply(entries []Entry) []Entry {
+	var out []Entry
+	for _, e := range entries {
+		if f.Match(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Symbols returns the entry's symbol, every symbol and option symbol in its order params,
+// including indexed legs, and the symbols quoted with it, without duplicates.
+func Symbols(e Entry) []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(s string) {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	add(e.Symbol)
+	keys := make([]string, 0, len(e.Params))
+	for k := range e.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k == "symbol" || k == "option_symbol" || strings.HasPrefix(k, "symbol[") || strings.HasPrefix(k, "option_symbol[") {
+			add(e.Params[k])
+		}
+	}
+	quoted := make([]string, 0, len(e.Quotes))
+	for s := range e.Quotes {
+		quoted = append(quoted, s)
+	}
+	sort.Strings(quoted)
+	for _, s := range quoted {
+		add(s)
+	}
+	return out
+}
+
+// csvHeader is the column layout of a CSV export.
+var csvHeader = []string{
+	"id", "time", "action", "account_id", "sandbox", "order_id", "status", "symbol", "class",
+	"side", "quantity", "type", "duration", "price", "stop", "tag", "bid", "ask", "last",
+	"note", "outcome", "pnl", "error",
+}
+
+// WriteCSV writes the entries as CSV, one row per entry, with the order params most trades use,
+// the quote for the entry's symbol, and the latest annotated outcome and P&L.
+func WriteCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		var quote Quote
+		if symbols := Symbols(e); len(symbols) > 0 {
+			quote = e.Quotes[symbols[0]]
+		}
+		outcome, pnl := e.Outcome()
+		pnlText := ""
+		if pnl != nil {
+			pnlText = strconv.FormatFloat(*pnl, 'f', -1, 64)
+		}
+		notes := []string{}
+		if e.Note != "" {
+			notes = append(notes, e.Note)
+		}
+		for _, a := range e.Annotations {
+			if a.Note != "" {
+				notes = append(notes, a.Note)
+			}
+		}
+		row := []string{
+			strconv.Itoa(e.ID),
+			e.Time.Format(time.RFC3339),
+			string(e.Action),
+			e.AccountID,
+			strconv.FormatBool(e.Sandbox),
+			e.OrderID,
+			Status(e),
+			e.Symbol,
+			e.Params["class"],
+			e.Params["side"],
+			e.Params["quantity"],
+			e.Params["type"],
+			e.Params["duration"],
+			e.Params["price"],
+			e.Params["stop"],
+			e.Params["tag"],
+			formatPrice(quote.Bid),
+			formatPrice(quote.Ask),
+			formatPrice(quote.Last),
+			strings.Join(notes, "; "),
+			outcome,
+			pnlText,
+			e.Error,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSONL writes the entries, annotations merged, as JSON Lines.
+func WriteJSONL(w io.Writer, entries []Entry) error {
+	enc := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return fmt.Errorf("unable to write journal entry %d: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// Status returns the order status from the entry's response, "error" if the request failed,
+// or "" if neither is known.
+func Status(e Entry) string {
+	if e.Error != "" {
+		return "error"
+	}
+	var resp struct {
+		Order struct {
+			Status string `json:"status"`
+		} `json:"order"`
+	}
+	if len(e.Response) > 0 && json.Unmarshal(e.Response, &resp) == nil {
+		return resp.Order.Status
+	}
+	return ""
+}
+
+// formatPrice formats a quote price, leaving zero blank.
+func formatPrice(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
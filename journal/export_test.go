@@ -0,0 +1,91 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package journal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFilter verifies entries are selected by action, time, and any symbol in the order.
+func TestFilter(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{ID: 1, Time: day, Action: ActionPlace, Symbol: "AAPL", Params: map[string]string{"option_symbol": "AAPL261120C00250000"}},
+		{ID: 2, Time: day.Add(24 * time.Hour), Action: ActionCancel, Symbol: "SPY"},
+		{ID: 3, Time: day.Add(48 * time.Hour), Action: ActionPlace, Params: map[string]string{"symbol": "spy"}},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int
+	}{
+		{"all", Filter{}, []int{1, 2, 3}},
+		{"symbol", Filter{Symbol: "SPY"}, []int{2, 3}},
+		{"option leg", Filter{Symbol: "aapl261120c00250000"}, []int{1}},
+		{"action", Filter{Action: ActionPlace}, []int{1, 3}},
+		{"since", Filter{Since: day.Add(time.Hour)}, []int{2, 3}},
+		{"until", Filter{Until: day.Add(48 * time.Hour)}, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, e := range tt.filter.Apply(entries) {
+			got = append(got, e.ID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Apply() = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: Apply() = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+// TestWriteCSV verifies the export has a header and one row per entry with quote and outcome.
+func TestWriteCSV(t *testing.T) {
+	pnl := 55.25
+	e := Entry{
+		ID:          1,
+		Time:        time.Date(2026, 10, 19, 14, 30, 0, 0, time.UTC),
+		Action:      ActionPlace,
+		OrderID:     "123",
+		Symbol:      "AAPL",
+		Params:      map[string]string{"class": "equity", "side": "buy", "quantity": "10", "type": "limit", "price": "250"},
+		Response:    []byte(`{"order":{"id":123,"status":"ok"}}`),
+		Quotes:      map[string]Quote{"AAPL": {Bid: 249.9, Ask: 250.1, Last: 250}},
+		Note:        "earnings drift, entry",
+		Annotations: []Annotation{{Note: "sold at target", Outcome: "win", PnL: &pnl}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, []Entry{e}); err != nil {
+		t.Fatalf("WriteCSV() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "id,time,action") {
+		t.Fatalf("WriteCSV() = %q", buf.String())
+	}
+	want := `1,2026-10-19T14:30:00Z,place,,false,123,ok,AAPL,equity,buy,10,limit,,250,,,249.9,250.1,250,"earnings drift, entry; sold at target",win,55.25,`
+	if lines[1] != want {
+		t.Errorf("WriteCSV() row = %q, want %q", lines[1], want)
+	}
+}
+
+// TestWriteJSONL verifies each entry is written as one line.
+func TestWriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, []Entry{{ID: 1, Action: ActionPlace}, {ID: 2, Action: ActionChange}}); err != nil {
+		t.Fatalf("WriteJSONL() error: %v", err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 2 {
+		t.Errorf("WriteJSONL() wrote %d lines, want 2", n)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+// Package journal keeps a local, append-only trade journal of the orders placed, changed, and
+// canceled through the CLI.
+//
+// The journal is a JSON Lines file: one record per line, never rewritten. Annotations are
+// appended as their own records referring to an entry and are merged into it when the journal
+// is loaded. A crash mid-write can leave a partial line; the next record starts on a new line
+// after it, and Load skips lines it can't read, so only the interrupted record is lost. Writers
+// hold the journal's file lock so concurrent commands never hand out the same ID.
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/config"
+	"github.com/cloudmanic/tradier/filelock"
+)
+
+// journalFile is the file name for the journal in the tradier config directory.
+const journalFile = "journal.jsonl"
+
+// Action is what a journal entry records.
+type Action string
+
+// Actions recorded in the journal.
+const (
+	ActionPlace   Action = "place"
+	ActionPreview Action = "preview"
+	ActionChange  Action = "change"
+	ActionCancel  Action = "cancel"
+
+	// actionAnnotate marks a record that adds an annotation to an earlier entry.
+	actionAnnotate Action = "annotate"
+)
+
+// Quote is the market for one symbol at the time an order was sent.
+type Quote struct {
+	Bid  float64 `json:"bid"`
+	Ask  float64 `json:"ask"`
+	Last float64 `json:"last"`
+}
+
+// Annotation is a note added to an entry after the fact, such as how the trade turned out.
+type Annotation struct {
+	Time    time.Time `json:"time"`
+	Note    string    `json:"note,omitempty"`
+	Outcome string    `json:"outcome,omitempty"`
+	PnL     *float64  `json:"pnl,omitempty"`
+}
+
+// Entry is one order request sent to Tradier and its result.
+type Entry struct {
+	ID          int               `json:"id"`
+	Ref         int               `json:"ref,omitempty"`
+	Time        time.Time         `json:"time"`
+	Action      Action            `json:"action"`
+	AccountID   string            `json:"account_id,omitempty"`
+	Sandbox     bool              `json:"sandbox,omitempty"`
+	Paper       bool              `json:"paper,omitempty"`
+	OrderID     string            `json:"order_id,omitempty"`
+	Symbol      string            `json:"symbol,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+	Response    json.RawMessage   `json:"response,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Quotes      map[string]Quote  `json:"quotes,omitempty"`
+	Note        string            `json:"note,omitempty"`
+	Annotations []Annotation      `json:"annotations,omitempty"`
+}
+
+// Outcome returns the most recent outcome and P&L annotated on the entry, if any.
+func (e Entry) Outcome() (string, *float64) {
+	outcome := ""
+	var pnl *float64
+	for _, a := range e.Annotations {
+		if a.Outcome != "" {
+			outcome = a.Outcome
+		}
+		if a.PnL != nil {
+			pnl = a.PnL
+		}
+	}
+	return outcome, pnl
+}
+
+// SetResponse stores the API response when it is JSON, so the journal stays valid JSON Lines.
+// Anything else is kept as an error message.
+func (e *Entry) SetResponse(data []byte) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return
+	}
+	if json.Valid(data) {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, data); err == nil {
+			e.Response = compact.Bytes()
+			return
+		}
+	}
+	if e.Error == "" {
+		e.Error = string(data)
+	}
+}
+
+// DefaultPath returns the path of the journal in the tradier config directory.
+func DefaultPath() (string, error) {
+	dir, err := config.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, journalFile), nil
+}
+
+// Load reads the journal at path, oldest entry first, with annotations merged into their
+// entries. A missing file is an empty journal, and unreadable lines (interrupted writes) are
+// skipped.
+func Load(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read journal: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	index := map[int]int{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(text), &e); err != nil {
+			continue
+		}
+		if e.Action == actionAnnotate {
+			if i, ok := index[e.Ref]; ok {
+				entries[i].Annotations = append(entries[i].Annotations, e.Annotations...)
+			}
+			continue
+		}
+		index[e.ID] = len(entries)
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Append adds the entry to the journal at path with the next unused ID and returns it.
+func Append(path string, e Entry) (Entry, error) {
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		return Entry{}, err
+	}
+	defer unlock()
+	entries, err := Load(path)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.ID, e.Ref = 0, 0
+	for _, existing := range entries {
+		e.ID = max(e.ID, existing.ID)
+	}
+	e.ID++
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Annotations = nil
+	if err := appendRecord(path, e); err != nil {
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// Annotate adds an annotation to the entry with the given ID.
+func Annotate(path string, id int, a Annotation) error {
+	if a.Note == "" && a.Outcome == "" && a.PnL == nil {
+		return fmt.Errorf("annotation needs a note, outcome, or P&L")
+	}
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	entries, err := Load(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := Find(entries, id); !ok {
+		return fmt.Errorf("no journal entry with ID %d", id)
+	}
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+	return appendRecord(path, Entry{Ref: id, Time: a.Time, Action: actionAnnotate, Annotations: []Annotation{a}})
+}
+
+// Find returns the entry with the given ID.
+func Find(entries []Entry, id int) (Entry, bool) {
+	for _, e := range entries {
+		if e.ID == id {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// appendRecord writes one record as a line at the end of the journal, first ending a partial
+// line left by an interrupted write so the record isn't merged into it. The caller holds the lock.
+func appendRecord(path string, e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("unable to marshal journal entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create config directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open journal: %w", err)
+	}
+	line := append(data, '\n')
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+			f.Close()
+			return fmt.Errorf("unable to read journal: %w", err)
+		}
+		if last[0] != '\n' {
+			line = append([]byte{'\n'}, line...)
+		}
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to write journal: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestAppendLoad verifies entries get increasing IDs and round-trip through the file.
+func TestAppendLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	entries, err := Load(path)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Load(missing) = %v, %v, want empty", entries, err)
+	}
+
+	e := Entry{ID: 7, Action: ActionPlace, Symbol: "AAPL", Params: map[string]string{"class": "equity", "symbol": "AAPL"}, Note: "breakout"}
+	e.SetResponse([]byte(`{"order": {"id": 123, "status": "ok"}}`))
+	first, err := Append(path, e)
+	if err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+	second, err := Append(path, Entry{Action: ActionCancel, OrderID: "123"})
+	if err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+	if first.ID != 1 || second.ID != 2 || first.Time.IsZero() {
+		t.Errorf("Append() IDs = %d, %d, want 1, 2", first.ID, second.ID)
+	}
+
+	entries, err = Load(path)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("Load() = %+v, %v", entries, err)
+	}
+	if entries[0].Note != "breakout" || entries[0].Params["symbol"] != "AAPL" || string(entries[0].Response) != `{"order":{"id":123,"status":"ok"}}` {
+		t.Errorf("Load()[0] = %+v", entries[0])
+	}
+	if Status(entries[0]) != "ok" || Status(entries[1]) != "" {
+		t.Errorf("Status() = %q, %q, want ok, empty", Status(entries[0]), Status(entries[1]))
+	}
+}
+
+// TestSetResponse verifies non-JSON responses are kept as the error instead of the response.
+func TestSetResponse(t *testing.T) {
+	var e Entry
+	e.SetResponse([]byte("Invalid Parameter"))
+	if e.Response != nil || e.Error != "Invalid Parameter" || Status(e) != "error" {
+		t.Errorf("SetResponse(text) = %+v", e)
+	}
+}
+
+// TestAnnotate verifies annotations are merged into their entry and the latest outcome wins.
+func TestAnnotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	if _, err := Append(path, Entry{Action: ActionPlace, Symbol: "SPY"}); err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+	gain, loss := 120.5, -40.0
+	if err := Annotate(path, 1, Annotation{Outcome: "win", PnL: &gain}); err != nil {
+		t.Fatalf("Annotate() error: %v", err)
+	}
+	if err := Annotate(path, 1, Annotation{Note: "stopped out on the retest", Outcome: "loss", PnL: &loss}); err != nil {
+		t.Fatalf("Annotate() error: %v", err)
+	}
+	if err := Annotate(path, 5, Annotation{Note: "x"}); err == nil {
+		t.Errorf("Annotate(missing) error = nil, want error")
+	}
+	if err := Annotate(path, 1, Annotation{}); err == nil {
+		t.Errorf("Annotate(empty) error = nil, want error")
+	}
+
+	// Annotation records don't take entry IDs
+	next, err := Append(path, Entry{Action: ActionPlace})
+	if err != nil || next.ID != 2 {
+		t.Errorf("Append() = %+v, %v, want ID 2", next, err)
+	}
+
+	entries, err := Load(path)
+	if err != nil || len(entries) != 2 || len(entries[0].Annotations) != 2 {
+		t.Fatalf("Load() = %+v, %v", entries, err)
+	}
+	outcome, pnl := entries[0].Outcome()
+	if outcome != "loss" || pnl == nil || *pnl != -40 {
+		t.Errorf("Outcome() = %q, %v, want loss, -40", outcome, pnl)
+	}
+}
+
+// TestLoadTruncated verifies unreadable lines are skipped and a record written after an
+// interrupted write starts on its own line.
+func TestLoadTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	good := `{"id":1,"time":"2026-10-19T10:00:00Z","action":"place"}` + "\n"
+	if err := os.WriteFile(path, []byte(good+`{"id":2,"ti`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := Load(path)
+	if err != nil || len(entries) != 1 {
+		t.Errorf("Load(truncated) = %+v, %v, want one entry", entries, err)
+	}
+
+	if err := os.WriteFile(path, []byte("garbage\n"+good), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := Load(path); err != nil || len(entries) != 1 {
+		t.Errorf("Load(corrupt) = %+v, %v, want the readable entry", entries, err)
+	}
+
+	// Entries after a partial write are kept and keep counting up
+	os.WriteFile(path, []byte(good+`{"id":2,"ti`), 0600)
+	for want := 2; want <= 3; want++ {
+		if e, err := Append(path, Entry{Action: ActionPlace, Time: time.Now()}); err != nil || e.ID != want {
+			t.Errorf("Append() = %+v, %v, want ID %d", e, err, want)
+		}
+	}
+	if err := Annotate(path, 2, Annotation{Note: "after the crash"}); err != nil {
+		t.Fatalf("Annotate() error: %v", err)
+	}
+	entries, err = Load(path)
+	if err != nil || len(entries) != 3 || entries[1].ID != 2 || entries[2].ID != 3 || len(entries[1].Annotations) != 1 {
+		t.Errorf("Load() after partial write = %+v, %v, want entries 1-3 with 2 annotated", entries, err)
+	}
+}
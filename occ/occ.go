@@ -0,0 +1,86 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package occ
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Symbol is a parsed OCC option symbol such as AAPL220617C00270000.
+type Symbol struct {
+	Root       string
+	Expiration time.Time
+	Call       bool
+	Strike     float64
+}
+
+// Parse splits an OCC option symbol into its root, expiration, type, and strike.
+// The last 15 characters are always YYMMDD + C/P + an 8-digit strike in thousandths;
+// whatever precedes them is the root symbol.
+func Parse(sym string) (Symbol, error) {
+	if len(sym) < 16 {
+		return Symbol{}, fmt.Errorf("invalid OCC symbol %q: too short", sym)
+	}
+
+	suffix := sym[len(sym)-15:]
+	exp, err := time.Parse("060102", suffix[0:6])
+	if err != nil {
+		return Symbol{}, fmt.Errorf("invalid OCC symbol %q: bad expiration", sym)
+	}
+
+	var call bool
+	switch suffix[6] {
+	case 'C':
+		call = true
+	case 'P':
+		call = false
+	default:
+		return Symbol{}, fmt.Errorf("invalid OCC symbol %q: bad option type", sym)
+	}
+
+	strike, err := strconv.ParseUint(suffix[7:15], 10, 64)
+	if err != nil {
+		return Symbol{}, fmt.Errorf("invalid OCC symbol %q: bad strike", sym)
+	}
+
+	return Symbol{
+		Root:       sym[:len(sym)-15],
+		Expiration: exp,
+		Call:       call,
+		Strike:     float64(strike) / 1000,
+	}, nil
+}
+
+// IsOption reports whether the symbol is a well-formed OCC option symbol.
+func IsOption(sym string) bool {
+	_, err := Parse(sym)
+	return err == nil
+}
+
+// Underlying returns the root of an OCC option symbol, or the symbol unchanged when it is not an option.
+func Underlying(sym string) string {
+	if s, err := Parse(sym); err == nil {
+		return s.Root
+	}
+	return sym
+}
+
+// String formats the symbol back into OCC form.
+func (s Symbol) String() string {
+	kind := "P"
+	if s.Call {
+		kind = "C"
+	}
+	return fmt.Sprintf("%s%s%s%08d", s.Root, s.Expiration.Format("060102"), kind, int64(s.Strike*1000+0.5))
+}
+
+// TypeName returns "Call" or "Put".
+func (s Symbol) TypeName() string {
+	if s.Call {
+		return "Call"
+	}
+	return "Put"
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package occ
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParse verifies each component of a well-formed OCC symbol is extracted.
+func TestParse(t *testing.T) {
+	s, err := Parse("AAPL220617C00270000")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if s.Root != "AAPL" {
+		t.Errorf("Root = %q, want AAPL", s.Root)
+	}
+	if !s.Expiration.Equal(time.Date(2022, 6, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expiration = %v, want 2022-06-17", s.Expiration)
+	}
+	if !s.Call {
+		t.Error("Call = false, want true")
+	}
+	if s.Strike != 270 {
+		t.Errorf("Strike = %v, want 270", s.Strike)
+	}
+
+	p, err := Parse("UNG260220P00014500")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if p.Call || p.Strike != 14.5 || p.TypeName() != "Put" {
+		t.Errorf("Parse(put) = %+v", p)
+	}
+}
+
+// TestParseInvalid verifies malformed symbols are rejected.
+func TestParseInvalid(t *testing.T) {
+	for _, sym := range []string{"AAPL", "AAPL22061XC00270000", "AAPL220617X00270000", "AAPL220617C0027000A", "220617C00270000"} {
+		if _, err := Parse(sym); err == nil {
+			t.Errorf("Parse(%q) expected error", sym)
+		}
+	}
+}
+
+// TestUnderlying verifies option roots are returned and plain symbols pass through.
+func TestUnderlying(t *testing.T) {
+	if got := Underlying("SPY260220P00657000"); got != "SPY" {
+		t.Errorf("Underlying() = %q, want SPY", got)
+	}
+	if got := Underlying("MSFT"); got != "MSFT" {
+		t.Errorf("Underlying() = %q, want MSFT", got)
+	}
+}
+
+// TestStringRoundTrip verifies a parsed symbol formats back to the original.
+func TestStringRoundTrip(t *testing.T) {
+	for _, sym := range []string{"AAPL220617C00270000", "SPY260220P00657500", "BRKB251219C00012345"} {
+		s, err := Parse(sym)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", sym, err)
+		}
+		if s.String() != sym {
+			t.Errorf("String() = %q, want %q", s.String(), sym)
+		}
+	}
+}
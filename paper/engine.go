@@ -0,0 +1,815 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+// Package paper is a local paper-trading account that fills orders against real quotes.
+//
+// The Engine answers the account and order calls of client.Client (PlaceOrder, ChangeOrder,
+// CancelOrder, GetOrders, GetOrder, GetPositions, GetBalances, and GetHistory) with the same
+// signatures and response shapes, and Transport serves them over HTTP so an unmodified
+// client.Client can trade on paper while market data still comes from Tradier.
+//
+// The account is stored in the tradier config directory and brought up to date on every call:
+// working orders fill against the current quote (limit and stop logic, partial fills limited to
+// the displayed size, and commissions), day orders expire at the close, and expired options are
+// exercised, assigned, or expire worthless.
+package paper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/filelock"
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// QuoteSource returns current quotes keyed by symbol. Symbols without a quote are left out.
+type QuoteSource func(symbols []string) (map[string]Quote, error)
+
+// MarketHours reports whether the regular session is open at t.
+type MarketHours func(t time.Time) (bool, error)
+
+// Engine is a paper account stored at a path, or held in memory. It is safe for concurrent use,
+// and a file lock keeps separate processes from interleaving changes.
+type Engine struct {
+	path   string
+	state  *State
+	quotes QuoteSource
+	hours  MarketHours
+	now    func() time.Time
+
+	mu sync.Mutex
+}
+
+// New returns an engine for the paper account at path, priced by quotes. When hours is nil the
+// market is taken to be open 9:30 a.m. to 4 p.m. Eastern on weekdays.
+func New(path string, quotes QuoteSource, hours MarketHours) *Engine {
+	if hours == nil {
+		hours = regularHours
+	}
+	return &Engine{path: path, quotes: quotes, hours: hours, now: time.Now}
+}
+
+// NewMemory returns an engine for an account held in memory instead of on disk, whose clock is
+// now. Backtests use it to replay history through the same order calls as a live account.
+func NewMemory(s *State, quotes QuoteSource, hours MarketHours, now func() time.Time) *Engine {
+	if hours == nil {
+		hours = regularHours
+	}
+	return &Engine{state: s, quotes: quotes, hours: hours, now: now}
+}
+
+// regularHours reports whether t falls in the regular session of a weekday, ignoring holidays.
+func regularHours(t time.Time) (bool, error) {
+	t = t.In(pricing.Eastern())
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false, nil
+	}
+	minutes := t.Hour()*60 + t.Minute()
+	return minutes >= 9*60+30 && minutes < 16*60, nil
+}
+
+// ClientQuotes returns a QuoteSource that fetches quotes with c.
+func ClientQuotes(c *client.Client) QuoteSource {
+	return func(symbols []string) (map[string]Quote, error) {
+		data, err := c.PostQuotes(strings.Join(symbols, ","), "false")
+		if err != nil {
+			return nil, err
+		}
+		return ParseQuotes(data)
+	}
+}
+
+// ParseQuotes reads a Tradier quotes response, whose quote is an object for one symbol and an
+// array for several.
+func ParseQuotes(data []byte) (map[string]Quote, error) {
+	var resp struct {
+		Quotes struct {
+			Quote json.RawMessage `json:"quote"`
+		} `json:"quotes"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		// Tradier returns "quotes": "null" for no matches
+		return map[string]Quote{}, nil
+	}
+	var list []Quote
+	raw := strings.TrimSpace(string(resp.Quotes.Quote))
+	switch {
+	case strings.HasPrefix(raw, "["):
+		if err := json.Unmarshal(resp.Quotes.Quote, &list); err != nil {
+			return nil, fmt.Errorf("unable to parse quotes: %w", err)
+		}
+	case strings.HasPrefix(raw, "{"):
+		var q Quote
+		if err := json.Unmarshal(resp.Quotes.Quote, &q); err != nil {
+			return nil, fmt.Errorf("unable to parse quotes: %w", err)
+		}
+		list = []Quote{q}
+	}
+	out := make(map[string]Quote, len(list))
+	for _, q := range list {
+		out[q.Symbol] = q
+	}
+	return out, nil
+}
+
+// Reset replaces the paper account at path with an empty one holding cash.
+func Reset(path string, cash float64, commission Commission) error {
+	unlock, err := filelock.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return Save(path, NewState(cash, commission, time.Now()))
+}
+
+// update loads the account, brings it up to date, applies fn, processes again so new orders
+// can fill at once, and saves, holding the lock throughout. A memory account is changed in
+// place. Symbols lists extra quotes fn needs.
+func (e *Engine) update(symbols []string, fn func(s *State, quotes map[string]Quote, now time.Time) error) ([]Event, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s := e.state
+	if s == nil {
+		unlock, err := filelock.Lock(e.path)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		if s, err = Load(e.path); err != nil {
+			return nil, err
+		}
+	}
+	now := e.now()
+	if s == nil {
+		s = NewState(DefaultCash, DefaultCommission, now)
+	}
+	open, err := e.hours(now)
+	if err != nil {
+		return nil, err
+	}
+	quotes, err := e.fetch(append(s.QuoteSymbols(now), symbols...))
+	if err != nil {
+		return nil, err
+	}
+
+	events := s.Process(now, quotes, open)
+	if fn != nil {
+		if err := fn(s, quotes, now); err != nil {
+			return nil, err
+		}
+		events = append(events, s.Process(now, quotes, open)...)
+	}
+	if e.state != nil {
+		return events, nil
+	}
+	if err := Save(e.path, s); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// fetch returns quotes for the unique symbols, without calling the source when there are none.
+func (e *Engine) fetch(symbols []string) (map[string]Quote, error) {
+	seen := map[string]bool{}
+	var unique []string
+	for _, s := range symbols {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+	if len(unique) == 0 {
+		return map[string]Quote{}, nil
+	}
+	quotes, err := e.quotes(unique)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch quotes for paper trading: %w", err)
+	}
+	return quotes, nil
+}
+
+// Process brings the account up to date, filling, expiring, and settling as of now, and returns
+// the history events that added.
+func (e *Engine) Process() ([]Event, error) {
+	return e.update(nil, nil)
+}
+
+// State returns an up-to-date copy of the account.
+func (e *Engine) State() (*State, error) {
+	var out State
+	_, err := e.update(nil, func(s *State, _ map[string]Quote, _ time.Time) error {
+		out = *s
+		return nil
+	})
+	return &out, err
+}
+
+// PlaceOrder places an equity, option, or multileg order, or previews it when params has
+// preview=true. Marketable orders fill immediately while the market is open.
+func (e *Engine) PlaceOrder(accountID string, params map[string]string) ([]byte, error) {
+	o, err := parseOrder(params)
+	if err != nil {
+		return nil, err
+	}
+	var symbols []string
+	for _, l := range o.Legs {
+		symbols = append(symbols, l.Instrument())
+	}
+
+	preview := params["preview"] == "true"
+	var resp map[string]interface{}
+	_, err = e.update(symbols, func(s *State, quotes map[string]Quote, now time.Time) error {
+		cost, commission := estimate(o, quotes, s.Commission)
+		for _, l := range o.Legs {
+			legCost := 0.0
+			if buying(l.Side) {
+				legCost = cost
+			}
+			if reason := s.check(l.Side, l.Instrument(), l.Quantity, legCost); reason != "" {
+				return apiError(reason)
+			}
+		}
+		if preview {
+			resp = previewJSON(o, cost, commission, now)
+			return nil
+		}
+
+		for _, existing := range s.Orders {
+			o.ID = max(o.ID, existing.ID)
+		}
+		o.ID++
+		o.Status, o.Created, o.Updated = StatusOpen, now, now
+		s.Orders = append(s.Orders, o)
+		resp = map[string]interface{}{"order": map[string]interface{}{"id": o.ID, "status": "ok", "partner_id": "paper"}}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resp)
+}
+
+// ChangeOrder changes the type, duration, price, or stop of a working order.
+func (e *Engine) ChangeOrder(accountID, orderID string, params map[string]string) ([]byte, error) {
+	id, err := strconv.Atoi(orderID)
+	if err != nil {
+		return nil, apiError("invalid order ID " + orderID)
+	}
+	_, err = e.update(nil, func(s *State, _ map[string]Quote, now time.Time) error {
+		o := s.order(id)
+		if o == nil {
+			return notFound(orderID)
+		}
+		if !o.Working() {
+			return apiError(fmt.Sprintf("order %d is %s and can't be changed", id, o.Status))
+		}
+		changed := *o
+		for k, v := range params {
+			if v == "" {
+				continue
+			}
+			switch k {
+			case "type":
+				changed.Type = v
+			case "duration":
+				changed.Duration = v
+			case "price", "stop":
+				f, err := strconv.ParseFloat(v, 64)
+				if err != nil || f <= 0 {
+					return apiError(fmt.Sprintf("invalid %s %q", k, v))
+				}
+				if k == "price" {
+					changed.Price = f
+				} else {
+					changed.Stop = f
+					changed.Triggered = false
+				}
+			case "tag":
+				changed.Tag = v
+			}
+		}
+		if err := validateTerms(&changed); err != nil {
+			return err
+		}
+		changed.Updated = now
+		*o = changed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{"order": map[string]interface{}{"id": id, "status": "ok"}})
+}
+
+// CancelOrder cancels the unfilled quantity of a working order.
+func (e *Engine) CancelOrder(accountID, orderID string) ([]byte, error) {
+	id, err := strconv.Atoi(orderID)
+	if err != nil {
+		return nil, apiError("invalid order ID " + orderID)
+	}
+	_, err = e.update(nil, func(s *State, _ map[string]Quote, now time.Time) error {
+		o := s.order(id)
+		if o == nil {
+			return notFound(orderID)
+		}
+		if !o.Working() {
+			return apiError(fmt.Sprintf("order %d is %s and can't be canceled", id, o.Status))
+		}
+		o.Status, o.Updated = StatusCanceled, now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{"order": map[string]interface{}{"id": id, "status": "ok"}})
+}
+
+// GetOrders returns the account's orders, oldest first, a page at a time when page and limit
+// are set. Tags are always included.
+func (e *Engine) GetOrders(accountID, page, limit, includeTags string) ([]byte, error) {
+	s, err := e.State()
+	if err != nil {
+		return nil, err
+	}
+	orders := paginate(len(s.Orders), page, limit)
+	if len(orders) == 0 {
+		return []byte(`{"orders":"null"}`), nil
+	}
+	list := make([]map[string]interface{}, 0, len(orders))
+	for _, i := range orders {
+		list = append(list, orderJSON(s.Orders[i]))
+	}
+	return json.Marshal(map[string]interface{}{"orders": map[string]interface{}{"order": list}})
+}
+
+// GetOrder returns one order.
+func (e *Engine) GetOrder(accountID, orderID, includeTags string) ([]byte, error) {
+	s, err := e.State()
+	if err != nil {
+		return nil, err
+	}
+	id, _ := strconv.Atoi(orderID)
+	o := s.order(id)
+	if o == nil {
+		return nil, notFound(orderID)
+	}
+	return json.Marshal(map[string]interface{}{"order": orderJSON(*o)})
+}
+
+// GetPositions returns the account's open positions.
+func (e *Engine) GetPositions(accountID string) ([]byte, error) {
+	s, err := e.State()
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Positions) == 0 {
+		return []byte(`{"positions":"null"}`), nil
+	}
+	list := make([]map[string]interface{}, 0, len(s.Positions))
+	for i, p := range s.Positions {
+		list = append(list, map[string]interface{}{
+			"id":            i + 1,
+			"symbol":        p.Symbol,
+			"quantity":      p.Quantity,
+			"cost_basis":    round(p.CostBasis),
+			"date_acquired": timestamp(p.DateAcquired),
+		})
+	}
+	return json.Marshal(map[string]interface{}{"positions": map[string]interface{}{"position": list}})
+}
+
+// GetBalances returns the account's cash and the value of its positions at the quote midpoint.
+func (e *Engine) GetBalances(accountID string) ([]byte, error) {
+	var b map[string]interface{}
+	_, err := e.update(nil, func(s *State, _ map[string]Quote, _ time.Time) error {
+		var symbols []string
+		for _, p := range s.Positions {
+			symbols = append(symbols, p.Symbol)
+		}
+		quotes, err := e.fetch(symbols)
+		if err != nil {
+			return err
+		}
+		b = balancesJSON(s, quotes)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{"balances": b})
+}
+
+// GetHistory returns account history, most recent first, optionally limited to one activity
+// type and a date range (YYYY-MM-DD, Eastern), a page at a time.
+func (e *Engine) GetHistory(accountID, page, limit, activityType, start, end string) ([]byte, error) {
+	s, err := e.State()
+	if err != nil {
+		return nil, err
+	}
+	eastern := pricing.Eastern()
+	var events []Event
+	for i := len(s.History) - 1; i >= 0; i-- {
+		ev := s.History[i]
+		date := ev.Date.In(eastern).Format("2006-01-02")
+		if (activityType == "" || ev.Type == activityType) && (start == "" || date >= start) && (end == "" || date <= end) {
+			events = append(events, ev)
+		}
+	}
+	if limit == "" {
+		limit = "25"
+	}
+	indexes := paginate(len(events), page, limit)
+	if len(indexes) == 0 {
+		return []byte(`{"history":"null"}`), nil
+	}
+	list := make([]map[string]interface{}, 0, len(indexes))
+	for _, i := range indexes {
+		list = append(list, eventJSON(events[i]))
+	}
+	return json.Marshal(map[string]interface{}{"history": map[string]interface{}{"event": list}})
+}
+
+// order returns the order with the given ID, or nil.
+func (s *State) order(id int) *Order {
+	for i := range s.Orders {
+		if s.Orders[i].ID == id {
+			return &s.Orders[i]
+		}
+	}
+	return nil
+}
+
+// parseOrder builds an order from Tradier order parameters. Equity, option, and multileg
+// classes are supported.
+func parseOrder(params map[string]string) (Order, error) {
+	o := Order{
+		Class:    params["class"],
+		Symbol:   strings.ToUpper(params["symbol"]),
+		Type:     params["type"],
+		Duration: params["duration"],
+		Tag:      params["tag"],
+	}
+	if o.Symbol == "" {
+		return Order{}, apiError("symbol is required")
+	}
+	quantity := func(key string) (float64, error) {
+		q, err := strconv.ParseFloat(params[key], 64)
+		if err != nil || q <= 0 || q != math.Trunc(q) {
+			return 0, apiError(fmt.Sprintf("%s must be a positive whole number", key))
+		}
+		return q, nil
+	}
+
+	switch o.Class {
+	case "equity", "option":
+		side := params["side"]
+		leg := Leg{Symbol: o.Symbol, Side: side}
+		if o.Class == "option" {
+			leg.OptionSymbol = strings.ToUpper(params["option_symbol"])
+			if !occ.IsOption(leg.OptionSymbol) {
+				return Order{}, apiError("option_symbol must be an OCC option symbol")
+			}
+			if !validSide(side, true) {
+				return Order{}, apiError("side must be buy_to_open, buy_to_close, sell_to_open, or sell_to_close")
+			}
+		} else if !validSide(side, false) {
+			return Order{}, apiError("side must be buy, sell, sell_short, or buy_to_cover")
+		}
+		q, err := quantity("quantity")
+		if err != nil {
+			return Order{}, err
+		}
+		leg.Quantity, o.Quantity = q, q
+		o.Legs = []Leg{leg}
+	case "multileg":
+		for i := 0; params[fmt.Sprintf("option_symbol[%d]", i)] != ""; i++ {
+			leg := Leg{Symbol: o.Symbol, OptionSymbol: strings.ToUpper(params[fmt.Sprintf("option_symbol[%d]", i)]), Side: params[fmt.Sprintf("side[%d]", i)]}
+			if !occ.IsOption(leg.OptionSymbol) {
+				return Order{}, apiError(fmt.Sprintf("option_symbol[%d] must be an OCC option symbol", i))
+			}
+			if !validSide(leg.Side, true) {
+				return Order{}, apiError(fmt.Sprintf("side[%d] must be buy_to_open, buy_to_close, sell_to_open, or sell_to_close", i))
+			}
+			q, err := quantity(fmt.Sprintf("quantity[%d]", i))
+			if err != nil {
+				return Order{}, err
+			}
+			leg.Quantity = q
+			o.Legs = append(o.Legs, leg)
+		}
+		if len(o.Legs) < 2 {
+			return Order{}, apiError("multileg orders need at least two legs")
+		}
+		o.Quantity = spreadUnit(o.Legs)
+	case "":
+		return Order{}, apiError("class is required")
+	default:
+		return Order{}, apiError(fmt.Sprintf("paper trading supports equity, option, and multileg orders, not %s", o.Class))
+	}
+
+	for key, dst := range map[string]*float64{"price": &o.Price, "stop": &o.Stop} {
+		if v := params[key]; v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f < 0 {
+				return Order{}, apiError(fmt.Sprintf("invalid %s %q", key, v))
+			}
+			*dst = f
+		}
+	}
+	if err := validateTerms(&o); err != nil {
+		return Order{}, err
+	}
+	return o, nil
+}
+
+// validSide reports whether side is allowed for an option or equity order.
+func validSide(side string, option bool) bool {
+	if option {
+		return side == "buy_to_open" || side == "buy_to_close" || side == "sell_to_open" || side == "sell_to_close"
+	}
+	return side == "buy" || side == "sell" || side == "sell_short" || side == "buy_to_cover"
+}
+
+// validateTerms checks an order's type, duration, and the prices its type needs.
+func validateTerms(o *Order) error {
+	types := []string{"market", "limit", "stop", "stop_limit"}
+	if o.Class == "multileg" {
+		types = []string{"market", "debit", "credit", "even"}
+	}
+	valid := false
+	for _, t := range types {
+		valid = valid || o.Type == t
+	}
+	if !valid {
+		return apiError(fmt.Sprintf("type must be one of %s", strings.Join(types, ", ")))
+	}
+	switch o.Duration {
+	case "day", "gtc", "pre", "post":
+	default:
+		return apiError("duration must be day, gtc, pre, or post")
+	}
+	if (o.Type == "limit" || o.Type == "stop_limit" || o.Type == "debit" || o.Type == "credit") && o.Price <= 0 {
+		return apiError(fmt.Sprintf("price is required for %s orders", o.Type))
+	}
+	if (o.Type == "stop" || o.Type == "stop_limit") && o.Stop <= 0 {
+		return apiError(fmt.Sprintf("stop is required for %s orders", o.Type))
+	}
+	return nil
+}
+
+// estimate returns the cash an order would need at the current quotes, or its limit price
+// when that is worse, and its commission.
+func estimate(o Order, quotes map[string]Quote, c Commission) (float64, float64) {
+	commission := 0.0
+	if o.Class == "equity" {
+		commission = c.PerOrder
+	} else {
+		for _, l := range o.Legs {
+			commission += c.PerContract * l.Quantity
+		}
+	}
+
+	if o.Class == "multileg" {
+		net, _, _ := netPrice(&o, quotes)
+		if o.Type == "debit" {
+			net = math.Max(net, o.Price)
+		}
+		return net*o.Quantity*100 + commission, commission
+	}
+	l := o.Legs[0]
+	price := touch(quotes[l.Instrument()], buying(l.Side))
+	if o.Type == "limit" || o.Type == "stop_limit" {
+		price = o.Price
+	}
+	return price*l.Quantity*multiplier(l.Instrument()) + commission, commission
+}
+
+// paginate returns the indexes of the items on a 1-based page. Without a limit every index is returned.
+func paginate(n int, page, limit string) []int {
+	size, _ := strconv.Atoi(limit)
+	p, _ := strconv.Atoi(page)
+	if p < 1 {
+		p = 1
+	}
+	from, to := 0, n
+	if size > 0 {
+		from = min((p-1)*size, n)
+		to = min(from+size, n)
+	}
+	out := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+// orderJSON renders an order in the shape of Tradier's orders endpoint.
+func orderJSON(o Order) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":                 o.ID,
+		"type":               o.Type,
+		"symbol":             o.Symbol,
+		"quantity":           o.Quantity,
+		"status":             o.Status,
+		"duration":           o.Duration,
+		"avg_fill_price":     round(o.AvgFillPrice),
+		"exec_quantity":      o.ExecQuantity,
+		"last_fill_price":    round(o.LastFillPrice),
+		"last_fill_quantity": o.LastFillQuantity,
+		"remaining_quantity": remaining(o),
+		"create_date":        timestamp(o.Created),
+		"transaction_date":   timestamp(o.Updated),
+		"class":              o.Class,
+	}
+	if o.Price > 0 {
+		m["price"] = o.Price
+	}
+	if o.Stop > 0 {
+		m["stop_price"] = o.Stop
+	}
+	if o.Tag != "" {
+		m["tag"] = o.Tag
+	}
+	if o.Reason != "" {
+		m["reason_description"] = o.Reason
+	}
+	if o.Class != "multileg" {
+		m["side"] = o.Legs[0].Side
+		if o.Legs[0].OptionSymbol != "" {
+			m["option_symbol"] = o.Legs[0].OptionSymbol
+		}
+		return m
+	}
+
+	legs := make([]map[string]interface{}, 0, len(o.Legs))
+	for _, l := range o.Legs {
+		legs = append(legs, map[string]interface{}{
+			"id":                 o.ID,
+			"type":               o.Type,
+			"symbol":             l.Symbol,
+			"side":               l.Side,
+			"quantity":           l.Quantity,
+			"status":             o.Status,
+			"duration":           o.Duration,
+			"avg_fill_price":     round(l.AvgFillPrice),
+			"exec_quantity":      l.ExecQuantity,
+			"last_fill_price":    round(l.AvgFillPrice),
+			"last_fill_quantity": l.ExecQuantity,
+			"remaining_quantity": l.Quantity - l.ExecQuantity,
+			"create_date":        timestamp(o.Created),
+			"transaction_date":   timestamp(o.Updated),
+			"class":              "option",
+			"option_symbol":      l.OptionSymbol,
+		})
+	}
+	m["num_legs"] = len(o.Legs)
+	m["strategy"] = "spread"
+	m["leg"] = legs
+	return m
+}
+
+// remaining returns the quantity of an order still to fill, zero once it is done.
+func remaining(o Order) float64 {
+	if !o.Working() {
+		return 0
+	}
+	return o.Quantity - o.ExecQuantity
+}
+
+// previewJSON renders an order preview in the shape of Tradier's preview response.
+func previewJSON(o Order, cost, commission float64, now time.Time) map[string]interface{} {
+	m := map[string]interface{}{
+		"status":         "ok",
+		"result":         true,
+		"class":          o.Class,
+		"symbol":         o.Symbol,
+		"quantity":       o.Quantity,
+		"type":           o.Type,
+		"duration":       o.Duration,
+		"commission":     round(commission),
+		"cost":           round(cost),
+		"order_cost":     round(cost - commission),
+		"fees":           0,
+		"request_date":   timestamp(now),
+		"extended_hours": false,
+	}
+	if o.Price > 0 {
+		m["price"] = o.Price
+	}
+	if o.Class != "multileg" {
+		m["side"] = o.Legs[0].Side
+		if o.Legs[0].OptionSymbol != "" {
+			m["option_symbol"] = o.Legs[0].OptionSymbol
+		}
+	}
+	return map[string]interface{}{"order": m}
+}
+
+// balancesJSON renders the account's balances in the shape of Tradier's balances endpoint,
+// valuing positions at the quote midpoint.
+func balancesJSON(s *State, quotes map[string]Quote) map[string]interface{} {
+	var stockLong, stockShort, optionLong, optionShort, basis float64
+	for _, p := range s.Positions {
+		value := p.Quantity * quotes[p.Symbol].Mark() * multiplier(p.Symbol)
+		basis += p.CostBasis
+		switch {
+		case occ.IsOption(p.Symbol) && value >= 0:
+			optionLong += value
+		case occ.IsOption(p.Symbol):
+			optionShort += value
+		case value >= 0:
+			stockLong += value
+		default:
+			stockShort += value
+		}
+	}
+	marketValue := stockLong + stockShort + optionLong + optionShort
+	pending := 0
+	for _, o := range s.Orders {
+		if o.Working() {
+			pending++
+		}
+	}
+	return map[string]interface{}{
+		"account_number":       AccountNumber,
+		"account_type":         "paper",
+		"total_equity":         round(s.Cash + marketValue),
+		"total_cash":           round(s.Cash),
+		"market_value":         round(marketValue),
+		"long_market_value":    round(stockLong + optionLong),
+		"short_market_value":   round(stockShort + optionShort),
+		"stock_long_value":     round(stockLong),
+		"option_long_value":    round(optionLong),
+		"option_short_value":   round(optionShort),
+		"open_pl":              round(marketValue - basis),
+		"close_pl":             round(s.ClosedPL),
+		"current_requirement":  0,
+		"uncleared_funds":      0,
+		"pending_cash":         0,
+		"pending_orders_count": pending,
+		"cash": map[string]interface{}{
+			"cash_available":      round(s.Cash),
+			"stock_buying_power":  round(math.Max(s.Cash, 0)),
+			"option_buying_power": round(math.Max(s.Cash, 0)),
+			"sweep":               0,
+			"unsettled_funds":     0,
+		},
+	}
+}
+
+// eventJSON renders a history event in the shape of Tradier's history endpoint.
+func eventJSON(ev Event) map[string]interface{} {
+	m := map[string]interface{}{
+		"amount": round(ev.Amount),
+		"date":   timestamp(ev.Date),
+		"type":   ev.Type,
+	}
+	switch ev.Type {
+	case "trade":
+		m["trade"] = map[string]interface{}{
+			"commission":  round(ev.Commission),
+			"description": ev.Description,
+			"price":       ev.Price,
+			"quantity":    ev.Quantity,
+			"symbol":      ev.Symbol,
+			"trade_type":  ev.TradeType,
+		}
+	case "option":
+		m["option"] = map[string]interface{}{
+			"option_type": ev.OptionType,
+			"description": ev.Description,
+			"quantity":    ev.Quantity,
+			"symbol":      ev.Symbol,
+		}
+	}
+	return m
+}
+
+// timestamp formats a time as Tradier does, in UTC with milliseconds.
+func timestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// round rounds a dollar amount to cents.
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// apiError returns a client.APIError carrying the message the way Tradier reports a rejected request.
+func apiError(msg string) error {
+	body, _ := json.Marshal(map[string]interface{}{"errors": map[string]interface{}{"error": []string{msg}}})
+	return &client.APIError{StatusCode: http.StatusBadRequest, Body: string(body)}
+}
+
+// notFound returns the error for an unknown order ID.
+func notFound(orderID string) error {
+	return &client.APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("order %s not found", orderID)}
+}
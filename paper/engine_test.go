@@ -0,0 +1,236 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package paper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+)
+
+// testEngine returns an engine on a temporary account priced from quotes, with the clock at now.
+func testEngine(t *testing.T, quotes map[string]Quote, now *time.Time) *Engine {
+	t.Helper()
+	source := func(symbols []string) (map[string]Quote, error) {
+		out := map[string]Quote{}
+		for _, s := range symbols {
+			if q, ok := quotes[s]; ok {
+				out[s] = q
+			}
+		}
+		return out, nil
+	}
+	e := New(filepath.Join(t.TempDir(), "paper.json"), source, nil)
+	e.now = func() time.Time { return *now }
+	return e
+}
+
+// decode unmarshals a response into a generic map.
+func decode(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("invalid JSON %s: %v", data, err)
+	}
+	return m
+}
+
+// TestEngineOrders verifies orders are placed, filled, changed, canceled, and reported in Tradier's shapes.
+func TestEngineOrders(t *testing.T) {
+	now := et(19, 10, 0)
+	quotes := map[string]Quote{"AAPL": {Symbol: "AAPL", Bid: 249.9, Ask: 250, Last: 250}}
+	e := testEngine(t, quotes, &now)
+
+	data, err := e.PlaceOrder("PAPER", map[string]string{"class": "equity", "symbol": "aapl", "side": "buy", "quantity": "10", "type": "market", "duration": "day", "tag": "test-1"})
+	if err != nil {
+		t.Fatalf("PlaceOrder() error: %v", err)
+	}
+	if order := decode(t, data)["order"].(map[string]interface{}); order["id"] != 1.0 || order["status"] != "ok" {
+		t.Errorf("PlaceOrder() = %s", data)
+	}
+
+	data, _ = e.PlaceOrder("PAPER", map[string]string{"class": "equity", "symbol": "AAPL", "side": "sell", "quantity": "5", "type": "limit", "price": "260", "duration": "gtc"})
+	if _, err := e.ChangeOrder("PAPER", "2", map[string]string{"price": "255"}); err != nil {
+		t.Fatalf("ChangeOrder() error: %v", err)
+	}
+
+	data, err = e.GetOrders("PAPER", "", "", "true")
+	if err != nil {
+		t.Fatalf("GetOrders() error: %v", err)
+	}
+	orders := decode(t, data)["orders"].(map[string]interface{})["order"].([]interface{})
+	first, second := orders[0].(map[string]interface{}), orders[1].(map[string]interface{})
+	if first["status"] != "filled" || first["avg_fill_price"] != 250.0 || first["tag"] != "test-1" || first["side"] != "buy" {
+		t.Errorf("filled order = %v", first)
+	}
+	if second["status"] != "open" || second["price"] != 255.0 {
+		t.Errorf("changed order = %v", second)
+	}
+
+	// The limit sell fills once the bid reaches it
+	quotes["AAPL"] = Quote{Symbol: "AAPL", Bid: 256, Ask: 256.1, Last: 256}
+	data, _ = e.GetOrder("PAPER", "2", "true")
+	if order := decode(t, data)["order"].(map[string]interface{}); order["status"] != "filled" || order["avg_fill_price"] != 256.0 {
+		t.Errorf("GetOrder() = %s", data)
+	}
+
+	data, _ = e.GetPositions("PAPER")
+	pos := decode(t, data)["positions"].(map[string]interface{})["position"].([]interface{})[0].(map[string]interface{})
+	if pos["symbol"] != "AAPL" || pos["quantity"] != 5.0 || pos["cost_basis"] != 1250.0 {
+		t.Errorf("GetPositions() = %s", data)
+	}
+
+	data, _ = e.GetBalances("PAPER")
+	b := decode(t, data)["balances"].(map[string]interface{})
+	if b["total_cash"] != DefaultCash-2500+1280.0 || b["close_pl"] != 30.0 || b["market_value"] != 1280.25 {
+		t.Errorf("GetBalances() = %s", data)
+	}
+
+	data, _ = e.GetHistory("PAPER", "", "", "trade", "", "")
+	events := decode(t, data)["history"].(map[string]interface{})["event"].([]interface{})
+	if len(events) != 2 || events[0].(map[string]interface{})["trade"].(map[string]interface{})["quantity"] != -5.0 {
+		t.Errorf("GetHistory() = %s, want the sell first", data)
+	}
+
+	// Canceling a filled order fails; a working one is canceled
+	if _, err := e.CancelOrder("PAPER", "1"); err == nil {
+		t.Errorf("CancelOrder(filled) error = nil, want error")
+	}
+	e.PlaceOrder("PAPER", map[string]string{"class": "equity", "symbol": "AAPL", "side": "buy", "quantity": "1", "type": "limit", "price": "1", "duration": "gtc"})
+	if _, err := e.CancelOrder("PAPER", "3"); err != nil {
+		t.Errorf("CancelOrder() error: %v", err)
+	}
+	data, _ = e.GetOrder("PAPER", "3", "")
+	if !strings.Contains(string(data), `"status":"canceled"`) {
+		t.Errorf("canceled order = %s", data)
+	}
+}
+
+// TestNewMemory verifies a memory account trades on the supplied clock without touching disk.
+func TestNewMemory(t *testing.T) {
+	now := et(19, 16, 0)
+	open := false
+	quotes := map[string]Quote{"SPY": {Symbol: "SPY", Bid: 600, Ask: 600, Last: 600}}
+	s := NewState(10000, Commission{PerOrder: 1}, now)
+	e := NewMemory(s, func([]string) (map[string]Quote, error) { return quotes, nil },
+		func(time.Time) (bool, error) { return open, nil }, func() time.Time { return now })
+
+	if _, err := e.PlaceOrder(AccountNumber, map[string]string{"class": "equity", "symbol": "SPY", "side": "buy", "quantity": "10", "type": "market", "duration": "day"}); err != nil {
+		t.Fatalf("PlaceOrder() error: %v", err)
+	}
+	if len(s.Orders) != 1 || s.Orders[0].Status != StatusOpen {
+		t.Fatalf("orders after close = %+v, want one open order", s.Orders)
+	}
+
+	now, open = et(20, 9, 30), true
+	quotes["SPY"] = Quote{Symbol: "SPY", Bid: 602, Ask: 602, Last: 602}
+	if _, err := e.Process(); err != nil {
+		t.Fatalf("Process() error: %v", err)
+	}
+	if s.Orders[0].Status != StatusFilled || !near(s.Cash, 10000-6020-1) || !s.Orders[0].Updated.Equal(now) {
+		t.Errorf("after open: order %+v, cash %v", s.Orders[0], s.Cash)
+	}
+}
+
+// TestEngineRejects verifies invalid orders and orders the account can't support are refused.
+func TestEngineRejects(t *testing.T) {
+	now := et(19, 10, 0)
+	e := testEngine(t, map[string]Quote{"AAPL": {Symbol: "AAPL", Bid: 249.9, Ask: 250, Last: 250}}, &now)
+	base := map[string]string{"class": "equity", "symbol": "AAPL", "side": "buy", "quantity": "10", "type": "market", "duration": "day"}
+	tests := []struct {
+		name   string
+		change map[string]string
+	}{
+		{"missing class", map[string]string{"class": ""}},
+		{"unsupported class", map[string]string{"class": "oto"}},
+		{"bad side", map[string]string{"side": "buy_to_open"}},
+		{"fractional quantity", map[string]string{"quantity": "1.5"}},
+		{"limit without price", map[string]string{"type": "limit"}},
+		{"stop without stop", map[string]string{"type": "stop"}},
+		{"bad duration", map[string]string{"duration": "week"}},
+		{"sell without position", map[string]string{"side": "sell"}},
+		{"insufficient cash", map[string]string{"quantity": "1000"}},
+		{"bad option symbol", map[string]string{"class": "option", "option_symbol": "AAPL", "side": "buy_to_open"}},
+	}
+	for _, tt := range tests {
+		params := map[string]string{}
+		for k, v := range base {
+			params[k] = v
+		}
+		for k, v := range tt.change {
+			params[k] = v
+		}
+		_, err := e.PlaceOrder("PAPER", params)
+		var apiErr *client.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: PlaceOrder() error = %v, want a 400 API error", tt.name, err)
+		}
+	}
+
+	// A preview is priced but not kept
+	params := map[string]string{"preview": "true"}
+	for k, v := range base {
+		params[k] = v
+	}
+	data, err := e.PlaceOrder("PAPER", params)
+	if err != nil || !strings.Contains(string(data), `"cost":2500`) {
+		t.Errorf("preview = %s, %v", data, err)
+	}
+	if data, _ := e.GetOrders("PAPER", "", "", ""); string(data) != `{"orders":"null"}` {
+		t.Errorf("GetOrders() after preview = %s, want none", data)
+	}
+}
+
+// TestTransport verifies a client.Client trades on paper through the transport while other
+// requests reach the server.
+func TestTransport(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"quotes":{"quote":{"symbol":"SPY","type":"etf","bid":600,"ask":600.05,"last":600}}}`))
+	}))
+	defer srv.Close()
+
+	now := et(19, 10, 0)
+	c := client.NewClient(srv.URL, "key")
+	e := New(filepath.Join(t.TempDir(), "paper.json"), ClientQuotes(client.NewClient(srv.URL, "key")), nil)
+	e.now = func() time.Time { return now }
+	c.HTTPClient.Transport = e.Transport(nil)
+
+	if _, err := c.PlaceOrder("PAPER", map[string]string{"class": "equity", "symbol": "SPY", "side": "buy", "quantity": "2", "type": "market", "duration": "day"}); err != nil {
+		t.Fatalf("PlaceOrder() error: %v", err)
+	}
+	data, err := c.GetPositions("PAPER")
+	if err != nil || !strings.Contains(string(data), `"symbol":"SPY"`) {
+		t.Errorf("GetPositions() = %s, %v", data, err)
+	}
+	if _, err := c.GetQuotes("SPY", "false"); err != nil {
+		t.Errorf("GetQuotes() error: %v", err)
+	}
+	if data, err := c.GetProfile(); err != nil || !strings.Contains(string(data), AccountNumber) {
+		t.Errorf("GetProfile() = %s, %v", data, err)
+	}
+
+	_, err = c.GetGainLoss("PAPER", "", "", "", "")
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotImplemented {
+		t.Errorf("GetGainLoss() error = %v, want not implemented", err)
+	}
+	_, err = c.CancelOrder("PAPER", "9")
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("CancelOrder(missing) error = %v, want not found", err)
+	}
+	for _, p := range paths {
+		if strings.HasPrefix(p, "/v1/accounts") || strings.HasPrefix(p, "/v1/user") {
+			t.Errorf("account request %s reached the server", p)
+		}
+	}
+}
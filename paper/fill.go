@@ -0,0 +1,513 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package paper
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// roundLot is the number of shares in one unit of a Tradier equity bid or ask size.
+const roundLot = 100
+
+// settlementRoots maps option roots that differ from their underlying's symbol, such as weekly
+// index options, to the symbol quoted for settlement.
+var settlementRoots = map[string]string{
+	"SPXW": "SPX",
+	"NDXP": "NDX",
+	"RUTW": "RUT",
+	"VIXW": "VIX",
+}
+
+// Quote is the market for one symbol. Sizes are as Tradier reports them: round lots for
+// equities and contracts for options. Type is the security type, e.g. "stock" or "index".
+type Quote struct {
+	Symbol  string  `json:"symbol"`
+	Type    string  `json:"type"`
+	Bid     float64 `json:"bid"`
+	Ask     float64 `json:"ask"`
+	Last    float64 `json:"last"`
+	BidSize float64 `json:"bidsize"`
+	AskSize float64 `json:"asksize"`
+}
+
+// Mark returns the midpoint, or the last price when either side is missing.
+func (q Quote) Mark() float64 {
+	if q.Bid > 0 && q.Ask > 0 {
+		return (q.Bid + q.Ask) / 2
+	}
+	return q.Last
+}
+
+// buying reports whether an order side buys (buy, buy_to_cover, buy_to_open, buy_to_close).
+func buying(side string) bool {
+	return strings.HasPrefix(side, "buy")
+}
+
+// multiplier returns the contract multiplier of a symbol: 100 for options, 1 otherwise.
+func multiplier(symbol string) float64 {
+	if occ.IsOption(symbol) {
+		return 100
+	}
+	return 1
+}
+
+// underlying returns the symbol quoted for an option's settlement price.
+func underlying(root string) string {
+	if s, ok := settlementRoots[root]; ok {
+		return s
+	}
+	return root
+}
+
+// touch returns the price a marketable order trades at: the ask for buys and the bid for
+// sells, or the last price when that side of the market is missing.
+func touch(q Quote, buy bool) float64 {
+	if buy && q.Ask > 0 {
+		return q.Ask
+	}
+	if !buy && q.Bid > 0 {
+		return q.Bid
+	}
+	return q.Last
+}
+
+// displayed returns the quantity shown at the touch, or 0 when the quote has no size.
+func displayed(q Quote, buy, option bool) float64 {
+	size := q.BidSize
+	if buy {
+		size = q.AskSize
+	}
+	if !option {
+		size *= roundLot
+	}
+	return size
+}
+
+// execPrice decides whether a single-leg order trades against the quote and at what price.
+// Market orders take the touch; limit orders trade at the touch once it reaches the limit,
+// so a marketable limit gets the better price. A stop is triggered when the last price (or
+// the touch, without trades) reaches it, and then behaves as a market or limit order.
+func execPrice(o *Order, q Quote) (float64, bool) {
+	buy := buying(o.Legs[0].Side)
+	if o.Type == "stop" || o.Type == "stop_limit" {
+		if !o.Triggered {
+			ref := q.Last
+			if ref <= 0 {
+				ref = touch(q, buy)
+			}
+			if ref <= 0 || (buy && ref < o.Stop) || (!buy && ref > o.Stop) {
+				return 0, false
+			}
+			o.Triggered = true
+		}
+	}
+
+	price := touch(q, buy)
+	if price <= 0 {
+		return 0, false
+	}
+	if o.Type == "limit" || o.Type == "stop_limit" {
+		if (buy && price > o.Price+1e-9) || (!buy && price < o.Price-1e-9) {
+			return 0, false
+		}
+	}
+	return price, true
+}
+
+// spreadUnit returns the greatest common divisor of the leg quantities, the number of
+// spreads a multileg order's quantities describe.
+func spreadUnit(legs []Leg) float64 {
+	gcd := func(a, b int64) int64 {
+		for b != 0 {
+			a, b = b, a%b
+		}
+		return a
+	}
+	var unit int64
+	for _, l := range legs {
+		unit = gcd(unit, int64(math.Round(l.Quantity)))
+	}
+	if unit <= 0 {
+		return 1
+	}
+	return float64(unit)
+}
+
+// netPrice returns the net price of one spread of a multileg order at the touch of every leg,
+// positive for a debit, with each leg's price.
+func netPrice(o *Order, quotes map[string]Quote) (float64, []float64, bool) {
+	unit := spreadUnit(o.Legs)
+	net := 0.0
+	prices := make([]float64, len(o.Legs))
+	for i, l := range o.Legs {
+		buy := buying(l.Side)
+		price := touch(quotes[l.Instrument()], buy)
+		if price <= 0 {
+			return 0, nil, false
+		}
+		prices[i] = price
+		sign := -1.0
+		if buy {
+			sign = 1
+		}
+		net += sign * price * l.Quantity / unit
+	}
+	return net, prices, true
+}
+
+// marketable reports whether a multileg order's net price satisfies its type.
+func marketable(o *Order, net float64) bool {
+	switch o.Type {
+	case "debit":
+		return net <= o.Price+1e-9
+	case "credit":
+		return -net >= o.Price-1e-9
+	case "even":
+		return net <= 1e-9
+	}
+	return true
+}
+
+// sessionClose returns the first regular-session close at or after t: 4 p.m. Eastern on a
+// weekday. Day orders expire then.
+func sessionClose(t time.Time) time.Time {
+	eastern := pricing.Eastern()
+	t = t.In(eastern)
+	close := time.Date(t.Year(), t.Month(), t.Day(), 16, 0, 0, 0, eastern)
+	if !t.Before(close) {
+		close = close.AddDate(0, 0, 1)
+	}
+	for close.Weekday() == time.Saturday || close.Weekday() == time.Sunday {
+		close = close.AddDate(0, 0, 1)
+	}
+	return close
+}
+
+// expired reports whether an option symbol's contract has expired: it stops trading at 4 p.m.
+// Eastern on its expiration date.
+func expired(symbol string, now time.Time) bool {
+	s, err := occ.Parse(symbol)
+	if err != nil {
+		return false
+	}
+	return !now.Before(pricing.Expiry(s.Expiration))
+}
+
+// QuoteSymbols returns the symbols whose quotes the next Process call uses: every instrument
+// of working orders, plus the underlying of each expired option position.
+func (s *State) QuoteSymbols(now time.Time) []string {
+	var out []string
+	for _, o := range s.Orders {
+		if o.Working() {
+			for _, l := range o.Legs {
+				out = append(out, l.Instrument())
+			}
+		}
+	}
+	for _, p := range s.Positions {
+		if expired(p.Symbol, now) {
+			out = append(out, underlying(occ.Underlying(p.Symbol)))
+		}
+	}
+	return out
+}
+
+// Process brings the account up to now and returns the history events it adds. Expired
+// options are exercised, assigned, or expire worthless against their underlying's last price;
+// day orders past the close and orders for expired contracts expire; and, while the market is
+// open, working orders fill as the quotes allow.
+func (s *State) Process(now time.Time, quotes map[string]Quote, open bool) []Event {
+	start := len(s.History)
+	s.settle(now, quotes)
+	for i := range s.Orders {
+		o := &s.Orders[i]
+		if !o.Working() {
+			continue
+		}
+		if o.Duration != "gtc" && !now.Before(sessionClose(o.Created)) {
+			o.Status, o.Updated = StatusExpired, now
+			continue
+		}
+		for _, l := range o.Legs {
+			if expired(l.OptionSymbol, now) {
+				o.Status, o.Updated = StatusExpired, now
+				break
+			}
+		}
+	}
+	if open {
+		for i := range s.Orders {
+			if s.Orders[i].Working() {
+				s.fill(&s.Orders[i], quotes, now)
+			}
+		}
+	}
+	return append([]Event(nil), s.History[start:]...)
+}
+
+// fill trades as much of a working order as its type and the quotes allow.
+func (s *State) fill(o *Order, quotes map[string]Quote, now time.Time) {
+	if o.Class == "multileg" {
+		s.fillMultileg(o, quotes, now)
+		return
+	}
+
+	leg := &o.Legs[0]
+	symbol := leg.Instrument()
+	q, ok := quotes[symbol]
+	if !ok {
+		return
+	}
+	price, ok := execPrice(o, q)
+	if !ok {
+		return
+	}
+	buy := buying(leg.Side)
+	option := o.Class == "option"
+	qty := o.Quantity - o.ExecQuantity
+	if size := displayed(q, buy, option); size > 0 && size < qty {
+		qty = size
+	}
+
+	commission := 0.0
+	if option {
+		commission = s.Commission.PerContract * qty
+	} else if o.ExecQuantity == 0 {
+		commission = s.Commission.PerOrder
+	}
+	mult := multiplier(symbol)
+	if reason := s.check(leg.Side, symbol, qty, price*mult*qty+commission); reason != "" {
+		o.Status, o.Reason, o.Updated = StatusRejected, reason, now
+		return
+	}
+
+	signed := qty
+	if !buy {
+		signed = -qty
+	}
+	s.trade(now, symbol, signed, price, mult, commission)
+	s.recordTrade(now, o.ID, symbol, leg.Side, signed, price, mult, commission)
+
+	leg.AvgFillPrice = (leg.AvgFillPrice*leg.ExecQuantity + price*qty) / (leg.ExecQuantity + qty)
+	leg.ExecQuantity += qty
+	o.AvgFillPrice = leg.AvgFillPrice
+	o.ExecQuantity = leg.ExecQuantity
+	o.LastFillPrice, o.LastFillQuantity = price, qty
+	o.Commission += commission
+	o.Updated = now
+	o.Status = StatusPartiallyFilled
+	if o.ExecQuantity >= o.Quantity-1e-9 {
+		o.Status = StatusFilled
+	}
+}
+
+// fillMultileg fills every leg of a multileg order at once when the net price allows. Spreads
+// are filled whole rather than partially.
+func (s *State) fillMultileg(o *Order, quotes map[string]Quote, now time.Time) {
+	net, prices, ok := netPrice(o, quotes)
+	if !ok || (o.Type != "market" && !marketable(o, net)) {
+		return
+	}
+
+	contracts := 0.0
+	for _, l := range o.Legs {
+		contracts += l.Quantity
+	}
+	commission := s.Commission.PerContract * contracts
+	cost := net*spreadUnit(o.Legs)*100 + commission
+	for i, l := range o.Legs {
+		if reason := s.check(l.Side, l.Instrument(), l.Quantity, cost); reason != "" {
+			o.Status, o.Reason, o.Updated = StatusRejected, fmt.Sprintf("leg %d: %s", i, reason), now
+			return
+		}
+	}
+
+	for i := range o.Legs {
+		l := &o.Legs[i]
+		legCommission := s.Commission.PerContract * l.Quantity
+		signed := l.Quantity
+		if !buying(l.Side) {
+			signed = -l.Quantity
+		}
+		s.trade(now, l.Instrument(), signed, prices[i], 100, legCommission)
+		s.recordTrade(now, o.ID, l.Instrument(), l.Side, signed, prices[i], 100, legCommission)
+		l.ExecQuantity, l.AvgFillPrice = l.Quantity, prices[i]
+	}
+	o.ExecQuantity = o.Quantity
+	o.AvgFillPrice = math.Abs(net)
+	o.LastFillPrice, o.LastFillQuantity = math.Abs(net), o.Quantity
+	o.Commission += commission
+	o.Status, o.Updated = StatusFilled, now
+}
+
+// check returns why a fill can't happen, or "" if it can: buys need the cash to pay for them,
+// and closing sides need the position they close. Cost is the cash a buy needs.
+func (s *State) check(side, symbol string, qty, cost float64) string {
+	held := s.held(symbol)
+	switch side {
+	case "buy", "buy_to_open":
+		if held < 0 {
+			return fmt.Sprintf("%s is held short; use buy_to_cover or buy_to_close", symbol)
+		}
+	case "sell_short", "sell_to_open":
+		if held > 0 {
+			return fmt.Sprintf("%s is held long; use sell or sell_to_close", symbol)
+		}
+	case "sell", "sell_to_close":
+		if held < qty-1e-9 {
+			return fmt.Sprintf("only %s %s held long", formatQuantity(math.Max(held, 0)), symbol)
+		}
+	case "buy_to_cover", "buy_to_close":
+		if -held < qty-1e-9 {
+			return fmt.Sprintf("only %s %s held short", formatQuantity(math.Max(-held, 0)), symbol)
+		}
+	}
+	if buying(side) && cost > s.Cash+1e-9 {
+		return fmt.Sprintf("insufficient cash: need %.2f, have %.2f", cost, s.Cash)
+	}
+	return ""
+}
+
+// held returns the signed quantity of a symbol in the account.
+func (s *State) held(symbol string) float64 {
+	for _, p := range s.Positions {
+		if p.Symbol == symbol {
+			return p.Quantity
+		}
+	}
+	return 0
+}
+
+// trade applies a signed quantity at price to cash and positions. Reducing a position realizes
+// P&L against its average cost; trading through zero opens the remainder on the other side.
+func (s *State) trade(now time.Time, symbol string, qty, price, mult, commission float64) {
+	s.Cash -= qty*price*mult + commission
+
+	i := -1
+	for j, p := range s.Positions {
+		if p.Symbol == symbol {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		s.Positions = append(s.Positions, Position{Symbol: symbol, Quantity: qty, CostBasis: qty * price * mult, DateAcquired: now})
+		return
+	}
+
+	p := &s.Positions[i]
+	if (p.Quantity > 0) == (qty > 0) {
+		p.Quantity += qty
+		p.CostBasis += qty * price * mult
+		return
+	}
+
+	closing := math.Copysign(math.Min(math.Abs(qty), math.Abs(p.Quantity)), qty)
+	basis := p.CostBasis * (-closing / p.Quantity)
+	s.ClosedPL += -closing*price*mult - basis
+	p.Quantity += closing
+	p.CostBasis -= basis
+	remaining := qty - closing
+	if math.Abs(p.Quantity) < 1e-9 {
+		s.Positions = append(s.Positions[:i], s.Positions[i+1:]...)
+	}
+	if math.Abs(remaining) > 1e-9 {
+		s.Positions = append(s.Positions, Position{Symbol: symbol, Quantity: remaining, CostBasis: remaining * price * mult, DateAcquired: now})
+	}
+}
+
+// recordTrade adds a trade to the history.
+func (s *State) recordTrade(now time.Time, orderID int, symbol, side string, qty, price, mult, commission float64) {
+	tradeType := "Equity"
+	if mult != 1 {
+		tradeType = "Option"
+	}
+	s.History = append(s.History, Event{
+		Date:        now,
+		Type:        "trade",
+		Amount:      -qty*price*mult - commission,
+		Symbol:      symbol,
+		Quantity:    qty,
+		Price:       price,
+		Commission:  commission,
+		Description: fmt.Sprintf("%s %s %s @ %.2f", strings.ToUpper(side), formatQuantity(math.Abs(qty)), symbol, price),
+		TradeType:   tradeType,
+		OrderID:     orderID,
+	})
+}
+
+// settle resolves option positions past expiration. An option at least a cent in the money is
+// exercised (long) or assigned (short) at its strike: stock options deliver shares and index
+// options, such as SPX, settle in cash. Anything else expires worthless. Positions whose
+// underlying has no quote are left for a later call.
+func (s *State) settle(now time.Time, quotes map[string]Quote) {
+	var keep []Position
+	var settled []Position
+	for _, p := range s.Positions {
+		if expired(p.Symbol, now) {
+			if q, ok := quotes[underlying(occ.Underlying(p.Symbol))]; ok && q.Last > 0 {
+				settled = append(settled, p)
+				continue
+			}
+		}
+		keep = append(keep, p)
+	}
+	if len(settled) == 0 {
+		return
+	}
+	s.Positions = keep
+
+	for _, p := range settled {
+		contract, _ := occ.Parse(p.Symbol)
+		q := quotes[underlying(contract.Root)]
+		intrinsic := q.Last - contract.Strike
+		if !contract.Call {
+			intrinsic = -intrinsic
+		}
+
+		// The option leaves the account at no value, realizing its premium
+		s.ClosedPL -= p.CostBasis
+		s.History = append(s.History, Event{Date: now, Type: "option", Symbol: p.Symbol, Quantity: -p.Quantity, Description: "Expired", OptionType: "expiration"})
+		event := &s.History[len(s.History)-1]
+		if intrinsic < 0.01 {
+			continue
+		}
+
+		event.OptionType, event.Description = "exercise", fmt.Sprintf("Exercised at %.2f", contract.Strike)
+		if p.Quantity < 0 {
+			event.OptionType, event.Description = "assignment", fmt.Sprintf("Assigned at %.2f", contract.Strike)
+		}
+		if q.Type == "index" {
+			amount := intrinsic * 100 * p.Quantity
+			s.Cash += amount
+			s.ClosedPL += amount
+			event.Amount = amount
+			continue
+		}
+
+		// Long calls and short puts receive shares; long puts and short calls deliver them
+		shares := 100 * p.Quantity
+		if !contract.Call {
+			shares = -shares
+		}
+		side := "buy"
+		if shares < 0 {
+			side = "sell"
+		}
+		symbol := underlying(contract.Root)
+		s.trade(now, symbol, shares, contract.Strike, 1, 0)
+		s.recordTrade(now, 0, symbol, side, shares, contract.Strike, 1, 0)
+	}
+}
+
+// formatQuantity formats a quantity without trailing zeros.
+func formatQuantity(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.4f", f), "0"), ".")
+}
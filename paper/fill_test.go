@@ -0,0 +1,241 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package paper
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// et returns a time on October 2026 in Eastern time.
+func et(day, hour, minute int) time.Time {
+	return time.Date(2026, 10, day, hour, minute, 0, 0, pricing.Eastern())
+}
+
+// near reports whether two amounts are equal to the cent.
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// TestExecPrice verifies market, limit, stop, and stop-limit orders against a quote.
+func TestExecPrice(t *testing.T) {
+	q := Quote{Bid: 99.9, Ask: 100.1, Last: 100}
+	tests := []struct {
+		name  string
+		order Order
+		price float64
+		ok    bool
+	}{
+		{"market buy", Order{Type: "market"}, 100.1, true},
+		{"market sell", Order{Type: "market", Legs: []Leg{{Side: "sell"}}}, 99.9, true},
+		{"limit buy below ask", Order{Type: "limit", Price: 100}, 0, false},
+		{"marketable limit buy", Order{Type: "limit", Price: 101}, 100.1, true},
+		{"limit sell", Order{Type: "limit", Price: 99.5, Legs: []Leg{{Side: "sell"}}}, 99.9, true},
+		{"buy stop not reached", Order{Type: "stop", Stop: 101}, 0, false},
+		{"buy stop reached", Order{Type: "stop", Stop: 100}, 100.1, true},
+		{"sell stop reached", Order{Type: "stop", Stop: 100.5, Legs: []Leg{{Side: "sell"}}}, 99.9, true},
+		{"stop limit above limit", Order{Type: "stop_limit", Stop: 99, Price: 100}, 0, false},
+		{"stop limit", Order{Type: "stop_limit", Stop: 99, Price: 100.25}, 100.1, true},
+	}
+	for _, tt := range tests {
+		if tt.order.Legs == nil {
+			tt.order.Legs = []Leg{{Side: "buy"}}
+		}
+		price, ok := execPrice(&tt.order, q)
+		if ok != tt.ok || !near(price, tt.price) {
+			t.Errorf("%s: execPrice() = %v, %v, want %v, %v", tt.name, price, ok, tt.price, tt.ok)
+		}
+	}
+
+	// A triggered stop limit stays triggered when the price falls back
+	o := Order{Type: "stop_limit", Stop: 100, Price: 99, Legs: []Leg{{Side: "buy"}}}
+	execPrice(&o, q)
+	if !o.Triggered {
+		t.Fatalf("execPrice() did not trigger the stop")
+	}
+	if price, ok := execPrice(&o, Quote{Bid: 98.5, Ask: 98.7, Last: 98.6}); !ok || !near(price, 98.7) {
+		t.Errorf("execPrice(triggered) = %v, %v, want 98.7, true", price, ok)
+	}
+}
+
+// TestTrade verifies cash, cost basis, and realized P&L as positions are opened, reduced, and reversed.
+func TestTrade(t *testing.T) {
+	now := et(19, 10, 0)
+	s := NewState(10000, Commission{}, now)
+	s.trade(now, "AAPL", 10, 100, 1, 1)
+	s.trade(now, "AAPL", -4, 110, 1, 1)
+	if !near(s.Cash, 10000-1000-1+440-1) || !near(s.ClosedPL, 40) {
+		t.Errorf("after sell: cash %v, closed %v", s.Cash, s.ClosedPL)
+	}
+	if p := s.Positions[0]; p.Quantity != 6 || !near(p.CostBasis, 600) {
+		t.Errorf("after sell: position %+v", p)
+	}
+
+	// Selling through zero leaves a short at the fill price
+	s.trade(now, "AAPL", -10, 90, 1, 0)
+	if len(s.Positions) != 1 || s.Positions[0].Quantity != -4 || !near(s.Positions[0].CostBasis, -360) || !near(s.ClosedPL, -20) {
+		t.Errorf("after reversal: %+v, closed %v", s.Positions, s.ClosedPL)
+	}
+	s.trade(now, "AAPL", 4, 80, 1, 0)
+	if len(s.Positions) != 0 || !near(s.ClosedPL, 20) {
+		t.Errorf("after cover: %+v, closed %v", s.Positions, s.ClosedPL)
+	}
+}
+
+// TestProcessFills verifies partial fills at the displayed size, commissions, and rejection for cash.
+func TestProcessFills(t *testing.T) {
+	now := et(19, 10, 0)
+	s := NewState(100000, Commission{PerOrder: 1, PerContract: 0.5}, now)
+	s.Orders = []Order{
+		{ID: 1, Class: "equity", Symbol: "AAPL", Type: "market", Duration: "day", Quantity: 300, Status: StatusOpen, Created: now, Legs: []Leg{{Symbol: "AAPL", Side: "buy", Quantity: 300}}},
+		{ID: 2, Class: "option", Symbol: "AAPL", Type: "limit", Price: 5, Duration: "gtc", Quantity: 2, Status: StatusOpen, Created: now, Legs: []Leg{{Symbol: "AAPL", OptionSymbol: "AAPL261120C00250000", Side: "buy_to_open", Quantity: 2}}},
+		{ID: 3, Class: "equity", Symbol: "NVDA", Type: "market", Duration: "day", Quantity: 1000, Status: StatusOpen, Created: now, Legs: []Leg{{Symbol: "NVDA", Side: "buy", Quantity: 1000}}},
+	}
+	quotes := map[string]Quote{
+		"AAPL":                {Bid: 249.9, Ask: 250, Last: 250, AskSize: 2},
+		"AAPL261120C00250000": {Bid: 4.9, Ask: 5, Last: 5},
+		"NVDA":                {Bid: 199, Ask: 200, Last: 200},
+	}
+
+	events := s.Process(now, quotes, true)
+	if len(events) != 2 {
+		t.Fatalf("Process() events = %+v, want 2 trades", events)
+	}
+	aapl, call, nvda := s.Orders[0], s.Orders[1], s.Orders[2]
+	if aapl.Status != StatusPartiallyFilled || aapl.ExecQuantity != 200 || aapl.Commission != 1 {
+		t.Errorf("equity order = %+v, want 200 filled of 300", aapl)
+	}
+	if call.Status != StatusFilled || call.AvgFillPrice != 5 || call.Commission != 1 {
+		t.Errorf("option order = %+v", call)
+	}
+	if nvda.Status != StatusRejected || nvda.Reason == "" {
+		t.Errorf("order over cash = %+v, want rejected", nvda)
+	}
+	if !near(s.Cash, 100000-50000-1-1000-1) {
+		t.Errorf("cash = %v", s.Cash)
+	}
+
+	// The rest fills on the next pass, with no second per-order commission
+	s.Process(now.Add(time.Minute), quotes, true)
+	if s.Orders[0].Status != StatusFilled || s.Orders[0].Commission != 1 || s.held("AAPL") != 300 {
+		t.Errorf("after second pass: %+v, held %v", s.Orders[0], s.held("AAPL"))
+	}
+
+	// Nothing fills while the market is closed
+	s.Orders = append(s.Orders, Order{ID: 4, Class: "equity", Symbol: "AAPL", Type: "market", Duration: "gtc", Quantity: 1, Status: StatusOpen, Created: now, Legs: []Leg{{Symbol: "AAPL", Side: "sell", Quantity: 1}}})
+	s.Process(now, quotes, false)
+	if s.Orders[3].Status != StatusOpen {
+		t.Errorf("closed market: order %+v, want open", s.Orders[3])
+	}
+}
+
+// TestProcessExpiresDayOrders verifies day orders expire at the close and GTC orders don't.
+func TestProcessExpiresDayOrders(t *testing.T) {
+	friday := et(23, 15, 0)
+	s := NewState(1000, Commission{}, friday)
+	leg := []Leg{{Symbol: "AAPL", Side: "buy", Quantity: 1}}
+	s.Orders = []Order{
+		{ID: 1, Class: "equity", Type: "limit", Price: 1, Duration: "day", Quantity: 1, Status: StatusOpen, Created: friday, Legs: leg},
+		{ID: 2, Class: "equity", Type: "limit", Price: 1, Duration: "gtc", Quantity: 1, Status: StatusOpen, Created: friday, Legs: leg},
+		{ID: 3, Class: "equity", Type: "limit", Price: 1, Duration: "day", Quantity: 1, Status: StatusOpen, Created: et(24, 12, 0), Legs: leg},
+	}
+	s.Process(et(23, 16, 0), nil, false)
+	if s.Orders[0].Status != StatusExpired || s.Orders[1].Status != StatusOpen || s.Orders[2].Status != StatusOpen {
+		t.Errorf("statuses = %s, %s, %s, want expired, open, open", s.Orders[0].Status, s.Orders[1].Status, s.Orders[2].Status)
+	}
+
+	// An order entered on Saturday works through Monday's session
+	s.Process(et(26, 15, 59), nil, false)
+	if s.Orders[2].Status != StatusOpen {
+		t.Errorf("weekend order expired early")
+	}
+	s.Process(et(26, 16, 0), nil, false)
+	if s.Orders[2].Status != StatusExpired {
+		t.Errorf("weekend order = %s, want expired", s.Orders[2].Status)
+	}
+}
+
+// TestMultileg verifies a spread fills as a unit once its net debit is at or below the limit.
+func TestMultileg(t *testing.T) {
+	now := et(19, 10, 0)
+	s := NewState(10000, Commission{PerContract: 0.5}, now)
+	s.Orders = []Order{{
+		ID: 1, Class: "multileg", Symbol: "AAPL", Type: "debit", Price: 2, Duration: "day", Quantity: 2, Status: StatusOpen, Created: now,
+		Legs: []Leg{
+			{Symbol: "AAPL", OptionSymbol: "AAPL261120C00250000", Side: "buy_to_open", Quantity: 2},
+			{Symbol: "AAPL", OptionSymbol: "AAPL261120C00260000", Side: "sell_to_open", Quantity: 2},
+		},
+	}}
+	quotes := map[string]Quote{
+		"AAPL261120C00250000": {Bid: 4.9, Ask: 5.1},
+		"AAPL261120C00260000": {Bid: 3, Ask: 3.2},
+	}
+	s.Process(now, quotes, true)
+	if s.Orders[0].Status != StatusOpen {
+		t.Fatalf("spread at 2.10 debit filled against a 2.00 limit")
+	}
+
+	quotes["AAPL261120C00260000"] = Quote{Bid: 3.15, Ask: 3.3}
+	s.Process(now, quotes, true)
+	o := s.Orders[0]
+	if o.Status != StatusFilled || !near(o.AvgFillPrice, 1.95) || o.Commission != 2 {
+		t.Errorf("spread = %+v, want filled at 1.95", o)
+	}
+	if !near(s.Cash, 10000-1020+630-2) || s.held("AAPL261120C00260000") != -2 {
+		t.Errorf("cash %v, positions %+v", s.Cash, s.Positions)
+	}
+}
+
+// TestSettle verifies exercise, assignment, cash settlement, and worthless expiry at expiration.
+func TestSettle(t *testing.T) {
+	after := et(16, 16, 0)
+	s := NewState(100000, Commission{}, after)
+	s.Positions = []Position{
+		{Symbol: "AAPL261016C00240000", Quantity: 1, CostBasis: 500},
+		{Symbol: "AAPL261016P00260000", Quantity: -2, CostBasis: -300},
+		{Symbol: "AAPL261016C00300000", Quantity: 1, CostBasis: 20},
+		{Symbol: "SPXW261016C06000000", Quantity: 1, CostBasis: 1000},
+		{Symbol: "MSFT261016C00400000", Quantity: 1, CostBasis: 100},
+		{Symbol: "AAPL261120C00250000", Quantity: 1, CostBasis: 700},
+	}
+	quotes := map[string]Quote{
+		"AAPL": {Last: 250, Type: "stock"},
+		"SPX":  {Last: 6020, Type: "index"},
+	}
+
+	if got := s.QuoteSymbols(after); len(got) != 5 {
+		t.Errorf("QuoteSymbols() = %v, want the underlyings of five expired options", got)
+	}
+	s.settle(after, quotes)
+
+	// Long 240 call: buy 100 at 240. Short two 260 puts: buy 200 at 260.
+	if got := s.held("AAPL"); got != 300 {
+		t.Errorf("AAPL shares = %v, want 300", got)
+	}
+	// MSFT has no quote yet and the November call hasn't expired
+	if s.held("MSFT261016C00400000") != 1 || s.held("AAPL261120C00250000") != 1 || s.held("AAPL261016C00300000") != 0 {
+		t.Errorf("positions = %+v", s.Positions)
+	}
+	wantCash := 100000 - 24000 - 52000 + 2000.0
+	if !near(s.Cash, wantCash) {
+		t.Errorf("cash = %v, want %v", s.Cash, wantCash)
+	}
+	// Premiums: -500 + 300 - 20 - 1000, plus 2000 of SPX cash settlement
+	if !near(s.ClosedPL, 780) {
+		t.Errorf("closed P&L = %v, want 780", s.ClosedPL)
+	}
+
+	kinds := map[string]int{}
+	for _, ev := range s.History {
+		if ev.Type == "option" {
+			kinds[ev.OptionType]++
+		}
+	}
+	if kinds["exercise"] != 2 || kinds["assignment"] != 1 || kinds["expiration"] != 1 {
+		t.Errorf("option events = %v", kinds)
+	}
+}
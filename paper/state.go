@@ -0,0 +1,183 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package paper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudmanic/tradier/config"
+)
+
+// stateFile is the file name for the paper account in the tradier config directory.
+const stateFile = "paper.json"
+
+// AccountNumber is the account number reported for the paper account.
+const AccountNumber = "PAPER"
+
+// DefaultCash is the starting cash of a paper account created on first use.
+const DefaultCash = 100000
+
+// Order statuses, as Tradier reports them.
+const (
+	StatusOpen            = "open"
+	StatusPartiallyFilled = "partially_filled"
+	StatusFilled          = "filled"
+	StatusCanceled        = "canceled"
+	StatusExpired         = "expired"
+	StatusRejected        = "rejected"
+)
+
+// Commission is the fee schedule charged on fills.
+type Commission struct {
+	// PerOrder is charged once per equity order, on its first fill.
+	PerOrder float64 `json:"per_order"`
+
+	// PerContract is charged on every option contract filled.
+	PerContract float64 `json:"per_contract"`
+}
+
+// DefaultCommission matches Tradier's standard pricing: free equity trades and $0.35 per
+// option contract.
+var DefaultCommission = Commission{PerContract: 0.35}
+
+// Position is an open holding. Quantity is negative for shorts, and CostBasis is the signed
+// total paid for it, as in Tradier's positions.
+type Position struct {
+	Symbol       string    `json:"symbol"`
+	Quantity     float64   `json:"quantity"`
+	CostBasis    float64   `json:"cost_basis"`
+	DateAcquired time.Time `json:"date_acquired"`
+}
+
+// Leg is one instrument of an order. Single-leg orders have one leg.
+type Leg struct {
+	Symbol       string  `json:"symbol"`
+	OptionSymbol string  `json:"option_symbol,omitempty"`
+	Side         string  `json:"side"`
+	Quantity     float64 `json:"quantity"`
+	ExecQuantity float64 `json:"exec_quantity"`
+	AvgFillPrice float64 `json:"avg_fill_price"`
+}
+
+// Instrument returns the symbol the leg trades: the option symbol for options, otherwise the symbol.
+func (l Leg) Instrument() string {
+	if l.OptionSymbol != "" {
+		return l.OptionSymbol
+	}
+	return l.Symbol
+}
+
+// Order is an order held by the paper account. Price is the limit price, or the net price of a
+// multileg order; Stop is the stop price.
+type Order struct {
+	ID               int       `json:"id"`
+	Class            string    `json:"class"`
+	Symbol           string    `json:"symbol"`
+	Type             string    `json:"type"`
+	Duration         string    `json:"duration"`
+	Price            float64   `json:"price,omitempty"`
+	Stop             float64   `json:"stop,omitempty"`
+	Quantity         float64   `json:"quantity"`
+	Tag              string    `json:"tag,omitempty"`
+	Status           string    `json:"status"`
+	Reason           string    `json:"reason,omitempty"`
+	Legs             []Leg     `json:"legs"`
+	Triggered        bool      `json:"triggered,omitempty"`
+	ExecQuantity     float64   `json:"exec_quantity"`
+	AvgFillPrice     float64   `json:"avg_fill_price"`
+	LastFillPrice    float64   `json:"last_fill_price"`
+	LastFillQuantity float64   `json:"last_fill_quantity"`
+	Commission       float64   `json:"commission"`
+	Created          time.Time `json:"created"`
+	Updated          time.Time `json:"updated"`
+}
+
+// Working reports whether the order can still fill.
+func (o Order) Working() bool {
+	return o.Status == StatusOpen || o.Status == StatusPartiallyFilled
+}
+
+// Event is an entry in the account history: a trade, or an option expiring, being exercised,
+// or being assigned.
+type Event struct {
+	Date        time.Time `json:"date"`
+	Type        string    `json:"type"`
+	Amount      float64   `json:"amount"`
+	Symbol      string    `json:"symbol"`
+	Quantity    float64   `json:"quantity"`
+	Price       float64   `json:"price"`
+	Commission  float64   `json:"commission"`
+	Description string    `json:"description"`
+	TradeType   string    `json:"trade_type,omitempty"`
+	OptionType  string    `json:"option_type,omitempty"`
+	OrderID     int       `json:"order_id,omitempty"`
+}
+
+// State is the whole paper account as stored on disk.
+type State struct {
+	StartingCash float64    `json:"starting_cash"`
+	Cash         float64    `json:"cash"`
+	ClosedPL     float64    `json:"closed_pl"`
+	Commission   Commission `json:"commission"`
+	Positions    []Position `json:"positions"`
+	Orders       []Order    `json:"orders"`
+	History      []Event    `json:"history"`
+	Created      time.Time  `json:"created"`
+}
+
+// NewState returns an empty paper account holding cash.
+func NewState(cash float64, commission Commission, now time.Time) *State {
+	return &State{StartingCash: cash, Cash: cash, Commission: commission, Created: now}
+}
+
+// DefaultPath returns the path of the paper account in the tradier config directory.
+func DefaultPath() (string, error) {
+	dir, err := config.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, stateFile), nil
+}
+
+// Load reads the paper account stored at path. A missing file returns nil.
+func Load(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read paper account: %w", err)
+	}
+
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("unable to parse paper account: %w", err)
+	}
+	return &s, nil
+}
+
+// Save writes the paper account to path, replacing the file atomically.
+func Save(path string, s *State) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal paper account: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create config directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write paper account: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("unable to write paper account: %w", err)
+	}
+	return nil
+}
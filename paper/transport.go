@@ -0,0 +1,116 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package paper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudmanic/tradier/client"
+)
+
+// transport routes account requests to a paper engine and everything else to next.
+type transport struct {
+	engine *Engine
+	next   http.RoundTripper
+}
+
+// Transport returns an http.RoundTripper for a client.Client that answers order, position,
+// balance, history, and profile requests from the paper account and passes every other request,
+// such as market data, to next. Other account endpoints are refused, so nothing reaches a real
+// account while paper trading.
+func (e *Engine) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{engine: e, next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := req.URL.Path
+	if path != "/v1/user/profile" && !strings.HasPrefix(path, "/v1/accounts/") && !strings.HasPrefix(path, "/v1/user/") {
+		return t.next.RoundTrip(req)
+	}
+
+	params := map[string]string{}
+	values := req.URL.Query()
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("invalid request body: %w", err)
+		}
+		for k, v := range form {
+			values[k] = v
+		}
+	}
+	for k := range values {
+		params[k] = values.Get(k)
+	}
+
+	data, err := t.serve(req.Method, path, params)
+	status := http.StatusOK
+	if err != nil {
+		var apiErr *client.APIError
+		if !errors.As(err, &apiErr) {
+			return nil, err
+		}
+		status, data = apiErr.StatusCode, []byte(apiErr.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
+
+// serve dispatches an account request to the engine.
+func (t *transport) serve(method, path string, params map[string]string) ([]byte, error) {
+	if path == "/v1/user/profile" && method == http.MethodGet {
+		return []byte(`{"profile":{"id":"paper","name":"Paper Trading","account":{"account_number":"` + AccountNumber +
+			`","classification":"individual","day_trader":false,"option_level":6,"status":"active","type":"paper"}}}`), nil
+	}
+
+	// /v1/accounts/{id}/{resource}[/{order id}]
+	parts := strings.Split(strings.TrimPrefix(path, "/v1/accounts/"), "/")
+	e := t.engine
+	if len(parts) >= 2 && strings.HasPrefix(path, "/v1/accounts/") {
+		account, resource := parts[0], parts[1]
+		switch {
+		case resource == "orders" && len(parts) == 2 && method == http.MethodGet:
+			return e.GetOrders(account, params["page"], params["limit"], params["includeTags"])
+		case resource == "orders" && len(parts) == 2 && method == http.MethodPost:
+			return e.PlaceOrder(account, params)
+		case resource == "orders" && len(parts) == 3 && method == http.MethodGet:
+			return e.GetOrder(account, parts[2], params["includeTags"])
+		case resource == "orders" && len(parts) == 3 && method == http.MethodPut:
+			return e.ChangeOrder(account, parts[2], params)
+		case resource == "orders" && len(parts) == 3 && method == http.MethodDelete:
+			return e.CancelOrder(account, parts[2])
+		case resource == "positions" && len(parts) == 2 && method == http.MethodGet:
+			return e.GetPositions(account)
+		case resource == "balances" && len(parts) == 2 && method == http.MethodGet:
+			return e.GetBalances(account)
+		case resource == "history" && len(parts) == 2 && method == http.MethodGet:
+			return e.GetHistory(account, params["page"], params["limit"], params["type"], params["start"], params["end"])
+		}
+	}
+	return nil, &client.APIError{StatusCode: http.StatusNotImplemented, Body: fmt.Sprintf("paper trading does not support %s %s", method, path)}
+}
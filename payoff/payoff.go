@@ -0,0 +1,326 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package payoff
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/occ"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// defaultSteps is the number of price points evaluated across the range.
+const defaultSteps = 200
+
+// Leg is one position in a trade. Quantity is signed (negative for short) and Entry is the
+// per-share price paid or received. IV is used to value options before their expiration.
+type Leg struct {
+	Symbol     string    `json:"symbol"`
+	Quantity   float64   `json:"quantity"`
+	Entry      float64   `json:"entry"`
+	IV         float64   `json:"iv"`
+	Option     bool      `json:"option"`
+	Call       bool      `json:"call,omitempty"`
+	Strike     float64   `json:"strike,omitempty"`
+	Expiration time.Time `json:"-"`
+	Multiplier float64   `json:"multiplier"`
+	Underlying string    `json:"underlying"`
+}
+
+// Config controls the price range and model inputs for an analysis. Date is the optional
+// day to value the trade at before expiration, Spot and Vol drive the probability of profit.
+type Config struct {
+	Low      float64
+	High     float64
+	Steps    int
+	Date     time.Time
+	Now      time.Time
+	Spot     float64
+	Vol      float64
+	Rate     float64
+	Dividend float64
+}
+
+// Analysis is the profit and loss profile of a trade across a range of underlying prices.
+// AtExpiration is valued at the first option expiration; AtDate is empty when no date was given.
+type Analysis struct {
+	Prices          []float64 `json:"prices"`
+	AtExpiration    []float64 `json:"at_expiration"`
+	AtDate          []float64 `json:"at_date,omitempty"`
+	Expiration      string    `json:"expiration,omitempty"`
+	Date            string    `json:"date,omitempty"`
+	NetCost         float64   `json:"net_cost"`
+	MaxProfit       float64   `json:"max_profit"`
+	MaxLoss         float64   `json:"max_loss"`
+	UnlimitedProfit bool      `json:"unlimited_profit"`
+	UnlimitedLoss   bool      `json:"unlimited_loss"`
+	Breakevens      []float64 `json:"breakevens"`
+	ProbProfit      float64   `json:"prob_profit"`
+}
+
+// ParseLeg parses a leg written as "QTY SYMBOL" or "QTY SYMBOL@PRICE", for example
+// "+1 AAPL261120C00250000", "-2 AAPL261120C00260000@3.10", or "100 AAPL@245.50".
+// A leg without a price has a zero Entry for the caller to fill from a quote.
+func ParseLeg(s string) (Leg, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Leg{}, fmt.Errorf("invalid leg %q: expected \"QTY SYMBOL[@PRICE]\"", s)
+	}
+	qty, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || qty == 0 {
+		return Leg{}, fmt.Errorf("invalid leg %q: quantity must be a non-zero number", s)
+	}
+
+	symbol, price, hasPrice := strings.Cut(fields[1], "@")
+	leg, err := NewLeg(symbol, qty)
+	if err != nil {
+		return Leg{}, err
+	}
+	if hasPrice {
+		leg.Entry, err = strconv.ParseFloat(price, 64)
+		if err != nil || leg.Entry < 0 {
+			return Leg{}, fmt.Errorf("invalid leg %q: bad price %q", s, price)
+		}
+	}
+	return leg, nil
+}
+
+// NewLeg builds a leg for an option or stock symbol with the given signed quantity.
+func NewLeg(symbol string, qty float64) (Leg, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	leg := Leg{Symbol: symbol, Quantity: qty, Multiplier: 1, Underlying: symbol}
+	if !occ.IsOption(symbol) {
+		return leg, nil
+	}
+	o, err := occ.Parse(symbol)
+	if err != nil {
+		return Leg{}, err
+	}
+	leg.Option = true
+	leg.Call = o.Call
+	leg.Strike = o.Strike
+	leg.Expiration = o.Expiration
+	leg.Multiplier = 100
+	leg.Underlying = occ.Underlying(symbol)
+	return leg, nil
+}
+
+// ValueAt returns the leg's per-share value with the underlying at spot on the given date.
+// Options are valued with Black-Scholes-Merton at the leg's IV before expiration and at
+// intrinsic value on or after it.
+func (l Leg) ValueAt(spot float64, at time.Time, rate, dividend float64) float64 {
+	if !l.Option {
+		return spot
+	}
+	p := pricing.Params{
+		Spot:     spot,
+		Strike:   l.Strike,
+		Years:    pricing.YearsToExpiry(l.Expiration, at),
+		Rate:     rate,
+		Dividend: dividend,
+		Vol:      l.IV,
+		Call:     l.Call,
+	}
+	return pricing.Price(p)
+}
+
+// PnL returns the profit or loss of all legs with the underlying at spot on the given date.
+func PnL(legs []Leg, spot float64, at time.Time, rate, dividend float64) float64 {
+	total := 0.0
+	for _, l := range legs {
+		total += l.Quantity * l.Multiplier * (l.ValueAt(spot, at, rate, dividend) - l.Entry)
+	}
+	return total
+}
+
+// NetCost returns the total debit paid (positive) or credit received (negative) to open the legs.
+func NetCost(legs []Leg) float64 {
+	total := 0.0
+	for _, l := range legs {
+		total += l.Quantity * l.Multiplier * l.Entry
+	}
+	return total
+}
+
+// PriceAtNet adjusts the legs' entry prices so the trade opens at net, the per-share price of
+// one unit of the trade as an order quotes it: positive for a debit, negative for a credit. A
+// unit is the smallest leg quantity, so a 1x2 ratio spread of 2 and 4 contracts is two units.
+// The difference from the current entries is split evenly across every contract, with long
+// legs paying more and short legs receiving less for a higher debit.
+func PriceAtNet(legs []Leg, net float64) {
+	var units, contracts, current float64
+	for _, l := range legs {
+		q := math.Abs(l.Quantity)
+		if units == 0 || q < units {
+			units = q
+		}
+		contracts += q
+		current += l.Quantity * l.Entry
+	}
+	if contracts == 0 {
+		return
+	}
+	shift := (net*units - current) / contracts
+	for i, l := range legs {
+		if l.Quantity > 0 {
+			legs[i].Entry += shift
+		} else {
+			legs[i].Entry -= shift
+		}
+	}
+}
+
+// FirstExpiration returns the earliest option expiration among the legs, or the zero time if none.
+func FirstExpiration(legs []Leg) time.Time {
+	var first time.Time
+	for _, l := range legs {
+		if l.Option && (first.IsZero() || l.Expiration.Before(first)) {
+			first = l.Expiration
+		}
+	}
+	return first
+}
+
+// DefaultRange returns a price range covering two and a half standard deviations of the expected
+// move to the first expiration, widened so every strike sits at least 5% inside the edges.
+func DefaultRange(legs []Leg, spot, vol float64, now time.Time) (float64, float64) {
+	move := spot * 0.2
+	if exp := FirstExpiration(legs); vol > 0 && !exp.IsZero() {
+		move = 2.5 * spot * vol * math.Sqrt(math.Max(pricing.YearsToExpiry(exp, now), 1.0/365))
+	}
+	low, high := spot-move, spot+move
+	for _, l := range legs {
+		if l.Option {
+			low = math.Min(low, l.Strike*0.95)
+			high = math.Max(high, l.Strike*1.05)
+		}
+	}
+	return math.Max(low, 0), high
+}
+
+// Analyze evaluates the legs across the configured price range at the first expiration and,
+// when a date is set, on that date. Max profit and loss consider every strike and a zero
+// underlying price, and are flagged unlimited when the payoff keeps rising or falling above the range.
+func Analyze(legs []Leg, cfg Config) Analysis {
+	if cfg.Steps <= 1 {
+		cfg.Steps = defaultSteps
+	}
+	if cfg.Now.IsZero() {
+		cfg.Now = time.Now()
+	}
+
+	exp := FirstExpiration(legs)
+	expAt := pricing.Expiry(exp)
+	if exp.IsZero() {
+		expAt = cfg.Now
+	}
+
+	a := Analysis{NetCost: NetCost(legs)}
+	if !exp.IsZero() {
+		a.Expiration = exp.Format("2006-01-02")
+	}
+	if !cfg.Date.IsZero() {
+		a.Date = cfg.Date.Format("2006-01-02")
+	}
+	for i := 0; i < cfg.Steps; i++ {
+		price := cfg.Low + (cfg.High-cfg.Low)*float64(i)/float64(cfg.Steps-1)
+		a.Prices = append(a.Prices, price)
+		a.AtExpiration = append(a.AtExpiration, PnL(legs, price, expAt, cfg.Rate, cfg.Dividend))
+		if !cfg.Date.IsZero() {
+			a.AtDate = append(a.AtDate, PnL(legs, price, pricing.Expiry(cfg.Date), cfg.Rate, cfg.Dividend))
+		}
+	}
+
+	// Extremes of a payoff at expiration occur at strikes or at the ends of the price axis
+	candidates := []float64{0, cfg.Low, cfg.High}
+	slope := 0.0
+	for _, l := range legs {
+		if l.Option {
+			candidates = append(candidates, l.Strike)
+		}
+		if !l.Option || l.Call {
+			slope += l.Quantity * l.Multiplier
+		}
+	}
+	a.MaxProfit, a.MaxLoss = math.Inf(-1), math.Inf(1)
+	for _, price := range candidates {
+		v := PnL(legs, price, expAt, cfg.Rate, cfg.Dividend)
+		a.MaxProfit = math.Max(a.MaxProfit, v)
+		a.MaxLoss = math.Min(a.MaxLoss, v)
+	}
+	for _, v := range a.AtExpiration {
+		a.MaxProfit = math.Max(a.MaxProfit, v)
+		a.MaxLoss = math.Min(a.MaxLoss, v)
+	}
+	a.UnlimitedProfit = slope > 1e-9
+	a.UnlimitedLoss = slope < -1e-9
+
+	a.Breakevens = breakevens(a.Prices, a.AtExpiration)
+	if exp.IsZero() || cfg.Vol <= 0 || cfg.Spot <= 0 {
+		a.ProbProfit = -1
+	} else {
+		a.ProbProfit = probProfit(a.Prices, a.AtExpiration, pricing.Params{
+			Spot:     cfg.Spot,
+			Years:    pricing.YearsToExpiry(exp, cfg.Now),
+			Rate:     cfg.Rate,
+			Dividend: cfg.Dividend,
+			Vol:      cfg.Vol,
+		})
+	}
+	return a
+}
+
+// breakevens returns the prices where the P&L crosses zero, linearly interpolated between points.
+func breakevens(prices, pnl []float64) []float64 {
+	var out []float64
+	for i := range prices {
+		if pnl[i] == 0 {
+			if i == 0 || pnl[i-1] != 0 {
+				out = append(out, prices[i])
+			}
+			continue
+		}
+		if i == 0 {
+			continue
+		}
+		if a, b := pnl[i-1], pnl[i]; (a < 0 && b > 0) || (a > 0 && b < 0) {
+			out = append(out, prices[i-1]+(prices[i]-prices[i-1])*(-a)/(b-a))
+		}
+	}
+	sort.Float64s(out)
+	return out
+}
+
+// probProfit integrates the lognormal distribution of the underlying at expiration over the
+// price intervals where the P&L is positive, including the tails beyond the range.
+func probProfit(prices, pnl []float64, p pricing.Params) float64 {
+	// cdf is the probability the underlying closes at or below x
+	cdf := func(x float64) float64 {
+		if x <= 0 {
+			return 0
+		}
+		q := p
+		q.Strike, q.Call = x, true
+		return 1 - pricing.ProbITM(q)
+	}
+
+	prob := 0.0
+	if pnl[0] > 0 {
+		prob += cdf(prices[0])
+	}
+	for i := 1; i < len(prices); i++ {
+		if (pnl[i-1]+pnl[i])/2 > 0 {
+			prob += cdf(prices[i]) - cdf(prices[i-1])
+		}
+	}
+	if pnl[len(pnl)-1] > 0 {
+		prob += 1 - cdf(prices[len(prices)-1])
+	}
+	return prob
+}
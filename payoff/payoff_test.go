@@ -0,0 +1,138 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package payoff
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// mustLeg parses a leg or fails the test.
+func mustLeg(t *testing.T, s string) Leg {
+	t.Helper()
+	l, err := ParseLeg(s)
+	if err != nil {
+		t.Fatalf("ParseLeg(%q) error: %v", s, err)
+	}
+	return l
+}
+
+// analysisTime is a fixed clock well before the test expirations.
+var analysisTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// TestParseLeg verifies option and stock legs, signed quantities, and entry prices.
+func TestParseLeg(t *testing.T) {
+	call := mustLeg(t, "-2 aapl261120C00250000@3.10")
+	if !call.Option || !call.Call || call.Strike != 250 || call.Quantity != -2 || call.Entry != 3.10 || call.Multiplier != 100 || call.Underlying != "AAPL" {
+		t.Errorf("ParseLeg(call) = %+v", call)
+	}
+	stock := mustLeg(t, "+100 AAPL")
+	if stock.Option || stock.Multiplier != 1 || stock.Quantity != 100 || stock.Entry != 0 {
+		t.Errorf("ParseLeg(stock) = %+v", stock)
+	}
+	for _, bad := range []string{"AAPL", "0 AAPL", "x AAPL", "1 AAPL@abc", "1 AAPL extra"} {
+		if _, err := ParseLeg(bad); err == nil {
+			t.Errorf("ParseLeg(%q) expected error", bad)
+		}
+	}
+}
+
+// TestAnalyzeVertical verifies a debit call spread's limits, breakeven, and cost.
+func TestAnalyzeVertical(t *testing.T) {
+	legs := []Leg{
+		mustLeg(t, "1 XYZ261120C00100000@4.00"),
+		mustLeg(t, "-1 XYZ261120C00110000@1.50"),
+	}
+	a := Analyze(legs, Config{Low: 80, High: 130, Steps: 501, Now: analysisTime, Spot: 100, Vol: 0.3})
+	if a.NetCost != 250 {
+		t.Errorf("NetCost = %v, want 250", a.NetCost)
+	}
+	if math.Abs(a.MaxProfit-750) > 1e-6 || math.Abs(a.MaxLoss+250) > 1e-6 {
+		t.Errorf("MaxProfit = %v, MaxLoss = %v, want 750 and -250", a.MaxProfit, a.MaxLoss)
+	}
+	if a.UnlimitedProfit || a.UnlimitedLoss {
+		t.Error("vertical spread should have limited risk and reward")
+	}
+	if len(a.Breakevens) != 1 || math.Abs(a.Breakevens[0]-102.5) > 1e-6 {
+		t.Errorf("Breakevens = %v, want [102.5]", a.Breakevens)
+	}
+	if a.ProbProfit <= 0.2 || a.ProbProfit >= 0.6 {
+		t.Errorf("ProbProfit = %v, want between 0.2 and 0.6", a.ProbProfit)
+	}
+	if a.Expiration != "2026-11-20" {
+		t.Errorf("Expiration = %q", a.Expiration)
+	}
+}
+
+// TestPriceAtNet verifies entries are shifted so the trade opens at the order's net price.
+func TestPriceAtNet(t *testing.T) {
+	legs := []Leg{
+		mustLeg(t, "2 XYZ261120C00100000@4.00"),
+		mustLeg(t, "-2 XYZ261120C00110000@1.50"),
+	}
+	PriceAtNet(legs, 2.70)
+	if math.Abs(legs[0].Entry-4.10) > 1e-9 || math.Abs(legs[1].Entry-1.40) > 1e-9 || math.Abs(NetCost(legs)-540) > 1e-9 {
+		t.Errorf("PriceAtNet(debit) entries = %v, %v, net cost %v, want 4.10, 1.40, 540", legs[0].Entry, legs[1].Entry, NetCost(legs))
+	}
+
+	short := []Leg{mustLeg(t, "-1 XYZ261120P00090000@2.00")}
+	PriceAtNet(short, -2.20)
+	if math.Abs(short[0].Entry-2.20) > 1e-9 {
+		t.Errorf("PriceAtNet(short) entry = %v, want the 2.20 limit", short[0].Entry)
+	}
+}
+
+// TestBreakevens verifies crossings between points and zeros on the grid, including the last point.
+func TestBreakevens(t *testing.T) {
+	prices := []float64{90, 100, 110, 120}
+	if got := breakevens(prices, []float64{-10, 10, 5, 0}); len(got) != 2 || got[0] != 95 || got[1] != 120 {
+		t.Errorf("breakevens() = %v, want [95 120]", got)
+	}
+	if got := breakevens(prices, []float64{0, 0, 5, 5}); len(got) != 1 || got[0] != 90 {
+		t.Errorf("breakevens(flat zero) = %v, want [90]", got)
+	}
+}
+
+// TestAnalyzeUnlimited verifies naked calls report unlimited loss and long calls unlimited profit.
+func TestAnalyzeUnlimited(t *testing.T) {
+	short := Analyze([]Leg{mustLeg(t, "-1 XYZ261120C00100000@2.00")}, Config{Low: 80, High: 120, Now: analysisTime})
+	if !short.UnlimitedLoss || short.UnlimitedProfit || short.MaxProfit != 200 {
+		t.Errorf("short call = unlimited loss %v, profit %v, max profit %v", short.UnlimitedLoss, short.UnlimitedProfit, short.MaxProfit)
+	}
+	if short.ProbProfit != -1 {
+		t.Errorf("ProbProfit without spot and vol = %v, want -1", short.ProbProfit)
+	}
+
+	// A covered call's stock and short call offset above the strike
+	covered := Analyze([]Leg{mustLeg(t, "100 XYZ@98"), mustLeg(t, "-1 XYZ261120C00100000@2.00")}, Config{Low: 80, High: 120, Now: analysisTime})
+	if covered.UnlimitedProfit || covered.UnlimitedLoss || math.Abs(covered.MaxProfit-400) > 1e-6 || math.Abs(covered.MaxLoss+9600) > 1e-6 {
+		t.Errorf("covered call max profit %v, max loss %v, unlimited %v/%v", covered.MaxProfit, covered.MaxLoss, covered.UnlimitedProfit, covered.UnlimitedLoss)
+	}
+}
+
+// TestAnalyzeAtDate verifies valuing before expiration keeps time value in the options.
+func TestAnalyzeAtDate(t *testing.T) {
+	leg := mustLeg(t, "1 XYZ261120C00100000@4.00")
+	leg.IV = 0.3
+	a := Analyze([]Leg{leg}, Config{Low: 90, High: 110, Steps: 3, Date: time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), Now: analysisTime})
+	if len(a.AtDate) != 3 {
+		t.Fatalf("AtDate = %v", a.AtDate)
+	}
+	if a.AtDate[1] <= a.AtExpiration[1] {
+		t.Errorf("at-the-money P&L before expiry %v should exceed expiry P&L %v", a.AtDate[1], a.AtExpiration[1])
+	}
+	if a.Date != "2026-10-20" {
+		t.Errorf("Date = %q", a.Date)
+	}
+}
+
+// TestDefaultRange verifies the range covers every strike with padding.
+func TestDefaultRange(t *testing.T) {
+	legs := []Leg{mustLeg(t, "1 XYZ261120P00050000"), mustLeg(t, "1 XYZ261120C00150000")}
+	low, high := DefaultRange(legs, 100, 0.1, analysisTime)
+	if low > 47.5 || high < 157.5 || low < 0 {
+		t.Errorf("DefaultRange() = %v, %v", low, high)
+	}
+}
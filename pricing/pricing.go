@@ -0,0 +1,393 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// minVol and maxVol bound the implied volatility search.
+	minVol = 0.0001
+	maxVol = 5.0
+
+	// binomialSteps is the number of steps in the American option tree. Prices are averaged
+	// over this and the next step count to damp the odd/even oscillation of CRR trees.
+	binomialSteps = 200
+)
+
+// Params describes an option to price. Years is the time to expiration, Rate the continuously
+// compounded risk-free rate, Dividend the continuous dividend yield, and Vol the annualized volatility.
+type Params struct {
+	Spot     float64
+	Strike   float64
+	Years    float64
+	Rate     float64
+	Dividend float64
+	Vol      float64
+	Call     bool
+	American bool
+}
+
+// Greeks are an option's price sensitivities. Theta and charm are per calendar day,
+// vega, vomma, and vanna are per one volatility point, and rho is per one percent rate change.
+type Greeks struct {
+	Price float64 `json:"price"`
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Theta float64 `json:"theta"`
+	Vega  float64 `json:"vega"`
+	Rho   float64 `json:"rho"`
+	Vanna float64 `json:"vanna"`
+	Charm float64 `json:"charm"`
+	Vomma float64 `json:"vomma"`
+}
+
+// ErrNoSolution is returned when no volatility reproduces the target price.
+var ErrNoSolution = errors.New("no implied volatility matches the price")
+
+// Price returns the theoretical value of the option, using Black-Scholes-Merton for
+// European options and a Cox-Ross-Rubinstein binomial tree for American options.
+func Price(p Params) float64 {
+	if p.Years <= 0 || p.Vol <= 0 {
+		return Intrinsic(p)
+	}
+	if p.American {
+		return (binomial(p, binomialSteps) + binomial(p, binomialSteps+1)) / 2
+	}
+	return blackScholes(p)
+}
+
+// Intrinsic returns the value of exercising the option immediately.
+func Intrinsic(p Params) float64 {
+	if p.Call {
+		return math.Max(p.Spot-p.Strike, 0)
+	}
+	return math.Max(p.Strike-p.Spot, 0)
+}
+
+// Compute returns the price and greeks of the option. European greeks are closed-form;
+// American greeks are central finite differences of the binomial price.
+func Compute(p Params) Greeks {
+	if p.Years <= 0 || p.Vol <= 0 {
+		g := Greeks{Price: Intrinsic(p)}
+		if g.Price > 0 {
+			g.Delta = 1
+			if !p.Call {
+				g.Delta = -1
+			}
+		}
+		return g
+	}
+	if p.American {
+		return finiteDifference(p)
+	}
+	return closedForm(p)
+}
+
+// ImpliedVol solves for the volatility at which the option's theoretical value equals price.
+// Newton's method is tried first and Brent's method on [0.0001, 5] is used when it fails to converge.
+func ImpliedVol(p Params, price float64) (float64, error) {
+	if p.Years <= 0 || p.Spot <= 0 || p.Strike <= 0 {
+		return 0, fmt.Errorf("%w: option is expired or inputs are invalid", ErrNoSolution)
+	}
+
+	f := func(vol float64) float64 {
+		q := p
+		q.Vol = vol
+		return Price(q) - price
+	}
+	lo, hi := f(minVol), f(maxVol)
+	if lo > 0 || hi < 0 {
+		return 0, fmt.Errorf("%w: %.4f is outside the arbitrage bounds", ErrNoSolution, price)
+	}
+
+	// Brenner-Subrahmanyam approximation as the Newton starting point
+	vol := math.Sqrt(2*math.Pi/p.Years) * price / p.Spot
+	if vol < 0.05 || vol > 2 {
+		vol = 0.3
+	}
+	for i := 0; i < 50; i++ {
+		q := p
+		q.Vol = vol
+		diff := Price(q) - price
+		if math.Abs(diff) < 1e-6 {
+			return vol, nil
+		}
+		vega := rawVega(q)
+		if vega < 1e-8 {
+			break
+		}
+		vol -= diff / vega
+		if vol <= minVol || vol >= maxVol || math.IsNaN(vol) {
+			break
+		}
+	}
+
+	return brent(f, minVol, maxVol, 1e-8)
+}
+
+// ProbITM returns the risk-neutral probability that the option expires in the money, N(d2) for calls
+// and N(-d2) for puts. Expired or zero-volatility options return 1 or 0 from their intrinsic value.
+func ProbITM(p Params) float64 {
+	if p.Years <= 0 || p.Vol <= 0 {
+		if Intrinsic(p) > 0 {
+			return 1
+		}
+		return 0
+	}
+	_, d2 := d1d2(p)
+	if p.Call {
+		return NormCDF(d2)
+	}
+	return NormCDF(-d2)
+}
+
+// ProbTouch returns the risk-neutral probability that the underlying trades at the strike at any time
+// before expiration, treating the strike as a barrier above or below spot. Uses the reflection
+// principle for geometric Brownian motion with drift r - q - vol²/2. The Call field is ignored.
+func ProbTouch(p Params) float64 {
+	if p.Spot <= 0 || p.Strike <= 0 {
+		return 0
+	}
+	if p.Spot == p.Strike {
+		return 1
+	}
+	if p.Years <= 0 || p.Vol <= 0 {
+		return 0
+	}
+
+	sigmaT := p.Vol * math.Sqrt(p.Years)
+	mu := p.Rate - p.Dividend - p.Vol*p.Vol/2
+	x := math.Log(p.Strike / p.Spot)
+	power := math.Pow(p.Strike/p.Spot, 2*mu/(p.Vol*p.Vol))
+	if p.Strike > p.Spot {
+		return NormCDF((-x+mu*p.Years)/sigmaT) + power*NormCDF((-x-mu*p.Years)/sigmaT)
+	}
+	return NormCDF((x-mu*p.Years)/sigmaT) + power*NormCDF((x+mu*p.Years)/sigmaT)
+}
+
+// Expiry returns the moment an option expiring on the given date stops trading: 4:00 PM Eastern.
+func Expiry(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 16, 0, 0, 0, Eastern())
+}
+
+// YearsToExpiry returns the time from now until the date's 4:00 PM Eastern close in years, floored at zero.
+func YearsToExpiry(date, now time.Time) float64 {
+	years := Expiry(date).Sub(now).Hours() / 24 / 365
+	if years < 0 {
+		return 0
+	}
+	return years
+}
+
+// Eastern returns the America/New_York location, falling back to a fixed EST offset
+// when the system has no timezone database.
+func Eastern() *time.Location {
+	if loc, err := time.LoadLocation("America/New_York"); err == nil {
+		return loc
+	}
+	return time.FixedZone("EST", -5*60*60)
+}
+
+// NormCDF is the standard normal cumulative distribution function.
+func NormCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// normPDF is the standard normal probability density function.
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+// d1d2 returns the Black-Scholes-Merton d1 and d2 terms.
+func d1d2(p Params) (float64, float64) {
+	sqrtT := math.Sqrt(p.Years)
+	d1 := (math.Log(p.Spot/p.Strike) + (p.Rate-p.Dividend+p.Vol*p.Vol/2)*p.Years) / (p.Vol * sqrtT)
+	return d1, d1 - p.Vol*sqrtT
+}
+
+// blackScholes prices a European option with a continuous dividend yield.
+func blackScholes(p Params) float64 {
+	d1, d2 := d1d2(p)
+	spot := p.Spot * math.Exp(-p.Dividend*p.Years)
+	strike := p.Strike * math.Exp(-p.Rate*p.Years)
+	if p.Call {
+		return spot*NormCDF(d1) - strike*NormCDF(d2)
+	}
+	return strike*NormCDF(-d2) - spot*NormCDF(-d1)
+}
+
+// closedForm returns the analytic Black-Scholes-Merton greeks.
+func closedForm(p Params) Greeks {
+	d1, d2 := d1d2(p)
+	t := p.Years
+	sqrtT := math.Sqrt(t)
+	dq := math.Exp(-p.Dividend * t)
+	dr := math.Exp(-p.Rate * t)
+	pdf := normPDF(d1)
+
+	vega := p.Spot * dq * pdf * sqrtT
+	g := Greeks{
+		Price: blackScholes(p),
+		Gamma: dq * pdf / (p.Spot * p.Vol * sqrtT),
+		Vega:  vega / 100,
+		Vanna: -dq * pdf * d2 / p.Vol / 100,
+		Vomma: vega * d1 * d2 / p.Vol / 100 / 100,
+	}
+
+	decay := -p.Spot * dq * pdf * p.Vol / (2 * sqrtT)
+	charm := dq * pdf * (2*(p.Rate-p.Dividend)*t - d2*p.Vol*sqrtT) / (2 * t * p.Vol * sqrtT)
+	if p.Call {
+		g.Delta = dq * NormCDF(d1)
+		g.Theta = (decay - p.Rate*p.Strike*dr*NormCDF(d2) + p.Dividend*p.Spot*dq*NormCDF(d1)) / 365
+		g.Rho = p.Strike * t * dr * NormCDF(d2) / 100
+		g.Charm = (p.Dividend*dq*NormCDF(d1) - charm) / 365
+	} else {
+		g.Delta = -dq * NormCDF(-d1)
+		g.Theta = (decay + p.Rate*p.Strike*dr*NormCDF(-d2) - p.Dividend*p.Spot*dq*NormCDF(-d1)) / 365
+		g.Rho = -p.Strike * t * dr * NormCDF(-d2) / 100
+		g.Charm = (-p.Dividend*dq*NormCDF(-d1) - charm) / 365
+	}
+	return g
+}
+
+// binomial prices an American option on a Cox-Ross-Rubinstein tree with the given number of steps.
+func binomial(p Params, steps int) float64 {
+	dt := p.Years / float64(steps)
+	u := math.Exp(p.Vol * math.Sqrt(dt))
+	d := 1 / u
+	disc := math.Exp(-p.Rate * dt)
+	prob := (math.Exp((p.Rate-p.Dividend)*dt) - d) / (u - d)
+
+	payoff := func(spot float64) float64 {
+		if p.Call {
+			return math.Max(spot-p.Strike, 0)
+		}
+		return math.Max(p.Strike-spot, 0)
+	}
+
+	// With d = 1/u the spot at any node is Spot * u^k for k in [-steps, steps]
+	spots := make([]float64, 2*steps+1)
+	for k := range spots {
+		spots[k] = p.Spot * math.Pow(u, float64(k-steps))
+	}
+
+	values := make([]float64, steps+1)
+	for i := 0; i <= steps; i++ {
+		values[i] = payoff(spots[2*(steps-i)])
+	}
+	for step := steps - 1; step >= 0; step-- {
+		for i := 0; i <= step; i++ {
+			cont := disc * (prob*values[i] + (1-prob)*values[i+1])
+			values[i] = math.Max(cont, payoff(spots[steps+step-2*i]))
+		}
+	}
+	return values[0]
+}
+
+// finiteDifference estimates greeks by bumping the inputs of the pricing function.
+func finiteDifference(p Params) Greeks {
+	price := func(spot, vol, years, rate float64) float64 {
+		q := p
+		q.Spot, q.Vol, q.Years, q.Rate = spot, vol, years, rate
+		return Price(q)
+	}
+
+	s, v, t, r := p.Spot, p.Vol, p.Years, p.Rate
+	ds := s * 0.01
+	dv := 0.01
+	dt := math.Min(1.0/365, t/2)
+
+	mid := price(s, v, t, r)
+	up := price(s+ds, v, t, r)
+	down := price(s-ds, v, t, r)
+	volUp := price(s, v+dv, t, r)
+	volDown := price(s, v-dv, t, r)
+
+	g := Greeks{
+		Price: mid,
+		Delta: (up - down) / (2 * ds),
+		Gamma: (up - 2*mid + down) / (ds * ds),
+		Theta: (price(s, v, t-dt, r) - mid) / (dt * 365),
+		Vega:  (volUp - volDown) / 2,
+		Rho:   (price(s, v, t, r+0.0001) - price(s, v, t, r-0.0001)) / 2 * 100,
+		Vomma: (volUp - 2*mid + volDown),
+	}
+	g.Vanna = ((price(s+ds, v+dv, t, r) - price(s+ds, v-dv, t, r)) - (price(s-ds, v+dv, t, r) - price(s-ds, v-dv, t, r))) / (4 * ds)
+	deltaLater := (price(s+ds, v, t-dt, r) - price(s-ds, v, t-dt, r)) / (2 * ds)
+	g.Charm = (deltaLater - g.Delta) / (dt * 365)
+	return g
+}
+
+// rawVega returns dPrice/dVol per unit of volatility, used as the Newton derivative.
+func rawVega(p Params) float64 {
+	if !p.American {
+		d1, _ := d1d2(p)
+		return p.Spot * math.Exp(-p.Dividend*p.Years) * normPDF(d1) * math.Sqrt(p.Years)
+	}
+	h := 0.001
+	up, down := p, p
+	up.Vol += h
+	down.Vol -= h
+	return (Price(up) - Price(down)) / (2 * h)
+}
+
+// brent finds a root of f in [a, b] using Brent's method. f(a) and f(b) must bracket zero.
+func brent(f func(float64) float64, a, b, tol float64) (float64, error) {
+	fa, fb := f(a), f(b)
+	if fa*fb > 0 {
+		return 0, ErrNoSolution
+	}
+	if math.Abs(fa) < math.Abs(fb) {
+		a, b, fa, fb = b, a, fb, fa
+	}
+	c, fc := a, fa
+	d := b - a
+	bisected := true
+
+	for i := 0; i < 200; i++ {
+		if fb == 0 || math.Abs(b-a) < tol {
+			return b, nil
+		}
+		var s float64
+		if fa != fc && fb != fc {
+			// Inverse quadratic interpolation
+			s = a*fb*fc/((fa-fb)*(fa-fc)) + b*fa*fc/((fb-fa)*(fb-fc)) + c*fa*fb/((fc-fa)*(fc-fb))
+		} else {
+			// Secant step
+			s = b - fb*(b-a)/(fb-fa)
+		}
+
+		lo, hi := (3*a+b)/4, b
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if s < lo || s > hi ||
+			(bisected && math.Abs(s-b) >= math.Abs(b-c)/2) ||
+			(!bisected && math.Abs(s-b) >= math.Abs(c-d)/2) ||
+			(bisected && math.Abs(b-c) < tol) ||
+			(!bisected && math.Abs(c-d) < tol) {
+			s = (a + b) / 2
+			bisected = true
+		} else {
+			bisected = false
+		}
+
+		fs := f(s)
+		d, c, fc = c, b, fb
+		if fa*fs < 0 {
+			b, fb = s, fs
+		} else {
+			a, fa = s, fs
+		}
+		if math.Abs(fa) < math.Abs(fb) {
+			a, b, fa, fb = b, a, fb, fa
+		}
+	}
+	return b, nil
+}
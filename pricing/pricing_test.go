@@ -0,0 +1,167 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package pricing
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// near reports whether got is within tol of want.
+func near(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol
+}
+
+// TestBlackScholes verifies European prices against textbook values and put-call parity.
+func TestBlackScholes(t *testing.T) {
+	// Hull, Options Futures and Other Derivatives: S=42, K=40, r=10%, vol=20%, T=0.5
+	call := Params{Spot: 42, Strike: 40, Years: 0.5, Rate: 0.1, Vol: 0.2, Call: true}
+	put := call
+	put.Call = false
+	if got := Price(call); !near(got, 4.76, 0.01) {
+		t.Errorf("call Price() = %v, want 4.76", got)
+	}
+	if got := Price(put); !near(got, 0.81, 0.01) {
+		t.Errorf("put Price() = %v, want 0.81", got)
+	}
+
+	div := Params{Spot: 100, Strike: 95, Years: 0.75, Rate: 0.04, Dividend: 0.02, Vol: 0.3, Call: true}
+	divPut := div
+	divPut.Call = false
+	parity := div.Spot*math.Exp(-div.Dividend*div.Years) - div.Strike*math.Exp(-div.Rate*div.Years)
+	if got := Price(div) - Price(divPut); !near(got, parity, 1e-9) {
+		t.Errorf("put-call parity = %v, want %v", got, parity)
+	}
+}
+
+// TestAmerican verifies the binomial tree against known values and early-exercise bounds.
+func TestAmerican(t *testing.T) {
+	// Hull: S=50, K=50, r=10%, vol=40%, T=5/12 American put is about 4.28
+	put := Params{Spot: 50, Strike: 50, Years: 5.0 / 12, Rate: 0.1, Vol: 0.4, American: true}
+	if got := Price(put); !near(got, 4.28, 0.02) {
+		t.Errorf("American put Price() = %v, want ~4.28", got)
+	}
+	european := put
+	european.American = false
+	if Price(put) <= Price(european) {
+		t.Errorf("American put %v should exceed European %v", Price(put), Price(european))
+	}
+
+	// Without dividends an American call is never exercised early
+	call := Params{Spot: 100, Strike: 100, Years: 1, Rate: 0.05, Vol: 0.25, Call: true, American: true}
+	euroCall := call
+	euroCall.American = false
+	if !near(Price(call), Price(euroCall), 0.02) {
+		t.Errorf("American call %v, European call %v, want equal", Price(call), Price(euroCall))
+	}
+}
+
+// TestClosedFormMatchesFiniteDifference verifies the analytic greeks against numerical derivatives.
+func TestClosedFormMatchesFiniteDifference(t *testing.T) {
+	for _, call := range []bool{true, false} {
+		p := Params{Spot: 100, Strike: 105, Years: 0.4, Rate: 0.05, Dividend: 0.01, Vol: 0.25, Call: call}
+		exact := Compute(p)
+		approx := finiteDifference(p)
+		checks := []struct {
+			name      string
+			got, want float64
+			tol       float64
+		}{
+			{"Delta", exact.Delta, approx.Delta, 1e-4},
+			{"Gamma", exact.Gamma, approx.Gamma, 1e-4},
+			{"Theta", exact.Theta, approx.Theta, 1e-3},
+			{"Vega", exact.Vega, approx.Vega, 1e-3},
+			{"Rho", exact.Rho, approx.Rho, 1e-4},
+			{"Vanna", exact.Vanna, approx.Vanna, 1e-4},
+			{"Charm", exact.Charm, approx.Charm, 1e-4},
+			{"Vomma", exact.Vomma, approx.Vomma, 1e-4},
+		}
+		for _, c := range checks {
+			if !near(c.got, c.want, c.tol) {
+				t.Errorf("call=%v %s = %v, finite difference %v", call, c.name, c.got, c.want)
+			}
+		}
+	}
+}
+
+// TestImpliedVol verifies the solver recovers the volatility used to price the option.
+func TestImpliedVol(t *testing.T) {
+	cases := []Params{
+		{Spot: 100, Strike: 100, Years: 0.25, Rate: 0.03, Vol: 0.2, Call: true},
+		{Spot: 100, Strike: 70, Years: 0.1, Rate: 0.03, Vol: 0.6, Call: false},
+		{Spot: 100, Strike: 130, Years: 2, Rate: 0.03, Dividend: 0.02, Vol: 1.2, Call: true},
+		{Spot: 50, Strike: 55, Years: 0.5, Rate: 0.05, Vol: 0.35, Call: false, American: true},
+	}
+	for _, p := range cases {
+		price := Price(p)
+		q := p
+		q.Vol = 0
+		got, err := ImpliedVol(q, price)
+		if err != nil {
+			t.Errorf("ImpliedVol(%+v) error: %v", p, err)
+			continue
+		}
+		if !near(got, p.Vol, 1e-4) {
+			t.Errorf("ImpliedVol(%+v) = %v, want %v", p, got, p.Vol)
+		}
+	}
+}
+
+// TestImpliedVolNoSolution verifies prices outside the arbitrage bounds are rejected.
+func TestImpliedVolNoSolution(t *testing.T) {
+	p := Params{Spot: 100, Strike: 80, Years: 0.5, Rate: 0.03, Call: true}
+	if _, err := ImpliedVol(p, 5); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("below intrinsic error = %v, want ErrNoSolution", err)
+	}
+	if _, err := ImpliedVol(p, 150); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("above spot error = %v, want ErrNoSolution", err)
+	}
+}
+
+// TestProbTouch verifies touch probabilities against the driftless reflection principle and close probabilities.
+func TestProbTouch(t *testing.T) {
+	// With r - q = vol²/2 the log price is driftless, so touching is twice the chance of closing beyond
+	p := Params{Spot: 100, Strike: 110, Years: 0.25, Vol: 0.3, Rate: 0.045}
+	p.Dividend = p.Rate - p.Vol*p.Vol/2
+	want := 2 * NormCDF(-math.Log(1.1)/(0.3*0.5))
+	if got := ProbTouch(p); !near(got, want, 1e-9) {
+		t.Errorf("ProbTouch(driftless) = %v, want %v", got, want)
+	}
+
+	for _, strike := range []float64{80, 95, 105, 130} {
+		q := Params{Spot: 100, Strike: strike, Years: 0.5, Rate: 0.04, Vol: 0.25, Call: strike > 100}
+		touch, closing := ProbTouch(q), ProbITM(q)
+		if touch < closing || touch > 1 {
+			t.Errorf("strike %v: ProbTouch = %v, ProbITM = %v", strike, touch, closing)
+		}
+	}
+	if got := ProbTouch(Params{Spot: 100, Strike: 100}); got != 1 {
+		t.Errorf("ProbTouch(at spot) = %v, want 1", got)
+	}
+}
+
+// TestExpiredOption verifies expired options are worth intrinsic value with unit delta.
+func TestExpiredOption(t *testing.T) {
+	g := Compute(Params{Spot: 90, Strike: 100, Vol: 0.3})
+	if g.Price != 10 || g.Delta != -1 || g.Gamma != 0 {
+		t.Errorf("Compute(expired put) = %+v", g)
+	}
+	if got := ProbITM(Params{Spot: 90, Strike: 100, Call: true}); got != 0 {
+		t.Errorf("ProbITM(expired OTM call) = %v, want 0", got)
+	}
+}
+
+// TestYearsToExpiry verifies expirations are measured to the 4:00 PM Eastern close.
+func TestYearsToExpiry(t *testing.T) {
+	date := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	now := Expiry(date).Add(-365 * 24 * time.Hour)
+	if got := YearsToExpiry(date, now); !near(got, 1, 1e-9) {
+		t.Errorf("YearsToExpiry() = %v, want 1", got)
+	}
+	if got := YearsToExpiry(date, Expiry(date).Add(time.Minute)); got != 0 {
+		t.Errorf("YearsToExpiry(after close) = %v, want 0", got)
+	}
+}
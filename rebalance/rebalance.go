@@ -0,0 +1,203 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package rebalance
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cloudmanic/tradier/occ"
+)
+
+// Side is the direction of a rebalancing trade.
+type Side string
+
+const (
+	// SideBuy buys shares.
+	SideBuy Side = "buy"
+
+	// SideSell sells shares.
+	SideSell Side = "sell"
+)
+
+// Holding is a current position and its price. Symbols with a target that are not held are
+// passed with a zero quantity so they can be priced.
+type Holding struct {
+	Symbol   string
+	Quantity float64
+	Price    float64
+}
+
+// Line is one symbol's current and target weight and the trade, if any, that moves it toward
+// the target. Weights are percentages of the portfolio value.
+type Line struct {
+	Symbol    string  `json:"symbol"`
+	Price     float64 `json:"price"`
+	Quantity  float64 `json:"quantity"`
+	Value     float64 `json:"value"`
+	Weight    float64 `json:"weight"`
+	Target    float64 `json:"target"`
+	Drift     float64 `json:"drift"`
+	Side      Side    `json:"side,omitempty"`
+	Shares    float64 `json:"shares,omitempty"`
+	Amount    float64 `json:"amount,omitempty"`
+	NewWeight float64 `json:"new_weight"`
+	Note      string  `json:"note,omitempty"`
+}
+
+// Skip is a held position left out of the rebalance.
+type Skip struct {
+	Symbol string `json:"symbol"`
+	Reason string `json:"reason"`
+}
+
+// Plan is the set of trades that moves a portfolio toward its targets.
+type Plan struct {
+	Value      float64 `json:"value"`
+	Cash       float64 `json:"cash"`
+	CashBuffer float64 `json:"cash_buffer"`
+	CashAfter  float64 `json:"cash_after"`
+	Lines      []Line  `json:"lines"`
+	Skipped    []Skip  `json:"skipped,omitempty"`
+}
+
+// Trades returns the lines with a trade, sells first so their proceeds fund the buys.
+func (p Plan) Trades() []Line {
+	var out []Line
+	for _, side := range []Side{SideSell, SideBuy} {
+		for _, l := range p.Lines {
+			if l.Side == side && l.Shares > 0 {
+				out = append(out, l)
+			}
+		}
+	}
+	return out
+}
+
+// Compute plans whole-share trades that bring each holding within the targets' drift of its
+// weight. The portfolio value is the cash plus the positions being rebalanced. Sells are sized
+// first; buys, largest shortfall first, are then limited to the cash left above the buffer.
+// Trades smaller than the minimum are dropped. Option and short positions, and positions
+// without a target unless SellUnlisted is set, are reported as skipped.
+func Compute(t Targets, holdings []Holding, cash float64) (Plan, error) {
+	if err := t.Validate(); err != nil {
+		return Plan{}, err
+	}
+
+	plan := Plan{Cash: cash}
+	held := map[string]Holding{}
+	for _, h := range holdings {
+		_, listed := t.Weights[h.Symbol]
+		switch {
+		case occ.IsOption(h.Symbol):
+			plan.Skipped = append(plan.Skipped, Skip{Symbol: h.Symbol, Reason: "option position"})
+		case h.Quantity < 0:
+			plan.Skipped = append(plan.Skipped, Skip{Symbol: h.Symbol, Reason: "short position"})
+		case !listed && !t.SellUnlisted:
+			plan.Skipped = append(plan.Skipped, Skip{Symbol: h.Symbol, Reason: "no target"})
+		default:
+			prev := held[h.Symbol]
+			h.Quantity += prev.Quantity
+			held[h.Symbol] = h
+		}
+	}
+
+	symbols := make([]string, 0, len(held))
+	plan.Value = cash
+	for symbol, h := range held {
+		if h.Price <= 0 {
+			return Plan{}, fmt.Errorf("no price for %s", symbol)
+		}
+		symbols = append(symbols, symbol)
+		plan.Value += h.Quantity * h.Price
+	}
+	for symbol := range t.Weights {
+		if _, ok := held[symbol]; !ok {
+			return Plan{}, fmt.Errorf("no price for %s", symbol)
+		}
+	}
+	if plan.Value <= 0 {
+		return Plan{}, fmt.Errorf("portfolio value is %.2f; nothing to rebalance", plan.Value)
+	}
+	sort.Strings(symbols)
+	plan.CashBuffer = plan.Value * t.CashBuffer / 100
+
+	// Size every trade as if cash were unlimited
+	for _, symbol := range symbols {
+		h := held[symbol]
+		l := Line{Symbol: symbol, Price: h.Price, Quantity: h.Quantity, Value: h.Quantity * h.Price, Target: t.Weights[symbol]}
+		l.Weight = l.Value / plan.Value * 100
+		l.Drift = l.Weight - l.Target
+		delta := plan.Value*l.Target/100 - l.Value
+
+		switch {
+		case math.Abs(l.Drift) <= t.Drift:
+			l.Note = "within drift"
+		case delta < 0:
+			l.Side, l.Shares = SideSell, math.Min(math.Floor(-delta/h.Price), math.Floor(h.Quantity))
+			if l.Target == 0 {
+				l.Shares = math.Floor(h.Quantity)
+			}
+		default:
+			l.Side, l.Shares = SideBuy, math.Floor(delta/h.Price)
+		}
+		plan.Lines = append(plan.Lines, l)
+	}
+
+	// Sells raise cash, then buys spend what is left above the buffer
+	budget := cash
+	for i := range plan.Lines {
+		if l := &plan.Lines[i]; l.Side == SideSell {
+			minimum(l, t.MinTrade)
+			budget += l.Amount
+		}
+	}
+	budget -= plan.CashBuffer
+	plan.CashAfter = budget + plan.CashBuffer
+
+	buys := make([]*Line, 0, len(plan.Lines))
+	for i := range plan.Lines {
+		if plan.Lines[i].Side == SideBuy {
+			buys = append(buys, &plan.Lines[i])
+		}
+	}
+	sort.SliceStable(buys, func(a, b int) bool { return buys[a].Drift < buys[b].Drift })
+	for _, l := range buys {
+		if cost := l.Shares * l.Price; cost > budget {
+			l.Shares = math.Max(math.Floor(budget/l.Price), 0)
+			l.Note = "limited by cash"
+		}
+		minimum(l, t.MinTrade)
+		budget -= l.Amount
+		plan.CashAfter -= l.Amount
+	}
+
+	for i := range plan.Lines {
+		l := &plan.Lines[i]
+		if l.Shares == 0 {
+			l.Side = ""
+		}
+		shares := l.Shares
+		if l.Side == SideSell {
+			shares = -shares
+		}
+		l.NewWeight = (l.Quantity + shares) * l.Price / plan.Value * 100
+	}
+	return plan, nil
+}
+
+// minimum sets a line's trade amount, dropping the trade if it is below the minimum trade size.
+func minimum(l *Line, minTrade float64) {
+	l.Amount = l.Shares * l.Price
+	if l.Shares > 0 && l.Amount >= minTrade {
+		return
+	}
+	if l.Shares > 0 {
+		l.Note = "below minimum trade"
+	} else if l.Note == "" {
+		l.Note = "less than one share"
+	}
+	l.Shares, l.Amount = 0, 0
+}
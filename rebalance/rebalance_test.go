@@ -0,0 +1,116 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package rebalance
+
+import (
+	"math"
+	"testing"
+)
+
+// lineFor returns the plan line for a symbol.
+func lineFor(t *testing.T, p Plan, symbol string) Line {
+	t.Helper()
+	for _, l := range p.Lines {
+		if l.Symbol == symbol {
+			return l
+		}
+	}
+	t.Fatalf("no line for %s in %+v", symbol, p.Lines)
+	return Line{}
+}
+
+// TestCompute verifies drift, whole shares, sells funding buys, and the cash buffer.
+func TestCompute(t *testing.T) {
+	targets := Targets{Weights: map[string]float64{"VTI": 60, "BND": 30, "GLD": 8}, Drift: 2, CashBuffer: 2}
+	holdings := []Holding{
+		{Symbol: "VTI", Quantity: 300, Price: 100}, // 30000 of 50000 = 60%
+		{Symbol: "BND", Quantity: 250, Price: 80},  // 20000 = 40%
+		{Symbol: "GLD", Price: 190},
+	}
+	plan, err := Compute(targets, holdings, 0)
+	if err != nil {
+		t.Fatalf("Compute() error: %v", err)
+	}
+	if plan.Value != 50000 || plan.CashBuffer != 1000 {
+		t.Errorf("Value, CashBuffer = %v, %v, want 50000, 1000", plan.Value, plan.CashBuffer)
+	}
+
+	if vti := lineFor(t, plan, "VTI"); vti.Side != "" || vti.Note != "within drift" || vti.NewWeight != 60 {
+		t.Errorf("VTI = %+v, want untouched", vti)
+	}
+	// BND is 10 points over: sell 5000 / 80 = 62 shares (4960)
+	if bnd := lineFor(t, plan, "BND"); bnd.Side != SideSell || bnd.Shares != 62 || bnd.Amount != 4960 {
+		t.Errorf("BND = %+v, want sell 62", bnd)
+	}
+	// GLD wants 4000 / 190 = 21 shares, but only 4960 - 1000 buffer = 3960 is free: 20 shares
+	gld := lineFor(t, plan, "GLD")
+	if gld.Side != SideBuy || gld.Shares != 20 || gld.Note != "limited by cash" {
+		t.Errorf("GLD = %+v, want buy 20 limited by cash", gld)
+	}
+	if math.Abs(plan.CashAfter-1160) > 1e-9 {
+		t.Errorf("CashAfter = %v, want 1160", plan.CashAfter)
+	}
+
+	trades := plan.Trades()
+	if len(trades) != 2 || trades[0].Symbol != "BND" || trades[1].Symbol != "GLD" {
+		t.Errorf("Trades() = %+v, want BND sell then GLD buy", trades)
+	}
+}
+
+// TestComputeSkipsAndMinimums verifies skipped positions, unlisted sells, and the minimum trade.
+func TestComputeSkipsAndMinimums(t *testing.T) {
+	holdings := []Holding{
+		{Symbol: "VTI", Quantity: 10, Price: 100},
+		{Symbol: "AAPL", Quantity: 5.5, Price: 200},
+		{Symbol: "SPY261218C00600000", Quantity: 1, Price: 12},
+		{Symbol: "TSLA", Quantity: -10, Price: 300},
+	}
+	targets := Targets{Weights: map[string]float64{"VTI": 95}, MinTrade: 100}
+
+	plan, err := Compute(targets, holdings, 50)
+	if err != nil {
+		t.Fatalf("Compute() error: %v", err)
+	}
+	if plan.Value != 1050 || len(plan.Skipped) != 3 {
+		t.Errorf("Value = %v, Skipped = %+v, want 1050 and 3 skips", plan.Value, plan.Skipped)
+	}
+	// VTI wants 997.50 of its 1000: less than one share over
+	if vti := lineFor(t, plan, "VTI"); vti.Shares != 0 || vti.Side != "" || vti.Note != "less than one share" {
+		t.Errorf("VTI = %+v, want no trade", vti)
+	}
+
+	targets.SellUnlisted = true
+	plan, err = Compute(targets, holdings, 50)
+	if err != nil {
+		t.Fatalf("Compute(sell unlisted) error: %v", err)
+	}
+	if aapl := lineFor(t, plan, "AAPL"); aapl.Side != SideSell || aapl.Shares != 5 || aapl.Target != 0 {
+		t.Errorf("AAPL = %+v, want sell all 5 whole shares", aapl)
+	}
+	// Value is 2150 with AAPL counted, so VTI wants 2042.50: buy 10 with the AAPL proceeds
+	if vti := lineFor(t, plan, "VTI"); vti.Side != SideBuy || vti.Shares != 10 {
+		t.Errorf("VTI = %+v, want buy 10", vti)
+	}
+
+	targets.MinTrade = 1500
+	plan, _ = Compute(targets, holdings, 50)
+	// Dropping the AAPL sale leaves no cash for VTI
+	if aapl := lineFor(t, plan, "AAPL"); aapl.Shares != 0 || aapl.Note != "below minimum trade" {
+		t.Errorf("AAPL = %+v, want dropped below minimum", aapl)
+	}
+	if vti := lineFor(t, plan, "VTI"); vti.Shares != 0 || vti.Note != "limited by cash" {
+		t.Errorf("VTI = %+v, want limited by cash", vti)
+	}
+}
+
+// TestComputeErrors verifies missing prices and an empty portfolio are rejected.
+func TestComputeErrors(t *testing.T) {
+	targets := Targets{Weights: map[string]float64{"VTI": 100}}
+	if _, err := Compute(targets, nil, 1000); err == nil {
+		t.Errorf("Compute(unpriced target) error = nil, want error")
+	}
+	if _, err := Compute(targets, []Holding{{Symbol: "VTI", Price: 100}}, 0); err == nil {
+		t.Errorf("Compute(empty) error = nil, want error")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package rebalance
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Targets is a model portfolio: target weights and the rules for trading toward them.
+type Targets struct {
+	// Weights are target percentages of portfolio value by symbol. A weight of 0 sells the position.
+	Weights map[string]float64 `yaml:"targets"`
+
+	// Drift is how many percentage points a holding may stray from its target before it is traded.
+	Drift float64 `yaml:"drift"`
+
+	// CashBuffer is the percentage of portfolio value always kept in cash.
+	CashBuffer float64 `yaml:"cash_buffer"`
+
+	// MinTrade is the smallest trade, in dollars, worth placing.
+	MinTrade float64 `yaml:"min_trade"`
+
+	// SellUnlisted sells stock positions that have no target. Otherwise they are left alone and
+	// excluded from the portfolio value.
+	SellUnlisted bool `yaml:"sell_unlisted"`
+}
+
+// ParseTargets decodes a YAML (or JSON) targets file:
+//
+//	targets:
+//	  VTI: 55
+//	  VXUS: 25
+//	  BND: 18
+//	drift: 3         # percentage points
+//	cash_buffer: 2   # percent of portfolio value
+//	min_trade: 100   # dollars
+//
+// The weights plus the cash buffer may not exceed 100; anything left over stays in cash.
+func ParseTargets(data []byte) (Targets, error) {
+	var raw Targets
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return Targets{}, fmt.Errorf("unable to parse targets file: %w", err)
+	}
+	t := raw
+	t.Weights = map[string]float64{}
+	for symbol, w := range raw.Weights {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if _, dup := t.Weights[symbol]; dup {
+			return Targets{}, fmt.Errorf("symbol %s is listed more than once", symbol)
+		}
+		t.Weights[symbol] = w
+	}
+	return t, t.Validate()
+}
+
+// LoadTargets reads and parses a targets file.
+func LoadTargets(path string) (Targets, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Targets{}, fmt.Errorf("unable to read targets file: %w", err)
+	}
+	return ParseTargets(data)
+}
+
+// Validate checks the weights and settings are usable.
+func (t Targets) Validate() error {
+	if len(t.Weights) == 0 {
+		return fmt.Errorf("targets file must list at least one symbol under targets")
+	}
+	sum := t.CashBuffer
+	for symbol, w := range t.Weights {
+		if w < 0 || math.IsNaN(w) {
+			return fmt.Errorf("target for %s must be a percentage of 0 or more", symbol)
+		}
+		sum += w
+	}
+	if sum > 100.0001 {
+		return fmt.Errorf("targets plus cash buffer add up to %.2f%%; they must not exceed 100%%", sum)
+	}
+	if t.Drift < 0 || t.CashBuffer < 0 || t.MinTrade < 0 {
+		return fmt.Errorf("drift, cash_buffer, and min_trade must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package rebalance
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseTargets verifies weights, settings, and symbol normalization.
+func TestParseTargets(t *testing.T) {
+	data := []byte("targets:\n  vti: 55\n  VXUS: 25\n  BND: 18\ndrift: 3\ncash_buffer: 2\nmin_trade: 100\n")
+	got, err := ParseTargets(data)
+	if err != nil {
+		t.Fatalf("ParseTargets() error: %v", err)
+	}
+	if got.Weights["VTI"] != 55 || got.Weights["BND"] != 18 || got.Drift != 3 || got.CashBuffer != 2 || got.MinTrade != 100 || got.SellUnlisted {
+		t.Errorf("ParseTargets() = %+v", got)
+	}
+}
+
+// TestParseTargetsErrors verifies bad weights and settings are rejected.
+func TestParseTargetsErrors(t *testing.T) {
+	tests := map[string]string{
+		"drift: 3":                            "at least one symbol",
+		"targets:\n  VTI: 90\n  BND: 20":      "must not exceed 100",
+		"targets:\n  VTI: 99\ncash_buffer: 2": "must not exceed 100",
+		"targets:\n  VTI: -5":                 "0 or more",
+		"targets:\n  VTI: 50\n  vti: 50":      "more than once",
+		"targets:\n  VTI: 50\nmin_trade: -1":  "must not be negative",
+		"targets: [VTI]":                      "unable to parse",
+	}
+	for src, want := range tests {
+		if _, err := ParseTargets([]byte(src)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseTargets(%q) error = %v, want containing %q", src, err, want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package reconcile
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CashSymbol is the ledger symbol whose quantity is the expected cash balance.
+const CashSymbol = "CASH"
+
+// Status describes how a ledger line compares with the broker.
+type Status string
+
+const (
+	// StatusOK means the ledger and broker agree.
+	StatusOK Status = "ok"
+
+	// StatusMissing means the ledger expects a position the broker does not hold.
+	StatusMissing Status = "missing"
+
+	// StatusExtra means the broker holds a position the ledger does not know about.
+	StatusExtra Status = "extra"
+
+	// StatusQuantity means both sides hold the symbol but quantities differ.
+	StatusQuantity Status = "quantity_mismatch"
+
+	// StatusCostBasis means quantities agree but cost basis differs beyond the tolerance.
+	StatusCostBasis Status = "cost_basis_mismatch"
+)
+
+// Holding is a position quantity and total cost basis for one symbol.
+type Holding struct {
+	Symbol    string
+	Quantity  float64
+	CostBasis float64
+	HasCost   bool
+}
+
+// Ledger is the expected state of an account as recorded locally.
+type Ledger struct {
+	Holdings []Holding
+	Cash     float64
+	HasCash  bool
+}
+
+// Tolerance sets how far cost basis and cash may drift before they count as mismatches.
+type Tolerance struct {
+	CostBasis float64
+	Cash      float64
+}
+
+// Line is the comparison result for a single symbol.
+type Line struct {
+	Symbol       string  `json:"symbol"`
+	Status       Status  `json:"status"`
+	ExpectedQty  float64 `json:"expected_quantity"`
+	ActualQty    float64 `json:"actual_quantity"`
+	ExpectedCost float64 `json:"expected_cost_basis"`
+	ActualCost   float64 `json:"actual_cost_basis"`
+	CostChecked  bool    `json:"cost_checked"`
+}
+
+// Result is the full reconciliation of a ledger against the broker.
+type Result struct {
+	Lines        []Line  `json:"lines"`
+	CashChecked  bool    `json:"cash_checked"`
+	ExpectedCash float64 `json:"expected_cash"`
+	ActualCash   float64 `json:"actual_cash"`
+	CashMatches  bool    `json:"cash_matches"`
+	Mismatches   int     `json:"mismatches"`
+}
+
+// quantityEpsilon absorbs float noise when comparing share counts.
+const quantityEpsilon = 1e-6
+
+// ParseCSV reads a ledger from CSV. The first row is a header naming at least "symbol" and
+// "quantity" columns; a "cost_basis" column is optional. Multiple rows for the same symbol
+// (tax lots) are summed. A row with symbol CASH records the expected cash balance in its
+// quantity column. Blank lines and rows starting with # are ignored.
+func ParseCSV(r io.Reader) (*Ledger, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ledger header: %w", err)
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	symCol, ok := cols["symbol"]
+	if !ok {
+		return nil, fmt.Errorf("ledger header must include a symbol column")
+	}
+	qtyCol, ok := cols["quantity"]
+	if !ok {
+		return nil, fmt.Errorf("ledger header must include a quantity column")
+	}
+	costCol, hasCost := cols["cost_basis"]
+
+	ledger := &Ledger{}
+	bySymbol := map[string]*Holding{}
+	var order []string
+	line := 1
+	for {
+		rec, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("ledger line %d: %w", line, err)
+		}
+
+		sym := strings.ToUpper(strings.TrimSpace(field(rec, symCol)))
+		if sym == "" {
+			continue
+		}
+		qty, err := strconv.ParseFloat(strings.TrimSpace(field(rec, qtyCol)), 64)
+		if err != nil {
+			return nil, fmt.Errorf("ledger line %d: invalid quantity %q", line, field(rec, qtyCol))
+		}
+
+		if sym == CashSymbol {
+			ledger.Cash += qty
+			ledger.HasCash = true
+			continue
+		}
+
+		h, ok := bySymbol[sym]
+		if !ok {
+			h = &Holding{Symbol: sym}
+			bySymbol[sym] = h
+			order = append(order, sym)
+		}
+		h.Quantity += qty
+
+		if hasCost {
+			if raw := strings.TrimSpace(field(rec, costCol)); raw != "" {
+				cost, err := strconv.ParseFloat(raw, 64)
+				if err != nil {
+					return nil, fmt.Errorf("ledger line %d: invalid cost_basis %q", line, raw)
+				}
+				h.CostBasis += cost
+				h.HasCost = true
+			}
+		}
+	}
+
+	for _, sym := range order {
+		ledger.Holdings = append(ledger.Holdings, *bySymbol[sym])
+	}
+	return ledger, nil
+}
+
+// Compare reconciles the ledger against broker holdings and cash. Broker holdings with the same
+// symbol are summed. Lines are sorted with mismatches first, then by symbol.
+func Compare(ledger *Ledger, broker []Holding, cash float64, tol Tolerance) Result {
+	actual := map[string]*Holding{}
+	for _, b := range broker {
+		sym := strings.ToUpper(b.Symbol)
+		h, ok := actual[sym]
+		if !ok {
+			h = &Holding{Symbol: sym}
+			actual[sym] = h
+		}
+		h.Quantity += b.Quantity
+		h.CostBasis += b.CostBasis
+	}
+
+	var res Result
+	seen := map[string]bool{}
+	for _, exp := range ledger.Holdings {
+		seen[exp.Symbol] = true
+		line := Line{Symbol: exp.Symbol, ExpectedQty: exp.Quantity, ExpectedCost: exp.CostBasis, CostChecked: exp.HasCost}
+		act, ok := actual[exp.Symbol]
+		switch {
+		case !ok || act.Quantity == 0:
+			if math.Abs(exp.Quantity) < quantityEpsilon {
+				line.Status = StatusOK
+			} else {
+				line.Status = StatusMissing
+			}
+		case math.Abs(act.Quantity-exp.Quantity) > quantityEpsilon:
+			line.ActualQty, line.ActualCost = act.Quantity, act.CostBasis
+			line.Status = StatusQuantity
+		case exp.HasCost && math.Abs(act.CostBasis-exp.CostBasis) > tol.CostBasis:
+			line.ActualQty, line.ActualCost = act.Quantity, act.CostBasis
+			line.Status = StatusCostBasis
+		default:
+			line.ActualQty, line.ActualCost = act.Quantity, act.CostBasis
+			line.Status = StatusOK
+		}
+		res.Lines = append(res.Lines, line)
+	}
+
+	for sym, act := range actual {
+		if seen[sym] || act.Quantity == 0 {
+			continue
+		}
+		res.Lines = append(res.Lines, Line{
+			Symbol:     sym,
+			Status:     StatusExtra,
+			ActualQty:  act.Quantity,
+			ActualCost: act.CostBasis,
+		})
+	}
+
+	if ledger.HasCash {
+		res.CashChecked = true
+		res.ExpectedCash = ledger.Cash
+		res.ActualCash = cash
+		res.CashMatches = math.Abs(cash-ledger.Cash) <= tol.Cash
+		if !res.CashMatches {
+			res.Mismatches++
+		}
+	}
+
+	for _, l := range res.Lines {
+		if l.Status != StatusOK {
+			res.Mismatches++
+		}
+	}
+
+	sort.Slice(res.Lines, func(i, j int) bool {
+		oi, oj := res.Lines[i].Status == StatusOK, res.Lines[j].Status == StatusOK
+		if oi != oj {
+			return !oi
+		}
+		return res.Lines[i].Symbol < res.Lines[j].Symbol
+	})
+	return res
+}
+
+// field returns the record value at index i, or an empty string when the row is short.
+func field(rec []string, i int) string {
+	if i < len(rec) {
+		return rec[i]
+	}
+	return ""
+}
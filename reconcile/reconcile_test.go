@@ -0,0 +1,107 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package reconcile
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseCSV verifies lots are summed, cash is captured, and comments are skipped.
+func TestParseCSV(t *testing.T) {
+	input := `symbol,quantity,cost_basis
+# opening lots
+AAPL,10,1500
+aapl,5,800
+MSFT,20,
+CASH,2500.50
+`
+	ledger, err := ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseCSV() error: %v", err)
+	}
+	if len(ledger.Holdings) != 2 {
+		t.Fatalf("Holdings = %d, want 2", len(ledger.Holdings))
+	}
+	aapl := ledger.Holdings[0]
+	if aapl.Symbol != "AAPL" || aapl.Quantity != 15 || aapl.CostBasis != 2300 || !aapl.HasCost {
+		t.Errorf("AAPL = %+v", aapl)
+	}
+	if msft := ledger.Holdings[1]; msft.HasCost {
+		t.Errorf("MSFT HasCost = true, want false")
+	}
+	if !ledger.HasCash || ledger.Cash != 2500.50 {
+		t.Errorf("Cash = %v (%v), want 2500.50", ledger.Cash, ledger.HasCash)
+	}
+}
+
+// TestParseCSVErrors verifies missing columns and bad numbers are reported.
+func TestParseCSVErrors(t *testing.T) {
+	cases := map[string]string{
+		"no symbol":    "ticker,quantity\nAAPL,1\n",
+		"no quantity":  "symbol,qty\nAAPL,1\n",
+		"bad quantity": "symbol,quantity\nAAPL,ten\n",
+		"bad cost":     "symbol,quantity,cost_basis\nAAPL,1,abc\n",
+	}
+	for name, input := range cases {
+		if _, err := ParseCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// TestCompare verifies missing, extra, quantity, and cost basis differences are classified.
+func TestCompare(t *testing.T) {
+	ledger := &Ledger{
+		Holdings: []Holding{
+			{Symbol: "AAPL", Quantity: 10, CostBasis: 1500, HasCost: true},
+			{Symbol: "MSFT", Quantity: 5},
+			{Symbol: "GOOG", Quantity: 2},
+			{Symbol: "AMZN", Quantity: 3, CostBasis: 300, HasCost: true},
+		},
+		Cash:    1000,
+		HasCash: true,
+	}
+	broker := []Holding{
+		{Symbol: "AAPL", Quantity: 10, CostBasis: 1500.40},
+		{Symbol: "MSFT", Quantity: 4},
+		{Symbol: "AMZN", Quantity: 3, CostBasis: 330},
+		{Symbol: "TSLA", Quantity: 1, CostBasis: 200},
+	}
+
+	res := Compare(ledger, broker, 1000.25, Tolerance{CostBasis: 1, Cash: 0.5})
+	got := map[string]Status{}
+	for _, l := range res.Lines {
+		got[l.Symbol] = l.Status
+	}
+	want := map[string]Status{
+		"AAPL": StatusOK,
+		"MSFT": StatusQuantity,
+		"GOOG": StatusMissing,
+		"AMZN": StatusCostBasis,
+		"TSLA": StatusExtra,
+	}
+	for sym, status := range want {
+		if got[sym] != status {
+			t.Errorf("%s status = %s, want %s", sym, got[sym], status)
+		}
+	}
+	if !res.CashMatches {
+		t.Error("CashMatches = false, want true within tolerance")
+	}
+	if res.Mismatches != 4 {
+		t.Errorf("Mismatches = %d, want 4", res.Mismatches)
+	}
+	if res.Lines[len(res.Lines)-1].Status != StatusOK {
+		t.Error("expected mismatched lines to sort before matching lines")
+	}
+}
+
+// TestCompareCashMismatch verifies a cash difference beyond tolerance counts as a mismatch.
+func TestCompareCashMismatch(t *testing.T) {
+	res := Compare(&Ledger{Cash: 100, HasCash: true}, nil, 90, Tolerance{Cash: 1})
+	if res.CashMatches || res.Mismatches != 1 {
+		t.Errorf("CashMatches = %v, Mismatches = %d; want false, 1", res.CashMatches, res.Mismatches)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// ResolveAt converts a schedule time to an absolute time after now. Accepted forms, all in
+// Eastern time:
+//
+//	15:55                  That time on the next trading day it has not yet passed
+//	2026-10-19 15:55       An exact date and time
+//	2026-10-19T15:55:00Z   An RFC 3339 timestamp
+//	next-open, next-close  The next regular-session open or close
+//	open, close            Today's open or close if still ahead, otherwise the next one
+//
+// The session anchors take an optional offset such as next-open+5m or close-10m. Closes
+// follow the calendar, so close-5m on a half day is 12:55. Clock times skip weekends and holidays.
+func ResolveAt(spec string, now time.Time, cal *calendar.Calendar) (time.Time, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	eastern := pricing.Eastern()
+	now = now.In(eastern)
+
+	if t, err := time.Parse(time.RFC3339, strings.ToUpper(spec)); err == nil {
+		return future(t, now)
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04", spec, eastern); err == nil {
+		return future(t, now)
+	}
+	if clock, err := time.ParseInLocation("15:04", spec, eastern); err == nil {
+		return nextClockTime(clock.Hour(), clock.Minute(), now, cal)
+	}
+
+	// Anchor names contain a dash themselves, so match the name before looking for an offset
+	anchor, offset := "", time.Duration(0)
+	for _, name := range []string{"next-open", "next-close", "open", "close"} {
+		rest, ok := strings.CutPrefix(spec, name)
+		if !ok || (rest != "" && rest[0] != '+' && rest[0] != '-') {
+			continue
+		}
+		anchor = name
+		if rest != "" {
+			d, err := time.ParseDuration(rest)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("invalid offset %q in %q", rest, spec)
+			}
+			offset = d
+		}
+		break
+	}
+
+	var t time.Time
+	var err error
+	switch anchor {
+	case "next-open":
+		t, err = cal.NextOpen(now)
+	case "next-close":
+		t, err = cal.NextClose(now)
+	case "open", "close":
+		t, err = sessionEdge(anchor == "open", offset, now, cal)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time %q (e.g. 15:55, 2026-10-19 09:35, next-open+5m, close-5m)", spec)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if anchor == "open" || anchor == "close" {
+		return t, nil
+	}
+	return future(t.Add(offset), now)
+}
+
+// sessionEdge returns the first regular open or close, plus offset, that is still ahead of now.
+func sessionEdge(open bool, offset time.Duration, now time.Time, cal *calendar.Calendar) (time.Time, error) {
+	from := calendar.Date(now)
+	for i := 0; i < 15; i++ {
+		day, err := cal.Day(from.AddDate(0, 0, i))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !day.Open || day.Regular.IsZero() {
+			continue
+		}
+		edge := day.Regular.End
+		if open {
+			edge = day.Regular.Start
+		}
+		if t := edge.Add(offset); t.After(now) {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no trading session found in the next two weeks")
+}
+
+// nextClockTime returns the given Eastern time of day on the first trading day it is still ahead.
+func nextClockTime(hour, minute int, now time.Time, cal *calendar.Calendar) (time.Time, error) {
+	from := calendar.Date(now)
+	for i := 0; i < 15; i++ {
+		day, err := cal.Day(from.AddDate(0, 0, i))
+		if err != nil {
+			return time.Time{}, err
+		}
+		t := time.Date(day.Date.Year(), day.Date.Month(), day.Date.Day(), hour, minute, 0, 0, pricing.Eastern())
+		if day.Open && t.After(now) {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no trading day found in the next two weeks")
+}
+
+// future returns t, or an error if it is not after now.
+func future(t, now time.Time) (time.Time, error) {
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("%s is in the past", t.In(pricing.Eastern()).Format("2006-01-02 15:04 MST"))
+	}
+	return t, nil
+}
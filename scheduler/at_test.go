@@ -0,0 +1,72 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// novemberJSON has a regular day, Thanksgiving, an early close, a weekend, and a regular Monday.
+const novemberJSON = `{"calendar":{"month":11,"year":2026,"days":{"day":[
+	{"date":"2026-11-25","status":"open","open":{"start":"09:30","end":"16:00"}},
+	{"date":"2026-11-26","status":"closed"},
+	{"date":"2026-11-27","status":"open","open":{"start":"09:30","end":"13:00"}},
+	{"date":"2026-11-28","status":"closed"},
+	{"date":"2026-11-29","status":"closed"},
+	{"date":"2026-11-30","status":"open","open":{"start":"09:30","end":"16:00"}}]}}}`
+
+// at returns the Eastern time on the given November 2026 day.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 11, day, hour, minute, 0, 0, pricing.Eastern())
+}
+
+// testCalendar returns a calendar with only the November sample month.
+func testCalendar() *calendar.Calendar {
+	return calendar.New(func(year int, month time.Month) ([]byte, error) {
+		return []byte(novemberJSON), nil
+	})
+}
+
+// TestResolveAt verifies each schedule form, including holiday and half-day handling.
+func TestResolveAt(t *testing.T) {
+	cal := testCalendar()
+	now := at(25, 15, 0)
+	tests := map[string]time.Time{
+		"15:55":                at(25, 15, 55),
+		"14:00":                at(27, 14, 0), // passed today, Thanksgiving skipped
+		"2026-11-30 09:45":     at(30, 9, 45),
+		"2026-11-25T20:56:00Z": at(25, 15, 56),
+		"next-open":            at(27, 9, 30),
+		"Next-Open+5m":         at(27, 9, 35),
+		"next-close":           at(25, 16, 0),
+		"close-5m":             at(25, 15, 55),
+		"open+1h":              at(27, 10, 30),
+		" next-close-30m ":     at(25, 15, 30),
+	}
+	for spec, want := range tests {
+		got, err := ResolveAt(spec, now, cal)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ResolveAt(%q) = %v, %v, want %v", spec, got, err, want)
+		}
+	}
+
+	// On the half day, close-5m is 12:55 rather than 15:55
+	if got, err := ResolveAt("close-5m", at(27, 10, 0), cal); err != nil || !got.Equal(at(27, 12, 55)) {
+		t.Errorf("ResolveAt(close-5m, half day) = %v, %v, want 12:55", got, err)
+	}
+}
+
+// TestResolveAtErrors verifies malformed and past times are rejected.
+func TestResolveAtErrors(t *testing.T) {
+	cal := testCalendar()
+	for _, spec := range []string{"", "tomorrow", "25:00", "next-open+5x", "opening", "2026-11-25 09:00", "next-close-2h"} {
+		if got, err := ResolveAt(spec, at(25, 15, 0), cal); err == nil {
+			t.Errorf("ResolveAt(%q) = %v, want error", spec, got)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseOrder decodes an order file into Tradier order parameters. The file is YAML (or JSON)
+// with the same field names as the API, dashes or underscores alike. Multileg, combo, and
+// OTO/OCO/OTOCO legs go in a legs list and become indexed parameters such as option_symbol[1]:
+//
+//	class: multileg
+//	symbol: SPY
+//	type: debit
+//	duration: day
+//	price: 1.25
+//	legs:
+//	  - option_symbol: SPY261218C00600000
+//	    side: buy_to_open
+//	    quantity: 1
+//	  - option_symbol: SPY261218C00610000
+//	    side: sell_to_open
+//	    quantity: 1
+func ParseOrder(data []byte) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse order file: %w", err)
+	}
+
+	params := map[string]string{}
+	for key, val := range raw {
+		key = strings.ReplaceAll(strings.ToLower(key), "-", "_")
+		if key != "legs" {
+			s, err := scalar(val)
+			if err != nil {
+				return nil, fmt.Errorf("order field %s: %w", key, err)
+			}
+			params[key] = s
+			continue
+		}
+
+		legs, ok := val.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("order field legs must be a list")
+		}
+		for i, leg := range legs {
+			fields, ok := leg.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("order leg %d must be a map of fields", i)
+			}
+			for k, v := range fields {
+				s, err := scalar(v)
+				if err != nil {
+					return nil, fmt.Errorf("order leg %d field %s: %w", i, k, err)
+				}
+				params[fmt.Sprintf("%s[%d]", strings.ReplaceAll(strings.ToLower(k), "-", "_"), i)] = s
+			}
+		}
+	}
+
+	if params["class"] == "" {
+		return nil, fmt.Errorf("order file must set class (equity, option, multileg, combo, oto, oco, otoco)")
+	}
+	if _, ok := params["preview"]; ok {
+		return nil, fmt.Errorf("order file must not set preview; the order is always submitted")
+	}
+	return params, nil
+}
+
+// LoadOrder reads and parses an order file.
+func LoadOrder(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read order file: %w", err)
+	}
+	return ParseOrder(data)
+}
+
+// scalar formats a YAML scalar as an API parameter value.
+func scalar(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case string:
+		return x, nil
+	case int:
+		return strconv.Itoa(x), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	}
+	return "", fmt.Errorf("must be a single value, not %T", v)
+}
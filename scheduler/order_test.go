@@ -0,0 +1,60 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseOrder verifies scalar fields, dashed names, and indexed legs.
+func TestParseOrder(t *testing.T) {
+	got, err := ParseOrder([]byte(`
+class: multileg
+symbol: SPY
+type: debit
+duration: day
+price: 1.25
+tag: roll-dec
+legs:
+  - option-symbol: SPY261218C00600000
+    side: buy_to_open
+    quantity: 1
+  - option_symbol: SPY261218C00610000
+    side: sell_to_open
+    quantity: 1
+`))
+	if err != nil {
+		t.Fatalf("ParseOrder() error: %v", err)
+	}
+	want := map[string]string{
+		"class": "multileg", "symbol": "SPY", "type": "debit", "duration": "day", "price": "1.25", "tag": "roll-dec",
+		"option_symbol[0]": "SPY261218C00600000", "side[0]": "buy_to_open", "quantity[0]": "1",
+		"option_symbol[1]": "SPY261218C00610000", "side[1]": "sell_to_open", "quantity[1]": "1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseOrder() = %v, want %v", got, want)
+	}
+
+	// JSON is valid YAML
+	if p, err := ParseOrder([]byte(`{"class":"equity","symbol":"AAPL","side":"buy","quantity":10,"type":"market","duration":"day"}`)); err != nil || p["quantity"] != "10" {
+		t.Errorf("ParseOrder(json) = %v, %v", p, err)
+	}
+}
+
+// TestParseOrderErrors verifies missing class, preview, and malformed legs are rejected.
+func TestParseOrderErrors(t *testing.T) {
+	for _, src := range []string{
+		"symbol: AAPL",
+		"class: equity\npreview: true",
+		"class: multileg\nlegs: nope",
+		"class: multileg\nlegs:\n  - just-a-string",
+		"class: equity\nsymbol: [AAPL, MSFT]",
+		"class: [",
+	} {
+		if _, err := ParseOrder([]byte(src)); err == nil {
+			t.Errorf("ParseOrder(%q) error = nil, want error", src)
+		}
+	}
+}
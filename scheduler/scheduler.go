@@ -0,0 +1,220 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cloudmanic/tradier/config"
+)
+
+// jobsFile is the file name for scheduled orders in the tradier config directory.
+const jobsFile = "scheduled_orders.json"
+
+// Status is where a scheduled order is in its life cycle.
+type Status string
+
+const (
+	// StatusPending is waiting for its submission time.
+	StatusPending Status = "pending"
+
+	// StatusSubmitting is due and being sent. A job left in this state was interrupted
+	// mid-submission and must be reconciled against the account's orders before anything else
+	// happens to it.
+	StatusSubmitting Status = "submitting"
+
+	// StatusSubmitted was accepted by the API.
+	StatusSubmitted Status = "submitted"
+
+	// StatusFailed was rejected by the API or could not be sent.
+	StatusFailed Status = "failed"
+
+	// StatusMissed was not sent because the daemon was not running within the grace period.
+	StatusMissed Status = "missed"
+
+	// StatusCanceled was canceled before its submission time.
+	StatusCanceled Status = "canceled"
+)
+
+// Job is an order staged for submission at a set time. Params are the Tradier order parameters
+// passed to PlaceOrder unchanged, and always include a tag unique to the job so an interrupted
+// submission can be found again.
+type Job struct {
+	ID          int               `json:"id"`
+	At          time.Time         `json:"at"`
+	Spec        string            `json:"spec"`
+	AccountID   string            `json:"account_id"`
+	Sandbox     bool              `json:"sandbox"`
+	Paper       bool              `json:"paper,omitempty"`
+	Params      map[string]string `json:"params"`
+	Status      Status            `json:"status"`
+	Created     time.Time         `json:"created"`
+	SubmittedAt time.Time         `json:"submitted_at,omitzero"`
+	OrderID     string            `json:"order_id,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// DefaultPath returns the path of the scheduled orders file in the tradier config directory.
+func DefaultPath() (string, error) {
+	dir, err := config.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, jobsFile), nil
+}
+
+// Load reads the jobs stored at path. A missing file holds no jobs.
+func Load(path string) ([]Job, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read scheduled orders: %w", err)
+	}
+
+	var jobs []Job
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, fmt.Errorf("unable to parse scheduled orders: %w", err)
+	}
+	return jobs, nil
+}
+
+// Save writes the jobs to path, replacing the file atomically.
+func Save(path string, jobs []Job) error {
+	if jobs == nil {
+		jobs = []Job{}
+	}
+	data, err := json.MarshalIndent(jobs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal scheduled orders: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("unable to create config directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("unable to write scheduled orders: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("unable to write scheduled orders: %w", err)
+	}
+	return nil
+}
+
+// Tag returns the order tag that identifies a scheduled order's submission. Tradier tags allow
+// letters, digits, and dashes.
+func Tag(j Job) string {
+	return fmt.Sprintf("sched-%d-%d", j.ID, j.Created.Unix())
+}
+
+// Add appends a pending job with the next unused ID, tagging it with Tag unless the order file
+// set its own tag, and returns the updated jobs and the new job.
+func Add(jobs []Job, j Job) ([]Job, Job) {
+	j.ID = 0
+	for _, existing := range jobs {
+		j.ID = max(j.ID, existing.ID)
+	}
+	j.ID++
+	j.Status = StatusPending
+	if j.Params["tag"] == "" {
+		params := map[string]string{"tag": Tag(j)}
+		for k, v := range j.Params {
+			if k != "tag" {
+				params[k] = v
+			}
+		}
+		j.Params = params
+	}
+	return append(jobs, j), j
+}
+
+// Cancel marks a pending job canceled. It fails if the job does not exist or already ran.
+func Cancel(jobs []Job, id int) error {
+	for i := range jobs {
+		if jobs[i].ID != id {
+			continue
+		}
+		if jobs[i].Status != StatusPending {
+			return fmt.Errorf("scheduled order %d is already %s", id, jobs[i].Status)
+		}
+		jobs[i].Status = StatusCanceled
+		return nil
+	}
+	return fmt.Errorf("no scheduled order with ID %d", id)
+}
+
+// Claim moves a pending job to submitting and returns it. The caller saves the jobs before
+// sending, so that once a job is claimed no later run can send it again. It fails if the job is
+// no longer pending, for example because it was canceled meanwhile.
+func Claim(jobs []Job, id int, now time.Time) (Job, error) {
+	for i := range jobs {
+		if jobs[i].ID != id {
+			continue
+		}
+		if jobs[i].Status != StatusPending {
+			return Job{}, fmt.Errorf("scheduled order %d is already %s", id, jobs[i].Status)
+		}
+		jobs[i].Status = StatusSubmitting
+		jobs[i].SubmittedAt = now
+		return jobs[i], nil
+	}
+	return Job{}, fmt.Errorf("no scheduled order with ID %d", id)
+}
+
+// Interrupted returns the jobs for the given environment left in submitting by a run that
+// stopped while sending them.
+func Interrupted(jobs []Job, sandbox, paper bool) []Job {
+	var out []Job
+	for _, j := range jobs {
+		if j.Status == StatusSubmitting && j.Sandbox == sandbox && j.Paper == paper {
+			out = append(out, j)
+		}
+	}
+	return out
+}
+
+// Due returns the pending jobs for the given environment (sandbox, paper, or production) whose
+// time has come, earliest first.
+func Due(jobs []Job, sandbox, paper bool, now time.Time) []Job {
+	var out []Job
+	for _, j := range jobs {
+		if j.Status == StatusPending && j.Sandbox == sandbox && j.Paper == paper && !j.At.After(now) {
+			out = append(out, j)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].At.Before(out[b].At) })
+	return out
+}
+
+// NextAt returns the earliest submission time among pending jobs for the environment.
+func NextAt(jobs []Job, sandbox, paper bool) (time.Time, bool) {
+	var next time.Time
+	for _, j := range jobs {
+		if j.Status == StatusPending && j.Sandbox == sandbox && j.Paper == paper && (next.IsZero() || j.At.Before(next)) {
+			next = j.At
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// Record stores the outcome of a submission attempt on the job with the same ID. Only a claimed
+// job is updated, so an outcome never overwrites a job changed by something else.
+func Record(jobs []Job, outcome Job) {
+	for i := range jobs {
+		if jobs[i].ID == outcome.ID && jobs[i].Status == StatusSubmitting {
+			jobs[i].Status = outcome.Status
+			jobs[i].SubmittedAt = outcome.SubmittedAt
+			jobs[i].OrderID = outcome.OrderID
+			jobs[i].Error = outcome.Error
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package scheduler
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestStore verifies jobs round-trip through the file with increasing IDs.
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	jobs, err := Load(path)
+	if err != nil || len(jobs) != 0 {
+		t.Fatalf("Load(missing) = %v, %v, want empty", jobs, err)
+	}
+
+	jobs, first := Add(jobs, Job{At: at(25, 15, 55), Params: map[string]string{"class": "equity"}})
+	jobs, second := Add(jobs, Job{ID: 50, At: at(27, 9, 35), Status: StatusFailed})
+	if first.ID != 1 || second.ID != 2 || second.Status != StatusPending {
+		t.Errorf("Add() = %+v, %+v", first, second)
+	}
+	if first.Params["tag"] != Tag(first) || first.Params["class"] != "equity" {
+		t.Errorf("Add() params = %v, want the job tag added", first.Params)
+	}
+	if _, tagged := Add(jobs, Job{Params: map[string]string{"tag": "mine"}}); tagged.Params["tag"] != "mine" {
+		t.Errorf("Add() replaced the order's own tag: %v", tagged.Params)
+	}
+	if err := Save(path, jobs); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil || len(loaded) != 2 || loaded[0].Params["class"] != "equity" || !loaded[1].At.Equal(at(27, 9, 35)) {
+		t.Errorf("Load() = %+v, %v", loaded, err)
+	}
+}
+
+// TestDue verifies only pending jobs for the environment whose time has passed are returned.
+func TestDue(t *testing.T) {
+	jobs := []Job{
+		{ID: 1, At: at(25, 15, 55), Status: StatusPending},
+		{ID: 2, At: at(25, 10, 0), Status: StatusPending},
+		{ID: 3, At: at(25, 9, 0), Status: StatusCanceled},
+		{ID: 4, At: at(25, 9, 0), Status: StatusPending, Sandbox: true},
+		{ID: 5, At: at(27, 9, 35), Status: StatusPending},
+		{ID: 6, At: at(25, 9, 0), Status: StatusPending, Paper: true},
+	}
+	due := Due(jobs, false, false, at(25, 16, 0))
+	if len(due) != 2 || due[0].ID != 2 || due[1].ID != 1 {
+		t.Errorf("Due() = %+v, want jobs 2 and 1", due)
+	}
+	if next, ok := NextAt(jobs, true, false); !ok || !next.Equal(at(25, 9, 0)) {
+		t.Errorf("NextAt(sandbox) = %v, %v", next, ok)
+	}
+	if _, ok := NextAt(jobs[2:3], false, false); ok {
+		t.Errorf("NextAt(no pending) ok = true, want false")
+	}
+	if due := Due(jobs, false, true, at(25, 16, 0)); len(due) != 1 || due[0].ID != 6 {
+		t.Errorf("Due(paper) = %+v, want job 6", due)
+	}
+}
+
+// TestCancelAndRecord verifies cancellation rules and outcome recording.
+func TestCancelAndRecord(t *testing.T) {
+	jobs := []Job{{ID: 1, Status: StatusPending}, {ID: 2, Status: StatusPending}}
+	if err := Cancel(jobs, 1); err != nil || jobs[0].Status != StatusCanceled {
+		t.Errorf("Cancel(1) = %v, status %s", err, jobs[0].Status)
+	}
+	if err := Cancel(jobs, 1); err == nil {
+		t.Errorf("Cancel(canceled) error = nil, want error")
+	}
+	if err := Cancel(jobs, 9); err == nil {
+		t.Errorf("Cancel(missing) error = nil, want error")
+	}
+
+	// An outcome only lands on a claimed job
+	Record(jobs, Job{ID: 2, Status: StatusSubmitted, OrderID: "12345", SubmittedAt: at(25, 15, 55)})
+	if jobs[1].Status != StatusPending {
+		t.Errorf("Record(unclaimed) = %+v, want it left pending", jobs[1])
+	}
+	if _, err := Claim(jobs, 2, at(25, 15, 55)); err != nil {
+		t.Fatalf("Claim(2) error: %v", err)
+	}
+	Record(jobs, Job{ID: 2, Status: StatusSubmitted, OrderID: "12345", SubmittedAt: at(25, 15, 55)})
+	if jobs[1].Status != StatusSubmitted || jobs[1].OrderID != "12345" || jobs[1].SubmittedAt.IsZero() {
+		t.Errorf("Record() = %+v", jobs[1])
+	}
+}
+
+// TestClaim verifies a job can be claimed once, claimed jobs cannot be canceled, and
+// interrupted claims are found for their environment.
+func TestClaim(t *testing.T) {
+	jobs := []Job{{ID: 1, Status: StatusPending}, {ID: 2, Status: StatusCanceled}, {ID: 3, Status: StatusPending, Sandbox: true}}
+	claimed, err := Claim(jobs, 1, at(25, 15, 55))
+	if err != nil || claimed.Status != StatusSubmitting || !claimed.SubmittedAt.Equal(at(25, 15, 55)) {
+		t.Fatalf("Claim(1) = %+v, %v", claimed, err)
+	}
+	if _, err := Claim(jobs, 1, at(25, 15, 55)); err == nil {
+		t.Errorf("Claim(claimed) error = nil, want error")
+	}
+	if _, err := Claim(jobs, 2, at(25, 15, 55)); err == nil {
+		t.Errorf("Claim(canceled) error = nil, want error")
+	}
+	if err := Cancel(jobs, 1); err == nil {
+		t.Errorf("Cancel(submitting) error = nil, want error")
+	}
+	if _, err := Claim(jobs, 3, at(25, 15, 55)); err != nil {
+		t.Fatalf("Claim(3) error: %v", err)
+	}
+	if got := Interrupted(jobs, false, false); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("Interrupted() = %+v, want job 1", got)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package spreads
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cloudmanic/tradier/occ"
+)
+
+// Strategy names reported by Detect.
+const (
+	Collar       = "collar"
+	IronCondor   = "iron_condor"
+	CoveredCall  = "covered_call"
+	Straddle     = "straddle"
+	Strangle     = "strangle"
+	CallVertical = "call_vertical"
+	PutVertical  = "put_vertical"
+)
+
+// sharesPerContract is the standard equity option multiplier.
+const sharesPerContract = 100
+
+// Position is a held quantity of a stock or OCC option symbol. Short positions are negative.
+type Position struct {
+	Symbol   string
+	Quantity float64
+}
+
+// Group is a set of positions that together form a recognised option strategy.
+type Group struct {
+	Strategy   string   `json:"strategy"`
+	Underlying string   `json:"underlying"`
+	Expiration string   `json:"expiration"`
+	Quantity   float64  `json:"quantity"`
+	Symbols    []string `json:"symbols"`
+	Label      string   `json:"label"`
+}
+
+// leg is an option position with its parsed OCC fields and remaining unmatched quantity.
+type leg struct {
+	symbol string
+	opt    occ.Symbol
+	qty    float64
+}
+
+// Detect finds option strategies in a set of positions. Positions are matched greedily from the
+// most to the least complex structure (collar, iron condor, covered call, straddle, strangle,
+// vertical), and each contract is used by at most one group. Quantities must match exactly
+// across legs, so partially hedged positions are left ungrouped.
+func Detect(positions []Position) []Group {
+	stock := map[string]float64{}
+	var legs []*leg
+	for _, p := range positions {
+		if o, err := occ.Parse(p.Symbol); err == nil {
+			legs = append(legs, &leg{symbol: p.Symbol, opt: o, qty: p.Quantity})
+			continue
+		}
+		stock[p.Symbol] += p.Quantity
+	}
+
+	// Deterministic matching regardless of input order
+	sort.Slice(legs, func(i, j int) bool { return legs[i].symbol < legs[j].symbol })
+
+	var groups []Group
+	groups = append(groups, detectCollars(stock, legs)...)
+	groups = append(groups, detectIronCondors(legs)...)
+	groups = append(groups, detectCoveredCalls(stock, legs)...)
+	groups = append(groups, detectStraddles(legs)...)
+	groups = append(groups, detectVerticals(legs)...)
+	return groups
+}
+
+// detectCollars pairs long stock with a long put and a short call of the same size and expiration.
+func detectCollars(stock map[string]float64, legs []*leg) []Group {
+	var groups []Group
+	for _, put := range legs {
+		if put.opt.Call || put.qty <= 0 {
+			continue
+		}
+		for _, call := range legs {
+			if !call.opt.Call || call.qty != -put.qty || !sameSeries(put, call) || call.opt.Strike <= put.opt.Strike {
+				continue
+			}
+			shares := put.qty * sharesPerContract
+			if stock[put.opt.Root] < shares {
+				continue
+			}
+			stock[put.opt.Root] -= shares
+			groups = append(groups, newGroup(Collar, put.qty, []*leg{put, call},
+				fmt.Sprintf("%s Collar %s %s/%s", put.opt.Root, expiry(put), strike(put), strike(call)), put.opt.Root))
+			put.qty, call.qty = 0, 0
+			break
+		}
+	}
+	return groups
+}
+
+// detectIronCondors finds a short put vertical and short call vertical sharing an expiration,
+// or the long (debit) mirror image of the same structure: long the inner strikes and short the
+// wings. Short condors have a negative quantity and long condors a positive one.
+func detectIronCondors(legs []*leg) []Group {
+	var groups []Group
+	for _, ip := range legs {
+		if ip.opt.Call || ip.qty == 0 {
+			continue
+		}
+		n := ip.qty
+		op := findLeg(legs, func(l *leg) bool {
+			return !l.opt.Call && l.qty == -n && sameSeries(l, ip) && l.opt.Strike < ip.opt.Strike
+		})
+		ic := findLeg(legs, func(l *leg) bool {
+			return l.opt.Call && l.qty == n && sameSeries(l, ip) && l.opt.Strike > ip.opt.Strike
+		})
+		if op == nil || ic == nil {
+			continue
+		}
+		oc := findLeg(legs, func(l *leg) bool {
+			return l.opt.Call && l.qty == -n && sameSeries(l, ip) && l.opt.Strike > ic.opt.Strike
+		})
+		if oc == nil {
+			continue
+		}
+		name := "Iron Condor"
+		if n > 0 {
+			name = "Long Iron Condor"
+		}
+		four := []*leg{op, ip, ic, oc}
+		groups = append(groups, newGroup(IronCondor, n, four,
+			fmt.Sprintf("%s %s %s %s/%s/%s/%s", ip.opt.Root, name, expiry(ip), strike(op), strike(ip), strike(ic), strike(oc)), ip.opt.Root))
+		for _, l := range four {
+			l.qty = 0
+		}
+	}
+	return groups
+}
+
+// detectCoveredCalls pairs short calls with enough long stock to cover them.
+func detectCoveredCalls(stock map[string]float64, legs []*leg) []Group {
+	var groups []Group
+	for _, call := range legs {
+		if !call.opt.Call || call.qty >= 0 {
+			continue
+		}
+		shares := -call.qty * sharesPerContract
+		if stock[call.opt.Root] < shares {
+			continue
+		}
+		stock[call.opt.Root] -= shares
+		groups = append(groups, newGroup(CoveredCall, -call.qty, []*leg{call},
+			fmt.Sprintf("%s Covered Call %s %s", call.opt.Root, expiry(call), strike(call)), call.opt.Root))
+		call.qty = 0
+	}
+	return groups
+}
+
+// detectStraddles pairs a call and put of the same quantity and expiration. Equal strikes form
+// a straddle; a put strike below the call strike forms a strangle.
+func detectStraddles(legs []*leg) []Group {
+	var groups []Group
+	for _, put := range legs {
+		if put.opt.Call || put.qty == 0 {
+			continue
+		}
+		call := findLeg(legs, func(l *leg) bool {
+			return l.opt.Call && l.qty == put.qty && sameSeries(l, put) && l.opt.Strike == put.opt.Strike
+		})
+		name, label := Straddle, "Straddle"
+		if call == nil {
+			call = findLeg(legs, func(l *leg) bool {
+				return l.opt.Call && l.qty == put.qty && sameSeries(l, put) && l.opt.Strike > put.opt.Strike
+			})
+			name, label = Strangle, "Strangle"
+		}
+		if call == nil {
+			continue
+		}
+		side := "Long"
+		if put.qty < 0 {
+			side = "Short"
+		}
+		strikes := strike(put)
+		if name == Strangle {
+			strikes += "/" + strike(call)
+		}
+		groups = append(groups, newGroup(name, put.qty, []*leg{put, call},
+			fmt.Sprintf("%s %s %s %s %s", put.opt.Root, side, label, expiry(put), strikes), put.opt.Root))
+		put.qty, call.qty = 0, 0
+	}
+	return groups
+}
+
+// detectVerticals pairs a long and short option of the same type and expiration at different strikes.
+func detectVerticals(legs []*leg) []Group {
+	var groups []Group
+	for _, long := range legs {
+		if long.qty <= 0 {
+			continue
+		}
+		short := findLeg(legs, func(l *leg) bool {
+			return l.opt.Call == long.opt.Call && l.qty == -long.qty && sameSeries(l, long) && l.opt.Strike != long.opt.Strike
+		})
+		if short == nil {
+			continue
+		}
+		name, kind := PutVertical, "Put"
+		if long.opt.Call {
+			name, kind = CallVertical, "Call"
+		}
+		lo, hi := long, short
+		if lo.opt.Strike > hi.opt.Strike {
+			lo, hi = hi, lo
+		}
+		groups = append(groups, newGroup(name, long.qty, []*leg{lo, hi},
+			fmt.Sprintf("%s %s Vertical %s %s/%s", long.opt.Root, kind, expiry(long), strike(lo), strike(hi)), long.opt.Root))
+		long.qty, short.qty = 0, 0
+	}
+	return groups
+}
+
+// findLeg returns the first leg with remaining quantity that satisfies match.
+func findLeg(legs []*leg, match func(*leg) bool) *leg {
+	for _, l := range legs {
+		if l.qty != 0 && match(l) {
+			return l
+		}
+	}
+	return nil
+}
+
+// sameSeries reports whether two legs share an underlying and expiration.
+func sameSeries(a, b *leg) bool {
+	return a.opt.Root == b.opt.Root && a.opt.Expiration.Equal(b.opt.Expiration)
+}
+
+// newGroup builds a Group from its legs. Stock legs are represented by the underlying symbol.
+func newGroup(strategy string, qty float64, legs []*leg, label, underlying string) Group {
+	g := Group{
+		Strategy:   strategy,
+		Underlying: underlying,
+		Expiration: legs[0].opt.Expiration.Format("2006-01-02"),
+		Quantity:   qty,
+		Label:      label,
+	}
+	if strategy == Collar || strategy == CoveredCall {
+		g.Symbols = append(g.Symbols, underlying)
+	}
+	for _, l := range legs {
+		g.Symbols = append(g.Symbols, l.symbol)
+	}
+	return g
+}
+
+// expiry formats a leg's expiration for labels.
+func expiry(l *leg) string {
+	return l.opt.Expiration.Format("2006-01-02")
+}
+
+// strike formats a leg's strike for labels, dropping decimals for whole numbers.
+func strike(l *leg) string {
+	if l.opt.Strike == math.Trunc(l.opt.Strike) {
+		return fmt.Sprintf("%.0f", l.opt.Strike)
+	}
+	return strings.TrimRight(fmt.Sprintf("%.2f", l.opt.Strike), "0")
+}
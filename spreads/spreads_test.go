@@ -0,0 +1,124 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package spreads
+
+import (
+	"reflect"
+	"testing"
+)
+
+// strategies returns the strategy names of the detected groups in order.
+func strategies(groups []Group) []string {
+	out := make([]string, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, g.Strategy)
+	}
+	return out
+}
+
+// TestDetectVertical verifies a long and short call at different strikes form a vertical.
+func TestDetectVertical(t *testing.T) {
+	groups := Detect([]Position{
+		{"AAPL260619C00250000", 2},
+		{"AAPL260619C00260000", -2},
+	})
+	if len(groups) != 1 || groups[0].Strategy != CallVertical {
+		t.Fatalf("Detect() = %+v, want one call vertical", groups)
+	}
+	g := groups[0]
+	if g.Label != "AAPL Call Vertical 2026-06-19 250/260" {
+		t.Errorf("Label = %q", g.Label)
+	}
+	if g.Quantity != 2 || len(g.Symbols) != 2 {
+		t.Errorf("Quantity = %v, Symbols = %v", g.Quantity, g.Symbols)
+	}
+}
+
+// TestDetectIronCondor verifies four legs form a condor instead of two verticals.
+func TestDetectIronCondor(t *testing.T) {
+	groups := Detect([]Position{
+		{"SPY260320P00500000", 1},
+		{"SPY260320P00510000", -1},
+		{"SPY260320C00560000", -1},
+		{"SPY260320C00570000", 1},
+	})
+	if !reflect.DeepEqual(strategies(groups), []string{IronCondor}) {
+		t.Fatalf("Detect() = %v, want [iron_condor]", strategies(groups))
+	}
+	if groups[0].Label != "SPY Iron Condor 2026-03-20 500/510/560/570" || groups[0].Quantity != -1 {
+		t.Errorf("Label = %q, Quantity = %v", groups[0].Label, groups[0].Quantity)
+	}
+}
+
+// TestDetectLongIronCondor verifies the debit mirror image, long the inner strikes and short the
+// wings, forms a long condor instead of two verticals.
+func TestDetectLongIronCondor(t *testing.T) {
+	groups := Detect([]Position{
+		{"SPY260320P00500000", -2},
+		{"SPY260320P00510000", 2},
+		{"SPY260320C00560000", 2},
+		{"SPY260320C00570000", -2},
+	})
+	if !reflect.DeepEqual(strategies(groups), []string{IronCondor}) {
+		t.Fatalf("Detect() = %v, want [iron_condor]", strategies(groups))
+	}
+	if groups[0].Label != "SPY Long Iron Condor 2026-03-20 500/510/560/570" || groups[0].Quantity != 2 {
+		t.Errorf("Label = %q, Quantity = %v", groups[0].Label, groups[0].Quantity)
+	}
+}
+
+// TestDetectCoveredCallAndCollar verifies stock coverage is consumed by collars before covered calls.
+func TestDetectCoveredCallAndCollar(t *testing.T) {
+	groups := Detect([]Position{
+		{"MSFT", 300},
+		{"MSFT260619P00380000", 1},
+		{"MSFT260619C00450000", -1},
+		{"MSFT260717C00460000", -2},
+	})
+	if !reflect.DeepEqual(strategies(groups), []string{Collar, CoveredCall}) {
+		t.Fatalf("Detect() = %v, want [collar covered_call]", strategies(groups))
+	}
+	if groups[0].Symbols[0] != "MSFT" {
+		t.Errorf("collar symbols = %v, want stock first", groups[0].Symbols)
+	}
+}
+
+// TestDetectUncoveredCall verifies a short call without enough stock is not a covered call.
+func TestDetectUncoveredCall(t *testing.T) {
+	groups := Detect([]Position{{"MSFT", 50}, {"MSFT260619C00450000", -1}})
+	if len(groups) != 0 {
+		t.Errorf("Detect() = %v, want none", strategies(groups))
+	}
+}
+
+// TestDetectStraddleAndStrangle verifies same-strike and split-strike call/put pairs.
+func TestDetectStraddleAndStrangle(t *testing.T) {
+	groups := Detect([]Position{
+		{"TSLA260116C00300000", -1},
+		{"TSLA260116P00300000", -1},
+		{"NVDA260116C00150000", 3},
+		{"NVDA260116P00120000", 3},
+	})
+	got := map[string]string{}
+	for _, g := range groups {
+		got[g.Underlying] = g.Label
+	}
+	if got["TSLA"] != "TSLA Short Straddle 2026-01-16 300" {
+		t.Errorf("TSLA label = %q", got["TSLA"])
+	}
+	if got["NVDA"] != "NVDA Long Strangle 2026-01-16 120/150" {
+		t.Errorf("NVDA label = %q", got["NVDA"])
+	}
+}
+
+// TestDetectMismatchedQuantity verifies legs with different sizes are left ungrouped.
+func TestDetectMismatchedQuantity(t *testing.T) {
+	groups := Detect([]Position{
+		{"AAPL260619P00250000", 2},
+		{"AAPL260619P00240000", -1},
+	})
+	if len(groups) != 0 {
+		t.Errorf("Detect() = %v, want none", strategies(groups))
+	}
+}
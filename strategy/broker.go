@@ -0,0 +1,293 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/paper"
+)
+
+// Broker places and cancels orders and reports the account a strategy trades.
+type Broker interface {
+	// PlaceOrder places an order from Tradier order parameters, as client.Client.PlaceOrder
+	// takes them, and returns its ID.
+	PlaceOrder(params map[string]string) (int, error)
+
+	// CancelOrder cancels a working order.
+	CancelOrder(orderID int) error
+
+	// Positions returns the account's open positions.
+	Positions() ([]Position, error)
+
+	// Orders returns the account's orders, working or not, as the broker reports them.
+	Orders() ([]Order, error)
+
+	// Balance returns the account's cash and total equity.
+	Balance() (Balance, error)
+}
+
+// Account is the set of client.Client's account and order calls a Broker is built on. Both
+// *client.Client and *paper.Engine implement it.
+type Account interface {
+	PlaceOrder(accountID string, params map[string]string) ([]byte, error)
+	CancelOrder(accountID, orderID string) ([]byte, error)
+	GetOrders(accountID, page, limit, includeTags string) ([]byte, error)
+	GetPositions(accountID string) ([]byte, error)
+	GetBalances(accountID string) ([]byte, error)
+}
+
+var (
+	_ Account = (*client.Client)(nil)
+	_ Account = (*paper.Engine)(nil)
+)
+
+// Position is an open holding. Quantity is negative for shorts, and CostBasis is the signed
+// total paid for it.
+type Position struct {
+	Symbol       string    `json:"symbol"`
+	Quantity     float64   `json:"quantity"`
+	CostBasis    float64   `json:"cost_basis"`
+	DateAcquired time.Time `json:"date_acquired,omitzero"`
+}
+
+// Order is an order as the broker reports it. A multileg order's legs are in Legs.
+type Order struct {
+	ID           int       `json:"id"`
+	Class        string    `json:"class"`
+	Symbol       string    `json:"symbol"`
+	OptionSymbol string    `json:"option_symbol,omitempty"`
+	Side         string    `json:"side,omitempty"`
+	Type         string    `json:"type"`
+	Duration     string    `json:"duration"`
+	Status       string    `json:"status"`
+	Quantity     float64   `json:"quantity"`
+	Price        float64   `json:"price,omitempty"`
+	Stop         float64   `json:"stop_price,omitempty"`
+	ExecQuantity float64   `json:"exec_quantity"`
+	AvgFillPrice float64   `json:"avg_fill_price"`
+	Tag          string    `json:"tag,omitempty"`
+	Updated      time.Time `json:"transaction_date,omitzero"`
+	Legs         []Order   `json:"leg,omitempty"`
+}
+
+// Working reports whether the order can still fill.
+func (o Order) Working() bool {
+	switch o.Status {
+	case "open", "partially_filled", "pending":
+		return true
+	}
+	return false
+}
+
+// Instrument returns the symbol the order trades: the option symbol for options.
+func (o Order) Instrument() string {
+	if o.OptionSymbol != "" {
+		return o.OptionSymbol
+	}
+	return o.Symbol
+}
+
+// Balance is an account's cash and the value of everything in it.
+type Balance struct {
+	Cash   float64 `json:"total_cash"`
+	Equity float64 `json:"total_equity"`
+}
+
+// accountBroker is a Broker over an Account.
+type accountBroker struct {
+	account   Account
+	accountID string
+}
+
+// NewAccountBroker returns a Broker trading accountID through account: a *client.Client for a
+// Tradier account, or a *paper.Engine with paper.AccountNumber for the paper account.
+func NewAccountBroker(account Account, accountID string) Broker {
+	return &accountBroker{account: account, accountID: accountID}
+}
+
+// PlaceOrder implements Broker.
+func (b *accountBroker) PlaceOrder(params map[string]string) (int, error) {
+	data, err := b.account.PlaceOrder(b.accountID, params)
+	if err != nil {
+		return 0, err
+	}
+	var resp struct {
+		Order struct {
+			ID int `json:"id"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil || resp.Order.ID == 0 {
+		return 0, fmt.Errorf("order was not accepted: %s", data)
+	}
+	return resp.Order.ID, nil
+}
+
+// CancelOrder implements Broker.
+func (b *accountBroker) CancelOrder(orderID int) error {
+	_, err := b.account.CancelOrder(b.accountID, strconv.Itoa(orderID))
+	return err
+}
+
+// Positions implements Broker.
+func (b *accountBroker) Positions() ([]Position, error) {
+	data, err := b.account.GetPositions(b.accountID)
+	if err != nil {
+		return nil, err
+	}
+	var list []struct {
+		Position
+		DateAcquired string `json:"date_acquired"`
+	}
+	if err := unmarshalNested(data, "positions", "position", &list); err != nil {
+		return nil, fmt.Errorf("unable to parse positions: %w", err)
+	}
+	out := make([]Position, 0, len(list))
+	for _, p := range list {
+		p.Position.DateAcquired = parseTime(p.DateAcquired)
+		out = append(out, p.Position)
+	}
+	return out, nil
+}
+
+// Orders implements Broker.
+func (b *accountBroker) Orders() ([]Order, error) {
+	data, err := b.account.GetOrders(b.accountID, "", "", "true")
+	if err != nil {
+		return nil, err
+	}
+	var list []wireOrder
+	if err := unmarshalNested(data, "orders", "order", &list); err != nil {
+		return nil, fmt.Errorf("unable to parse orders: %w", err)
+	}
+	out := make([]Order, 0, len(list))
+	for _, o := range list {
+		out = append(out, o.order())
+	}
+	return out, nil
+}
+
+// Balance implements Broker.
+func (b *accountBroker) Balance() (Balance, error) {
+	data, err := b.account.GetBalances(b.accountID)
+	if err != nil {
+		return Balance{}, err
+	}
+	var resp struct {
+		Balances Balance `json:"balances"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return Balance{}, fmt.Errorf("unable to parse balances: %w", err)
+	}
+	return resp.Balances, nil
+}
+
+// unmarshalNested decodes the list at data[outer][inner] into v. Tradier returns the string
+// "null" for an empty list and a bare object for a list of one.
+func unmarshalNested(data []byte, outer, inner string, v interface{}) error {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(data, &top); err != nil {
+		return err
+	}
+	wrapper := top[outer]
+	if len(wrapper) == 0 || wrapper[0] != '{' {
+		return nil
+	}
+	var mid map[string]json.RawMessage
+	if err := json.Unmarshal(wrapper, &mid); err != nil {
+		return err
+	}
+	raw := mid[inner]
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	if raw[0] != '[' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// wireOrder is an order as Tradier sends it, with its timestamp and legs still to convert.
+type wireOrder struct {
+	Order
+	Updated string      `json:"transaction_date"`
+	Legs    []wireOrder `json:"leg"`
+}
+
+// order converts the order and its legs.
+func (w wireOrder) order() Order {
+	o := w.Order
+	o.Updated = parseTime(w.Updated)
+	o.Legs = nil
+	for _, l := range w.Legs {
+		o.Legs = append(o.Legs, l.order())
+	}
+	return o
+}
+
+// parseTime parses a Tradier timestamp, returning the zero time for one it can't read.
+func parseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// filled is how much of an order or leg had filled, and at what average price, when last seen.
+type filled struct {
+	quantity float64
+	price    float64
+}
+
+// fillTracker turns changes in orders' filled quantities into fills.
+type fillTracker struct {
+	seen map[string]filled
+}
+
+// newFillTracker returns a tracker that treats what orders have already filled as seen.
+func newFillTracker(orders []Order) *fillTracker {
+	t := &fillTracker{seen: map[string]filled{}}
+	t.diff(orders)
+	return t
+}
+
+// diff returns the fills since orders were last seen. The price of each is worked out from the
+// change in the average fill price, so fills between two looks are combined into one.
+func (t *fillTracker) diff(orders []Order) []Fill {
+	var out []Fill
+	for _, o := range orders {
+		legs := o.Legs
+		if len(legs) == 0 {
+			legs = []Order{o}
+		}
+		for i, l := range legs {
+			key := fmt.Sprintf("%d/%d", o.ID, i)
+			prev := t.seen[key]
+			if l.ExecQuantity <= prev.quantity {
+				continue
+			}
+			t.seen[key] = filled{quantity: l.ExecQuantity, price: l.AvgFillPrice}
+			qty := l.ExecQuantity - prev.quantity
+			updated := l.Updated
+			if updated.IsZero() {
+				updated = o.Updated
+			}
+			out = append(out, Fill{
+				OrderID:  o.ID,
+				Symbol:   l.Instrument(),
+				Side:     l.Side,
+				Quantity: qty,
+				Price:    (l.AvgFillPrice*l.ExecQuantity - prev.price*prev.quantity) / qty,
+				Tag:      o.Tag,
+				Time:     updated,
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/paper"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// TestAccountBrokerPaper verifies a broker over the paper account places, reports, and cancels orders.
+func TestAccountBrokerPaper(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, pricing.Eastern())
+	quotes := map[string]paper.Quote{"SPY": {Symbol: "SPY", Bid: 600, Ask: 600.1, Last: 600}}
+	s := paper.NewState(100000, paper.Commission{}, now)
+	e := paper.NewMemory(s, func([]string) (map[string]paper.Quote, error) { return quotes, nil }, nil, func() time.Time { return now })
+	b := NewAccountBroker(e, paper.AccountNumber)
+
+	id, err := b.PlaceOrder(map[string]string{"class": "equity", "symbol": "SPY", "side": "buy", "quantity": "10", "type": "market", "duration": "day", "tag": "entry"})
+	if err != nil || id != 1 {
+		t.Fatalf("PlaceOrder() = %d, %v, want 1", id, err)
+	}
+	limit, err := b.PlaceOrder(map[string]string{"class": "equity", "symbol": "SPY", "side": "sell", "quantity": "10", "type": "limit", "price": "650", "duration": "gtc"})
+	if err != nil {
+		t.Fatalf("PlaceOrder() error: %v", err)
+	}
+	if _, err := b.PlaceOrder(map[string]string{"class": "equity", "symbol": "SPY", "side": "buy", "quantity": "1000", "type": "market", "duration": "day"}); err == nil {
+		t.Errorf("PlaceOrder() beyond the cash error = nil, want rejection")
+	}
+
+	orders, err := b.Orders()
+	if err != nil || len(orders) != 2 {
+		t.Fatalf("Orders() = %+v, %v, want 2", orders, err)
+	}
+	if o := orders[0]; o.Status != "filled" || o.ExecQuantity != 10 || o.AvgFillPrice != 600.1 || o.Tag != "entry" || o.Working() || !o.Updated.Equal(now) {
+		t.Errorf("Orders()[0] = %+v", o)
+	}
+	if !orders[1].Working() || orders[1].Price != 650 {
+		t.Errorf("Orders()[1] = %+v, want the working limit", orders[1])
+	}
+
+	positions, err := b.Positions()
+	if err != nil || len(positions) != 1 || positions[0].Symbol != "SPY" || positions[0].Quantity != 10 || !positions[0].DateAcquired.Equal(now) {
+		t.Errorf("Positions() = %+v, %v", positions, err)
+	}
+	if bal, err := b.Balance(); err != nil || bal.Cash != 100000-6001 || bal.Equity != 100000-6001+6000.5 {
+		t.Errorf("Balance() = %+v, %v", bal, err)
+	}
+
+	if err := b.CancelOrder(limit); err != nil {
+		t.Fatalf("CancelOrder() error: %v", err)
+	}
+	if orders, _ := b.Orders(); orders[1].Working() {
+		t.Errorf("order %d still working after CancelOrder()", limit)
+	}
+}
+
+// TestAccountBrokerClient verifies a broker over client.Client reads Tradier's single-object and empty lists.
+func TestAccountBrokerClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/accounts/A1/positions":
+			w.Write([]byte(`{"positions":"null"}`))
+		case "/v1/accounts/A1/orders":
+			w.Write([]byte(`{"orders":{"order":{"id":7,"type":"debit","symbol":"SPY","status":"filled","quantity":1,"exec_quantity":1,"avg_fill_price":1.2,"transaction_date":"2026-10-19T14:00:00.000Z","class":"multileg","tag":"spread",
+				"leg":[{"id":7,"symbol":"SPY","side":"buy_to_open","option_symbol":"SPY261120C00600000","exec_quantity":1,"avg_fill_price":3.5,"transaction_date":"2026-10-19T14:00:00.000Z"},
+				{"id":7,"symbol":"SPY","side":"sell_to_open","option_symbol":"SPY261120C00610000","exec_quantity":1,"avg_fill_price":2.3,"transaction_date":"2026-10-19T14:00:00.000Z"}]}}}`))
+		}
+	}))
+	defer server.Close()
+	b := NewAccountBroker(client.NewClient(server.URL, "key"), "A1")
+
+	if positions, err := b.Positions(); err != nil || len(positions) != 0 {
+		t.Errorf("Positions() = %+v, %v, want none", positions, err)
+	}
+	orders, err := b.Orders()
+	if err != nil || len(orders) != 1 || len(orders[0].Legs) != 2 || orders[0].Legs[1].Instrument() != "SPY261120C00610000" {
+		t.Fatalf("Orders() = %+v, %v", orders, err)
+	}
+	if want := time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC); !orders[0].Legs[0].Updated.Equal(want) {
+		t.Errorf("leg Updated = %v, want %v", orders[0].Legs[0].Updated, want)
+	}
+
+	fills := newFillTracker(nil).diff(orders)
+	if len(fills) != 2 || fills[0].Symbol != "SPY261120C00600000" || fills[0].Price != 3.5 || fills[1].Side != "sell_to_open" || fills[1].Tag != "spread" {
+		t.Errorf("fills = %+v", fills)
+	}
+}
+
+// TestFillTracker verifies only new fills are reported, each at the price of the shares it added.
+func TestFillTracker(t *testing.T) {
+	order := Order{ID: 1, Symbol: "AAPL", Side: "buy", Quantity: 100, ExecQuantity: 40, AvgFillPrice: 10}
+	tracker := newFillTracker([]Order{order})
+	if fills := tracker.diff([]Order{order}); len(fills) != 0 {
+		t.Errorf("diff() with nothing new = %+v", fills)
+	}
+
+	order.ExecQuantity, order.AvgFillPrice = 100, 10.6
+	fills := tracker.diff([]Order{order})
+	if len(fills) != 1 || fills[0].Quantity != 60 || !near(fills[0].Price, 11) {
+		t.Errorf("diff() = %+v, want 60 at 11", fills)
+	}
+}
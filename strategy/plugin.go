@@ -0,0 +1,61 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"fmt"
+	"plugin"
+	"time"
+
+	"github.com/cloudmanic/tradier/backtest"
+)
+
+// LoadPlugin opens a strategy compiled as a Go plugin with go build -buildmode=plugin. The
+// plugin's main package must export a constructor:
+//
+//	func New() strategy.Strategy
+//
+// Plugins need cgo and run on Linux and macOS only, and must be built with the same Go version
+// and module versions as the tradier binary.
+func LoadPlugin(path string) (Strategy, error) {
+	sym, err := lookupNew(path)
+	if err != nil {
+		return nil, err
+	}
+	newStrategy, ok := sym.(func() Strategy)
+	if !ok {
+		return nil, fmt.Errorf("strategy plugin New must be a func() strategy.Strategy, not %T", sym)
+	}
+	return newStrategy(), nil
+}
+
+// LoadBacktestPlugin opens a plugin for backtest.Run. Its New may return either a
+// strategy.Strategy, which is replayed with OnTimer every timer, or a backtest.Strategy, which
+// is used as it is.
+func LoadBacktestPlugin(path string, timer time.Duration) (backtest.Strategy, error) {
+	sym, err := lookupNew(path)
+	if err != nil {
+		return nil, err
+	}
+	switch newStrategy := sym.(type) {
+	case func() Strategy:
+		return Replay(newStrategy(), timer), nil
+	case func() backtest.Strategy:
+		return newStrategy(), nil
+	}
+	return nil, fmt.Errorf("strategy plugin New must be a func() strategy.Strategy or a func() backtest.Strategy, not %T", sym)
+}
+
+// lookupNew opens the plugin at path and returns its exported New.
+func lookupNew(path string) (plugin.Symbol, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open strategy plugin: %w", err)
+	}
+	sym, err := p.Lookup("New")
+	if err != nil {
+		return nil, fmt.Errorf("strategy plugin %s must export New: %w", path, err)
+	}
+	return sym, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"time"
+
+	"github.com/cloudmanic/tradier/backtest"
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/paper"
+)
+
+// replay adapts a Strategy to a backtest.
+type replay struct {
+	strategy Strategy
+	timer    time.Duration
+	bt       *backtest.Context
+	ctx      *Context
+	fills    *fillTracker
+	quotes   map[string]Quote
+	last     time.Time
+	next     time.Time
+}
+
+// Replay adapts a strategy to backtest.Run, calling OnTimer at most once per bar close when at
+// least timer has passed (never when timer is zero). At each close the strategy gets the fills
+// since the last close, then for each symbol a quote with every price at the close, then the bar.
+// The strategy trades the backtest's simulated account through the same Broker as the paper
+// account.
+func Replay(strategy Strategy, timer time.Duration) backtest.Strategy {
+	return &replay{strategy: strategy, timer: timer}
+}
+
+// OnBar implements backtest.Strategy.
+func (r *replay) OnBar(bt *backtest.Context, symbol string, bar bars.Bar) error {
+	if bt != r.bt {
+		// A new backtest starts from scratch
+		r.bt, r.quotes, r.fills = bt, map[string]Quote{}, newFillTracker(nil)
+		r.last, r.next = time.Time{}, time.Time{}
+		r.ctx = &Context{
+			Broker: NewAccountBroker(bt.Account(), paper.AccountNumber),
+			mode:   Backtest,
+			now:    bt.Time,
+			bars:   bt.Bars,
+			quotes: r.quotes,
+			logf:   bt.Logf,
+		}
+	}
+
+	now := bt.Time()
+	if !now.Equal(r.last) {
+		r.last = now
+		orders, err := r.ctx.Orders()
+		if err != nil {
+			return err
+		}
+		for _, f := range r.fills.diff(orders) {
+			if err := r.strategy.OnFill(r.ctx, f); err != nil {
+				return err
+			}
+		}
+		if r.timer > 0 && !now.Before(r.next) {
+			r.next = now.Add(r.timer)
+			if err := r.strategy.OnTimer(r.ctx, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	q := Quote{Symbol: symbol, Bid: bar.Close, Ask: bar.Close, Last: bar.Close, Time: now}
+	r.quotes[symbol] = q
+	if err := r.strategy.OnQuote(r.ctx, q); err != nil {
+		return err
+	}
+	return r.strategy.OnBar(r.ctx, symbol, bar)
+}
+
+// Lookback implements backtest.Warmup, passing on the strategy's lookback when it has one.
+func (r *replay) Lookback() int {
+	if w, ok := r.strategy.(backtest.Warmup); ok {
+		return w.Lookback()
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/backtest"
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// TestReplay verifies a strategy trades a backtest through its broker and sees fills, quotes,
+// and timers at bar closes.
+func TestReplay(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, pricing.Eastern()) }
+	cfg := backtest.Config{
+		Symbols: []string{"AAPL"},
+		Bars: map[string][]bars.Bar{"AAPL": {
+			{Time: day(5), Open: 100, High: 101, Low: 99, Close: 100},
+			{Time: day(6), Open: 102, High: 104, Low: 101, Close: 103},
+			{Time: day(7), Open: 103, High: 108, Low: 102, Close: 107},
+		}},
+		Cash: 10000,
+	}
+	s := &recorder{cancel: func() {}}
+	res, err := backtest.Run(cfg, Replay(s, 36*time.Hour))
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	if len(s.bars) != 3 || len(s.quotes) != 3 || s.quotes[1].Last != 103 || s.seen != 3 {
+		t.Errorf("bars = %+v, quotes = %+v", s.bars, s.quotes)
+	}
+	// The first bar's order fills at the second open and is reported at the second close; the
+	// second bar's at the third close
+	if len(s.fills) != 2 || s.fills[0].Price != 102 || s.fills[0].Tag != "first-bar" || s.fills[1].Price != 103 {
+		t.Errorf("fills = %+v", s.fills)
+	}
+	if s.timers != 2 {
+		t.Errorf("OnTimer() called %d times, want 2", s.timers)
+	}
+	if len(res.Fills) != 2 {
+		t.Errorf("backtest fills = %+v, want 2", res.Fills)
+	}
+
+	// Running again starts over
+	s2 := &recorder{cancel: func() {}}
+	replay := Replay(s2, 0)
+	backtest.Run(cfg, replay)
+	backtest.Run(cfg, replay)
+	if len(s2.fills) != 4 || s2.timers != 0 {
+		t.Errorf("two runs: fills = %d, timers = %d, want 4 and 0", len(s2.fills), s2.timers)
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/client"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// streamURL is Tradier's HTTP market event stream, used when a session does not name one.
+const streamURL = "https://stream.tradier.com/v1/markets/events"
+
+// Tick is one event from a market data feed: a trade of Size at Price, or a new bid and ask.
+type Tick struct {
+	Symbol  string
+	Time    time.Time
+	Trade   bool
+	Price   float64
+	Size    float64
+	Bid     float64
+	Ask     float64
+	BidSize float64
+	AskSize float64
+}
+
+// Feed passes trades and quotes for symbols to handle until ctx is canceled or the feed fails.
+type Feed func(ctx context.Context, symbols []string, handle func(Tick)) error
+
+// Config describes a live or paper run.
+type Config struct {
+	// Mode is reported to the strategy by Context.Mode. Live when empty.
+	Mode Mode
+
+	// Symbols are the symbols streamed and built into bars.
+	Symbols []string
+
+	// Broker is the account traded, and Feed the source of trades and quotes.
+	Broker Broker
+	Feed   Feed
+
+	// Interval is the width of the bars built from regular-session trades. Zero builds daily
+	// bars, which close with the session.
+	Interval time.Duration
+
+	// Calendar gives each day's regular session, so half days close early and holidays build no
+	// bars. Without one the session is 9:30 a.m. to 4 p.m. Eastern every day.
+	Calendar *calendar.Calendar
+
+	// Timer is how often OnTimer is called. Zero never calls it.
+	Timer time.Duration
+
+	// Poll is how often orders are checked for fills, 5 seconds when zero. Retry is how long to
+	// wait before reconnecting a failed feed, 5 seconds when zero.
+	Poll  time.Duration
+	Retry time.Duration
+
+	// History loads a symbol's bars from before the run, so indicators have data from the start.
+	History func(symbol string) ([]bars.Bar, error)
+
+	// Logf receives the strategy's log messages and feed and broker problems.
+	Logf func(format string, args ...interface{})
+}
+
+// pending is a bar still being built from trades, when it closes, and its traded value.
+type pending struct {
+	bar   bars.Bar
+	close time.Time
+	value float64
+}
+
+// runner is the state of a live or paper run.
+type runner struct {
+	cfg      Config
+	strategy Strategy
+	ctx      *Context
+	bars     map[string][]bars.Bar
+	building map[string]*pending
+	quotes   map[string]Quote
+	fills    *fillTracker
+
+	// day and regular are the date and regular session of the last trade's day.
+	day     time.Time
+	regular calendar.Session
+}
+
+// Run drives the strategy from the feed and trades through the broker until ctx is canceled,
+// which returns nil, or the strategy returns an error. Orders already filled when the run starts
+// are not reported as fills.
+func Run(ctx context.Context, cfg Config, strategy Strategy) error {
+	if cfg.Broker == nil || cfg.Feed == nil {
+		return fmt.Errorf("a broker and a feed are required")
+	}
+	if len(cfg.Symbols) == 0 {
+		return fmt.Errorf("no symbols to run")
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = Live
+	}
+	if cfg.Poll <= 0 {
+		cfg.Poll = 5 * time.Second
+	}
+	if cfg.Retry <= 0 {
+		cfg.Retry = 5 * time.Second
+	}
+
+	r := &runner{cfg: cfg, strategy: strategy, bars: map[string][]bars.Bar{}, building: map[string]*pending{}, quotes: map[string]Quote{}}
+	if cfg.History != nil {
+		for _, symbol := range cfg.Symbols {
+			b, err := cfg.History(symbol)
+			if err != nil {
+				return fmt.Errorf("bars for %s: %w", symbol, err)
+			}
+			r.bars[symbol] = b
+		}
+	}
+	orders, err := cfg.Broker.Orders()
+	if err != nil {
+		return err
+	}
+	r.fills = newFillTracker(orders)
+	r.ctx = &Context{
+		Broker: cfg.Broker,
+		mode:   cfg.Mode,
+		now:    time.Now,
+		bars:   func(symbol string) []bars.Bar { return r.bars[symbol] },
+		quotes: r.quotes,
+		logf:   cfg.Logf,
+	}
+
+	feedCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ticks := make(chan Tick, 1024)
+	failures := make(chan error, 1)
+	go r.stream(feedCtx, ticks, failures)
+
+	clock := time.NewTicker(time.Second)
+	defer clock.Stop()
+	poll := time.NewTicker(cfg.Poll)
+	defer poll.Stop()
+	var timer <-chan time.Time
+	if cfg.Timer > 0 {
+		t := time.NewTicker(cfg.Timer)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return nil
+		case t := <-ticks:
+			err = r.tick(t)
+		case failure := <-failures:
+			r.ctx.Logf("market data stopped (%v); reconnecting in %s", failure, cfg.Retry)
+		case now := <-clock.C:
+			err = r.closeBars(now)
+		case <-poll.C:
+			err = r.poll()
+		case now := <-timer:
+			err = strategy.OnTimer(r.ctx, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// stream runs the feed until ctx is canceled, reporting each failure and reconnecting after
+// the retry delay.
+func (r *runner) stream(ctx context.Context, ticks chan<- Tick, failures chan<- error) {
+	for {
+		err := r.cfg.Feed(ctx, r.cfg.Symbols, func(t Tick) {
+			select {
+			case ticks <- t:
+			case <-ctx.Done():
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("the feed ended")
+		}
+		select {
+		case failures <- err:
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case <-time.After(r.cfg.Retry):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tick updates a symbol's quote and bar from a feed event and passes the quote to the strategy.
+func (r *runner) tick(t Tick) error {
+	if t.Time.IsZero() {
+		t.Time = time.Now()
+	}
+	q := r.quotes[t.Symbol]
+	q.Symbol, q.Time = t.Symbol, t.Time
+	if t.Trade {
+		q.Last, q.Size = t.Price, t.Size
+		if err := r.closeBars(t.Time); err != nil {
+			return err
+		}
+		r.trade(t)
+	} else {
+		q.Bid, q.Ask, q.BidSize, q.AskSize = t.Bid, t.Ask, t.BidSize, t.AskSize
+	}
+	r.quotes[t.Symbol] = q
+	return r.strategy.OnQuote(r.ctx, q)
+}
+
+// trade adds a regular-session trade to the bar it falls in.
+func (r *runner) trade(t Tick) {
+	start, end, ok := r.bucket(t.Time)
+	if !ok || t.Price <= 0 {
+		return
+	}
+	p := r.building[t.Symbol]
+	if p == nil || !p.bar.Time.Equal(start) {
+		p = &pending{bar: bars.Bar{Time: start, Open: t.Price, High: t.Price, Low: t.Price}, close: end}
+		r.building[t.Symbol] = p
+	}
+	p.bar.High = max(p.bar.High, t.Price)
+	p.bar.Low = min(p.bar.Low, t.Price)
+	p.bar.Close = t.Price
+	p.bar.Volume += t.Size
+	p.value += t.Price * t.Size
+	if p.bar.Volume > 0 {
+		p.bar.VWAP = p.value / p.bar.Volume
+	}
+}
+
+// bucket returns when the bar holding a trade at t starts and closes, or false when t is
+// outside the regular session. Intraday bars start on multiples of the interval from the open;
+// daily bars start at midnight Eastern, as historical bars do.
+func (r *runner) bucket(t time.Time) (time.Time, time.Time, bool) {
+	session := r.session(t)
+	if !session.Contains(t) {
+		return time.Time{}, time.Time{}, false
+	}
+	if r.cfg.Interval <= 0 {
+		return calendar.Date(t), session.End, true
+	}
+	start := session.Start.Add(t.Sub(session.Start) / r.cfg.Interval * r.cfg.Interval)
+	end := start.Add(r.cfg.Interval)
+	if end.After(session.End) {
+		end = session.End
+	}
+	return start, end, true
+}
+
+// session returns the regular session on t's date from the calendar, looking each date up once.
+// Without a calendar, or when it can't be read, the session is 9:30 a.m. to 4 p.m. Eastern.
+func (r *runner) session(t time.Time) calendar.Session {
+	date := calendar.Date(t)
+	if date.Equal(r.day) {
+		return r.regular
+	}
+	r.day = date
+	r.regular = calendar.Session{
+		Start: time.Date(date.Year(), date.Month(), date.Day(), 9, 30, 0, 0, pricing.Eastern()),
+		End:   time.Date(date.Year(), date.Month(), date.Day(), 16, 0, 0, 0, pricing.Eastern()),
+	}
+	if r.cfg.Calendar != nil {
+		day, err := r.cfg.Calendar.Day(t)
+		if err != nil {
+			r.ctx.Logf("market calendar unavailable (%v); assuming regular hours on %s", err, date.Format("2006-01-02"))
+		} else {
+			r.regular = day.Regular
+		}
+	}
+	return r.regular
+}
+
+// closeBars finishes the bars that have closed by now and passes them to the strategy.
+func (r *runner) closeBars(now time.Time) error {
+	for _, symbol := range r.cfg.Symbols {
+		p := r.building[symbol]
+		if p == nil || now.Before(p.close) {
+			continue
+		}
+		delete(r.building, symbol)
+		r.bars[symbol] = append(r.bars[symbol], p.bar)
+		if err := r.strategy.OnBar(r.ctx, symbol, p.bar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// poll checks the broker's orders and passes new fills to the strategy. A broker that can't be
+// reached is logged and checked again next time.
+func (r *runner) poll() error {
+	orders, err := r.cfg.Broker.Orders()
+	if err != nil {
+		r.ctx.Logf("order check failed: %v", err)
+		return nil
+	}
+	for _, f := range r.fills.diff(orders) {
+		if err := r.strategy.OnFill(r.ctx, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamFeed returns a Feed of trades and quotes from Tradier's market event stream, opening a
+// new streaming session each time it is run.
+func StreamFeed(c *client.Client) Feed {
+	return func(ctx context.Context, symbols []string, handle func(Tick)) error {
+		data, err := c.CreateMarketSession()
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			Stream struct {
+				URL       string `json:"url"`
+				SessionID string `json:"sessionid"`
+			} `json:"stream"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return fmt.Errorf("unable to parse streaming session: %w", err)
+		}
+		url := resp.Stream.URL
+		if !strings.HasPrefix(url, "http") {
+			url = streamURL
+		}
+		return c.StreamMarketEvents(ctx, url, resp.Stream.SessionID, strings.Join(symbols, ","), "trade,quote", func(event []byte) error {
+			if t, ok := parseTick(event); ok {
+				handle(t)
+			}
+			return nil
+		})
+	}
+}
+
+// number is a stream event field that Tradier sends as a number or a string, depending on the
+// event type.
+type number float64
+
+// UnmarshalJSON accepts a number or a quoted number, leaving anything else zero.
+func (n *number) UnmarshalJSON(data []byte) error {
+	f, err := strconv.ParseFloat(strings.Trim(string(data), `"`), 64)
+	if err != nil {
+		return nil
+	}
+	*n = number(f)
+	return nil
+}
+
+// parseTick converts a trade or quote stream event, reporting false for other events.
+func parseTick(event []byte) (Tick, bool) {
+	var ev struct {
+		Type    string `json:"type"`
+		Symbol  string `json:"symbol"`
+		Price   number `json:"price"`
+		Size    number `json:"size"`
+		Date    number `json:"date"`
+		Bid     number `json:"bid"`
+		Ask     number `json:"ask"`
+		BidSize number `json:"bidsz"`
+		AskSize number `json:"asksz"`
+		BidDate number `json:"biddate"`
+		AskDate number `json:"askdate"`
+	}
+	if err := json.Unmarshal(event, &ev); err != nil || ev.Symbol == "" {
+		return Tick{}, false
+	}
+	millis := func(n number) time.Time {
+		if n <= 0 {
+			return time.Time{}
+		}
+		return time.UnixMilli(int64(n))
+	}
+	switch ev.Type {
+	case "trade":
+		return Tick{Symbol: ev.Symbol, Time: millis(ev.Date), Trade: true, Price: float64(ev.Price), Size: float64(ev.Size)}, true
+	case "quote":
+		return Tick{Symbol: ev.Symbol, Time: millis(max(ev.BidDate, ev.AskDate)), Bid: float64(ev.Bid), Ask: float64(ev.Ask), BidSize: float64(ev.BidSize), AskSize: float64(ev.AskSize)}, true
+	}
+	return Tick{}, false
+}
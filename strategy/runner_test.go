@@ -0,0 +1,218 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package strategy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+	"github.com/cloudmanic/tradier/calendar"
+	"github.com/cloudmanic/tradier/pricing"
+)
+
+// near reports whether two floats are within a cent.
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// fakeBroker fills every order in full at a fixed price as it is placed.
+type fakeBroker struct {
+	price  float64
+	orders []Order
+}
+
+// PlaceOrder implements Broker.
+func (b *fakeBroker) PlaceOrder(params map[string]string) (int, error) {
+	id := len(b.orders) + 1
+	b.orders = append(b.orders, Order{ID: id, Symbol: params["symbol"], Side: params["side"], Quantity: 1, ExecQuantity: 1, AvgFillPrice: b.price, Status: "filled", Tag: params["tag"]})
+	return id, nil
+}
+
+// CancelOrder implements Broker.
+func (b *fakeBroker) CancelOrder(orderID int) error { return errors.New("not working") }
+
+// Positions implements Broker.
+func (b *fakeBroker) Positions() ([]Position, error) { return nil, nil }
+
+// Orders implements Broker.
+func (b *fakeBroker) Orders() ([]Order, error) { return b.orders, nil }
+
+// Balance implements Broker.
+func (b *fakeBroker) Balance() (Balance, error) { return Balance{}, nil }
+
+// recorder is a strategy that records what it is called with and buys on the first bar.
+type recorder struct {
+	Base
+	cancel func()
+	quotes []Quote
+	bars   []bars.Bar
+	seen   int
+	fills  []Fill
+	timers int
+}
+
+// OnQuote implements Strategy.
+func (r *recorder) OnQuote(ctx *Context, q Quote) error {
+	r.quotes = append(r.quotes, q)
+	return nil
+}
+
+// OnBar implements Strategy.
+func (r *recorder) OnBar(ctx *Context, symbol string, bar bars.Bar) error {
+	r.bars = append(r.bars, bar)
+	r.seen = len(ctx.Bars(symbol))
+	_, err := ctx.PlaceOrder(map[string]string{"class": "equity", "symbol": symbol, "side": "buy", "quantity": "1", "type": "market", "duration": "day", "tag": "first-bar"})
+	return err
+}
+
+// OnFill implements Strategy.
+func (r *recorder) OnFill(ctx *Context, f Fill) error {
+	r.fills = append(r.fills, f)
+	r.done()
+	return nil
+}
+
+// OnTimer implements Strategy.
+func (r *recorder) OnTimer(ctx *Context, now time.Time) error {
+	r.timers++
+	r.done()
+	return nil
+}
+
+// done ends the run once a fill and a timer have been seen.
+func (r *recorder) done() {
+	if len(r.fills) > 0 && r.timers > 0 {
+		r.cancel()
+	}
+}
+
+// TestRun verifies trades and quotes become quotes and bars, orders' fills are reported, the
+// timer fires, and a failed feed is reconnected.
+func TestRun(t *testing.T) {
+	at := func(h, m, s int) time.Time { return time.Date(2026, 10, 19, h, m, s, 0, pricing.Eastern()) }
+	ticks := []Tick{
+		{Symbol: "SPY", Time: at(9, 20, 0), Trade: true, Price: 90, Size: 100},
+		{Symbol: "SPY", Time: at(9, 30, 0), Bid: 99.9, Ask: 100.1, BidSize: 3, AskSize: 4},
+		{Symbol: "SPY", Time: at(9, 30, 5), Trade: true, Price: 100, Size: 10},
+		{Symbol: "SPY", Time: at(9, 31, 0), Trade: true, Price: 101, Size: 5},
+		{Symbol: "SPY", Time: at(9, 34, 59), Trade: true, Price: 99.5, Size: 5},
+		{Symbol: "SPY", Time: at(9, 35, 0), Trade: true, Price: 102, Size: 1},
+	}
+	calls := 0
+	feed := func(ctx context.Context, symbols []string, handle func(Tick)) error {
+		calls++
+		if calls == 1 {
+			return errors.New("disconnected")
+		}
+		for _, tk := range ticks {
+			handle(tk)
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s := &recorder{cancel: cancel}
+	var logs []string
+	broker := &fakeBroker{price: 102.5}
+	cfg := Config{
+		Symbols:  []string{"SPY"},
+		Broker:   broker,
+		Feed:     feed,
+		Interval: 5 * time.Minute,
+		Timer:    5 * time.Millisecond,
+		Poll:     5 * time.Millisecond,
+		Retry:    time.Millisecond,
+		History: func(symbol string) ([]bars.Bar, error) {
+			return []bars.Bar{{Time: at(0, 0, 0).AddDate(0, 0, -3), Close: 98}}, nil
+		},
+		Logf: func(format string, args ...interface{}) { logs = append(logs, format) },
+	}
+	if err := Run(ctx, cfg, s); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Fatalf("Run() timed out: fills %+v, timers %d", s.fills, s.timers)
+	}
+
+	if len(s.quotes) != len(ticks) {
+		t.Errorf("OnQuote() called %d times, want %d", len(s.quotes), len(ticks))
+	}
+	if q := s.quotes[len(s.quotes)-1]; q.Last != 102 || q.Bid != 99.9 || q.AskSize != 4 || !q.Time.Equal(at(9, 35, 0)) {
+		t.Errorf("last quote = %+v", q)
+	}
+	want := bars.Bar{Time: at(9, 30, 0), Open: 100, High: 101, Low: 99.5, Close: 99.5, Volume: 20, VWAP: (1000 + 505 + 497.5) / 20.0}
+	if len(s.bars) != 1 || !s.bars[0].Time.Equal(want.Time) || s.seen != 2 {
+		t.Fatalf("OnBar() = %+v with %d bars, want %+v after the history bar", s.bars, s.seen, want)
+	}
+	if got := s.bars[0]; got.Open != want.Open || got.High != want.High || got.Low != want.Low || got.Close != want.Close || got.Volume != want.Volume || !near(got.VWAP, want.VWAP) {
+		t.Errorf("OnBar() = %+v, want %+v", got, want)
+	}
+	if len(s.fills) != 1 || s.fills[0].OrderID != 1 || s.fills[0].Price != 102.5 || s.fills[0].Tag != "first-bar" {
+		t.Errorf("OnFill() = %+v", s.fills)
+	}
+	if calls != 2 || len(logs) != 1 || !strings.Contains(logs[0], "reconnecting") {
+		t.Errorf("feed calls = %d, logs = %v, want one reconnect", calls, logs)
+	}
+}
+
+// TestRunDailyBars verifies daily bars start at midnight Eastern and close at 4 p.m.
+func TestRunDailyBars(t *testing.T) {
+	r := &runner{}
+	start, end, ok := r.bucket(time.Date(2026, 10, 19, 15, 59, 0, 0, pricing.Eastern()))
+	if !ok || !start.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, pricing.Eastern())) || end.Hour() != 16 {
+		t.Errorf("bucket() = %v, %v, %v", start, end, ok)
+	}
+	if _, _, ok := r.bucket(time.Date(2026, 10, 19, 16, 0, 0, 0, pricing.Eastern())); ok {
+		t.Errorf("bucket() at the close = true, want false")
+	}
+}
+
+// TestRunCalendarSessions verifies bars follow the calendar: a half day's bars close at the early
+// close and a holiday builds none.
+func TestRunCalendarSessions(t *testing.T) {
+	cal := calendar.New(func(year int, month time.Month) ([]byte, error) {
+		return []byte(`{"calendar":{"month":11,"year":2026,"days":{"day":[
+			{"date":"2026-11-26","status":"closed","description":"Market is closed for Thanksgiving Day"},
+			{"date":"2026-11-27","status":"open","description":"Market closes early","open":{"start":"09:30","end":"13:00"}}]}}}`), nil
+	})
+	daily := &runner{cfg: Config{Calendar: cal}}
+	_, end, ok := daily.bucket(time.Date(2026, 11, 27, 12, 59, 0, 0, pricing.Eastern()))
+	if !ok || !end.Equal(time.Date(2026, 11, 27, 13, 0, 0, 0, pricing.Eastern())) {
+		t.Errorf("bucket(half day) = %v, %v, want a close at 1 p.m.", end, ok)
+	}
+	if _, _, ok := daily.bucket(time.Date(2026, 11, 27, 14, 0, 0, 0, pricing.Eastern())); ok {
+		t.Errorf("bucket() after the early close = true, want false")
+	}
+	if _, _, ok := daily.bucket(time.Date(2026, 11, 26, 11, 0, 0, 0, pricing.Eastern())); ok {
+		t.Errorf("bucket(holiday) = true, want false")
+	}
+
+	intraday := &runner{cfg: Config{Calendar: cal, Interval: time.Hour}}
+	start, end, ok := intraday.bucket(time.Date(2026, 11, 27, 12, 45, 0, 0, pricing.Eastern()))
+	if !ok || !start.Equal(time.Date(2026, 11, 27, 12, 30, 0, 0, pricing.Eastern())) || !end.Equal(time.Date(2026, 11, 27, 13, 0, 0, 0, pricing.Eastern())) {
+		t.Errorf("bucket(last hour) = %v, %v, %v, want 12:30 to 13:00", start, end, ok)
+	}
+}
+
+// TestParseTick verifies trade and quote stream events are read whether numbers are quoted or not.
+func TestParseTick(t *testing.T) {
+	trade, ok := parseTick([]byte(`{"type":"trade","symbol":"SPY","exch":"Q","price":"601.25","size":"200","cvol":"1000","date":"1792418400000","last":"601.25"}`))
+	if !ok || !trade.Trade || trade.Price != 601.25 || trade.Size != 200 || trade.Time.UnixMilli() != 1792418400000 {
+		t.Errorf("parseTick(trade) = %+v, %v", trade, ok)
+	}
+	quote, ok := parseTick([]byte(`{"type":"quote","symbol":"SPY","bid":601.2,"bidsz":5,"bidexch":"Q","biddate":"1792418400000","ask":601.3,"asksz":7,"askexch":"Z","askdate":"1792418401000"}`))
+	if !ok || quote.Trade || quote.Bid != 601.2 || quote.AskSize != 7 || quote.Time.UnixMilli() != 1792418401000 {
+		t.Errorf("parseTick(quote) = %+v, %v", quote, ok)
+	}
+	if _, ok := parseTick([]byte(`{"type":"summary","symbol":"SPY","open":"600"}`)); ok {
+		t.Errorf("parseTick(summary) = true, want false")
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+// Package strategy runs one Go trading strategy live, on paper, or in a backtest without changes.
+//
+// A Strategy reacts to quotes, closed bars, fills of its orders, and a timer, and trades through
+// a Broker: PlaceOrder with Tradier order parameters, CancelOrder, and the account's positions,
+// orders, and balance. NewAccountBroker puts a Broker in front of anything that answers
+// client.Client's account and order calls, so the same code trades a Tradier account through a
+// *client.Client or the paper account through a *paper.Engine.
+//
+// Run drives a strategy from a Feed of trades and quotes, normally StreamFeed over Tradier's
+// market event stream, building bars from the trades and polling the broker's orders for fills.
+// Replay adapts a strategy to backtest.Run, trading the backtest's simulated account through the
+// same broker code as the paper account.
+//
+// Every call into a strategy comes from one goroutine, so strategies need no locking.
+package strategy
+
+import (
+	"time"
+
+	"github.com/cloudmanic/tradier/bars"
+)
+
+// Mode is where a strategy is running.
+type Mode string
+
+// Modes a strategy can run in.
+const (
+	Live     Mode = "live"
+	Paper    Mode = "paper"
+	Backtest Mode = "backtest"
+)
+
+// Strategy decides what to trade as market data and fills arrive. An error returned from any
+// method stops the run.
+type Strategy interface {
+	// OnQuote is called when a symbol's bid, ask, or last price changes. In a backtest it is
+	// called once per bar with every price at the close.
+	OnQuote(ctx *Context, q Quote) error
+
+	// OnBar is called for each symbol as a bar closes.
+	OnBar(ctx *Context, symbol string, bar bars.Bar) error
+
+	// OnFill is called when one of the account's orders fills, in whole or in part.
+	OnFill(ctx *Context, f Fill) error
+
+	// OnTimer is called every Timer interval. In a backtest it is called at most once per bar
+	// close, when at least the interval has passed since the last call.
+	OnTimer(ctx *Context, now time.Time) error
+}
+
+// Base implements Strategy with methods that do nothing. Embed it in a strategy that only
+// handles some events.
+type Base struct{}
+
+// OnQuote implements Strategy.
+func (Base) OnQuote(ctx *Context, q Quote) error { return nil }
+
+// OnBar implements Strategy.
+func (Base) OnBar(ctx *Context, symbol string, bar bars.Bar) error { return nil }
+
+// OnFill implements Strategy.
+func (Base) OnFill(ctx *Context, f Fill) error { return nil }
+
+// OnTimer implements Strategy.
+func (Base) OnTimer(ctx *Context, now time.Time) error { return nil }
+
+// Quote is the latest market for a symbol. Size is the size of the last trade.
+type Quote struct {
+	Symbol  string    `json:"symbol"`
+	Bid     float64   `json:"bid"`
+	Ask     float64   `json:"ask"`
+	BidSize float64   `json:"bid_size"`
+	AskSize float64   `json:"ask_size"`
+	Last    float64   `json:"last"`
+	Size    float64   `json:"size"`
+	Time    time.Time `json:"time"`
+}
+
+// Fill is a fill of one order, or of one leg of a multileg order. Symbol is the instrument
+// traded: the option symbol for options.
+type Fill struct {
+	OrderID  int       `json:"order_id"`
+	Symbol   string    `json:"symbol"`
+	Side     string    `json:"side"`
+	Quantity float64   `json:"quantity"`
+	Price    float64   `json:"price"`
+	Tag      string    `json:"tag,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// Context is a strategy's view of the market and its account, the same in every mode. The
+// embedded Broker places and cancels orders.
+type Context struct {
+	Broker
+
+	mode   Mode
+	now    func() time.Time
+	bars   func(symbol string) []bars.Bar
+	quotes map[string]Quote
+	logf   func(format string, args ...interface{})
+}
+
+// Mode returns where the strategy is running.
+func (c *Context) Mode() Mode {
+	return c.mode
+}
+
+// Time returns the current time: the clock when trading, the replay time in a backtest.
+func (c *Context) Time() time.Time {
+	return c.now()
+}
+
+// Bars returns a symbol's closed bars, oldest first, including any history loaded before the
+// run started. The slice must not be modified.
+func (c *Context) Bars(symbol string) []bars.Bar {
+	return c.bars(symbol)
+}
+
+// Quote returns the latest quote for a symbol, if one has arrived.
+func (c *Context) Quote(symbol string) (Quote, bool) {
+	q, ok := c.quotes[symbol]
+	return q, ok
+}
+
+// Position returns the signed quantity held of a symbol: negative when short.
+func (c *Context) Position(symbol string) (float64, error) {
+	positions, err := c.Positions()
+	if err != nil {
+		return 0, err
+	}
+	for _, p := range positions {
+		if p.Symbol == symbol {
+			return p.Quantity, nil
+		}
+	}
+	return 0, nil
+}
+
+// Logf records a message in the run's log.
+func (c *Context) Logf(format string, args ...interface{}) {
+	if c.logf != nil {
+		c.logf(format, args...)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package tags
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Fill is an executed order, or one leg of a multileg order, attributed to the order's tag.
+// Quantity is unsigned; Side says which way it traded.
+type Fill struct {
+	Tag        string    `json:"tag"`
+	OrderID    string    `json:"order_id"`
+	Symbol     string    `json:"symbol"`
+	Side       string    `json:"side"`
+	Quantity   float64   `json:"quantity"`
+	Price      float64   `json:"price"`
+	Multiplier float64   `json:"multiplier"`
+	Date       time.Time `json:"date"`
+	Commission float64   `json:"commission"`
+}
+
+// Signed returns the quantity, negative for sells (sell, sell_short, sell_to_open, sell_to_close).
+func (f Fill) Signed() float64 {
+	if strings.HasPrefix(f.Side, "sell") {
+		return -f.Quantity
+	}
+	return f.Quantity
+}
+
+// Summary is the trading activity and results of one tag.
+type Summary struct {
+	Tag         string             `json:"tag"`
+	Orders      int                `json:"orders"`
+	Fills       int                `json:"fills"`
+	Symbols     []string           `json:"symbols"`
+	Bought      float64            `json:"bought"`
+	Sold        float64            `json:"sold"`
+	Realized    float64            `json:"realized"`
+	Commissions float64            `json:"commissions"`
+	Net         float64            `json:"net"`
+	Open        map[string]float64 `json:"open,omitempty"`
+	First       time.Time          `json:"first"`
+	Last        time.Time          `json:"last"`
+}
+
+// lot is an open quantity at its entry price; negative quantities are short.
+type lot struct {
+	quantity float64
+	price    float64
+}
+
+// Summarize groups fills by tag, sorted by tag. Realized P&L matches each tag's fills first in,
+// first out per symbol, so it counts only round trips made under the same tag; quantities left
+// unmatched are reported in Open. Net is realized P&L less commissions.
+func Summarize(fills []Fill) []Summary {
+	sorted := append([]Fill(nil), fills...)
+	sort.SliceStable(sorted, func(a, b int) bool { return sorted[a].Date.Before(sorted[b].Date) })
+
+	byTag := map[string]*Summary{}
+	orders := map[string]map[string]bool{}
+	lots := map[string]map[string][]lot{}
+	for _, f := range sorted {
+		s, ok := byTag[f.Tag]
+		if !ok {
+			s = &Summary{Tag: f.Tag, First: f.Date}
+			byTag[f.Tag] = s
+			orders[f.Tag] = map[string]bool{}
+			lots[f.Tag] = map[string][]lot{}
+		}
+		mult := f.Multiplier
+		if mult == 0 {
+			mult = 1
+		}
+
+		s.Fills++
+		s.Last = f.Date
+		s.Commissions += f.Commission
+		orders[f.Tag][f.OrderID] = true
+		if f.Signed() > 0 {
+			s.Bought += f.Quantity * f.Price * mult
+		} else {
+			s.Sold += f.Quantity * f.Price * mult
+		}
+
+		// Close opposite-signed lots oldest first, then open a lot with whatever is left
+		q := f.Signed()
+		open := lots[f.Tag][f.Symbol]
+		for q != 0 && len(open) > 0 && (open[0].quantity > 0) != (q > 0) {
+			matched := math.Min(math.Abs(q), math.Abs(open[0].quantity))
+			direction := math.Copysign(1, open[0].quantity)
+			s.Realized += (f.Price - open[0].price) * matched * mult * direction
+			open[0].quantity -= matched * direction
+			q += matched * direction
+			if math.Abs(open[0].quantity) < 1e-9 {
+				open = open[1:]
+			}
+			if math.Abs(q) < 1e-9 {
+				q = 0
+			}
+		}
+		if q != 0 {
+			open = append(open, lot{quantity: q, price: f.Price})
+		}
+		lots[f.Tag][f.Symbol] = open
+	}
+
+	out := make([]Summary, 0, len(byTag))
+	for tag, s := range byTag {
+		s.Orders = len(orders[tag])
+		s.Net = s.Realized - s.Commissions
+		for symbol, open := range lots[tag] {
+			s.Symbols = append(s.Symbols, symbol)
+			remaining := 0.0
+			for _, l := range open {
+				remaining += l.quantity
+			}
+			if remaining != 0 {
+				if s.Open == nil {
+					s.Open = map[string]float64{}
+				}
+				s.Open[symbol] = remaining
+			}
+		}
+		sort.Strings(s.Symbols)
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Tag < out[b].Tag })
+	return out
+}
+
+// Total adds up the summaries into one, with an empty tag.
+func Total(summaries []Summary) Summary {
+	var t Summary
+	symbols := map[string]bool{}
+	for _, s := range summaries {
+		t.Orders += s.Orders
+		t.Fills += s.Fills
+		t.Bought += s.Bought
+		t.Sold += s.Sold
+		t.Realized += s.Realized
+		t.Commissions += s.Commissions
+		t.Net += s.Net
+		if t.First.IsZero() || s.First.Before(t.First) {
+			t.First = s.First
+		}
+		if s.Last.After(t.Last) {
+			t.Last = s.Last
+		}
+		for _, symbol := range s.Symbols {
+			if !symbols[symbol] {
+				symbols[symbol] = true
+				t.Symbols = append(t.Symbols, symbol)
+			}
+		}
+	}
+	sort.Strings(t.Symbols)
+	return t
+}
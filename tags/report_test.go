@@ -0,0 +1,60 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package tags
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// day returns a date in October 2026.
+func day(d int) time.Time {
+	return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+}
+
+// TestSummarize verifies FIFO realized P&L per tag, shorts, options, commissions, and open quantities.
+func TestSummarize(t *testing.T) {
+	fills := []Fill{
+		// Out of date order on purpose
+		{Tag: "momentum-1", OrderID: "3", Symbol: "AAPL", Side: "sell", Quantity: 15, Price: 120, Date: day(5), Commission: 1},
+		{Tag: "momentum-1", OrderID: "1", Symbol: "AAPL", Side: "buy", Quantity: 10, Price: 100, Date: day(1)},
+		{Tag: "momentum-1", OrderID: "2", Symbol: "AAPL", Side: "buy", Quantity: 10, Price: 110, Date: day(2)},
+		{Tag: "momentum-1", OrderID: "4", Symbol: "TSLA", Side: "sell_short", Quantity: 5, Price: 300, Date: day(3)},
+		{Tag: "momentum-1", OrderID: "5", Symbol: "TSLA", Side: "buy_to_cover", Quantity: 5, Price: 280, Date: day(6)},
+		{Tag: "wheel-1", OrderID: "6", Symbol: "SPY261218P00550000", Side: "sell_to_open", Quantity: 2, Price: 3.5, Multiplier: 100, Date: day(1), Commission: 0.7},
+		{Tag: "wheel-1", OrderID: "6", Symbol: "SPY261218P00540000", Side: "buy_to_open", Quantity: 2, Price: 2.5, Multiplier: 100, Date: day(1), Commission: 0.7},
+		{Tag: "wheel-1", OrderID: "7", Symbol: "SPY261218P00550000", Side: "buy_to_close", Quantity: 2, Price: 1, Multiplier: 100, Date: day(8)},
+	}
+	got := Summarize(fills)
+	if len(got) != 2 || got[0].Tag != "momentum-1" || got[1].Tag != "wheel-1" {
+		t.Fatalf("Summarize() = %+v", got)
+	}
+
+	// AAPL: 10 x (120-100) + 5 x (120-110) = 250; TSLA short: 5 x (300-280) = 100
+	m := got[0]
+	if m.Realized != 350 || m.Commissions != 1 || m.Net != 349 || m.Orders != 5 || m.Fills != 5 {
+		t.Errorf("momentum = %+v", m)
+	}
+	if m.Open["AAPL"] != 5 || len(m.Open) != 1 || !m.First.Equal(day(1)) || !m.Last.Equal(day(6)) {
+		t.Errorf("momentum open, dates = %v, %v, %v", m.Open, m.First, m.Last)
+	}
+	if m.Bought != 10*100+10*110+5*280 || m.Sold != 15*120+5*300 {
+		t.Errorf("momentum bought, sold = %v, %v", m.Bought, m.Sold)
+	}
+
+	// Short put closed: 2 x (3.50-1.00) x 100 = 500; the long put stays open
+	w := got[1]
+	if math.Abs(w.Realized-500) > 1e-9 || math.Abs(w.Commissions-1.4) > 1e-9 || w.Orders != 2 {
+		t.Errorf("wheel = %+v", w)
+	}
+	if w.Open["SPY261218P00540000"] != 2 || len(w.Symbols) != 2 {
+		t.Errorf("wheel open, symbols = %v, %v", w.Open, w.Symbols)
+	}
+
+	total := Total(got)
+	if math.Abs(total.Realized-850) > 1e-9 || total.Orders != 7 || len(total.Symbols) != 4 || !total.Last.Equal(day(8)) {
+		t.Errorf("Total() = %+v", total)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package tags
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxLength is the longest order tag Tradier accepts.
+const MaxLength = 255
+
+// New returns the tag for an order placed by a strategy during one run, e.g.
+// "momentum-20261018-153000". Sharing the strategy prefix lets orders be grouped by strategy,
+// and the run ID separates one run's orders from the next.
+func New(strategy, runID string) string {
+	tag := Sanitize(strategy)
+	if run := Sanitize(runID); run != "" {
+		tag += "-" + run
+	}
+	if len(tag) > MaxLength {
+		tag = strings.TrimRight(tag[:MaxLength], "-")
+	}
+	return tag
+}
+
+// RunID returns a run ID from the time, to the second, e.g. "20261018-153000".
+func RunID(t time.Time) string {
+	return t.Format("20060102-150405")
+}
+
+// Sanitize converts s to the characters Tradier allows in a tag: letters, digits, and dashes.
+// Other characters become dashes, and repeated, leading, and trailing dashes are removed.
+func Sanitize(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.TrimSpace(s) {
+		if r < 128 && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// Validate checks that a tag given by the user is one Tradier accepts.
+func Validate(tag string) error {
+	if len(tag) > MaxLength {
+		return fmt.Errorf("tag is %d characters; the limit is %d", len(tag), MaxLength)
+	}
+	for _, r := range tag {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return fmt.Errorf("tag %q may only contain letters, digits, and dashes", tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Cloudmanic Labs, LLC. All rights reserved.
+// Date: 2026-10-18
+
+package tags
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestNew verifies tags combine a sanitized strategy and run ID.
+func TestNew(t *testing.T) {
+	tests := []struct {
+		strategy, run, want string
+	}{
+		{"momentum", "20261018-153000", "momentum-20261018-153000"},
+		{"Mean Reversion_v2", "run.7", "Mean-Reversion-v2-run-7"},
+		{"  --iron condor--  ", "", "iron-condor"},
+		{"wheel", "é!", "wheel"},
+	}
+	for _, tt := range tests {
+		if got := New(tt.strategy, tt.run); got != tt.want {
+			t.Errorf("New(%q, %q) = %q, want %q", tt.strategy, tt.run, got, tt.want)
+		}
+		if err := Validate(New(tt.strategy, tt.run)); err != nil {
+			t.Errorf("Validate(New(%q, %q)) error: %v", tt.strategy, tt.run, err)
+		}
+	}
+
+	if got := New(strings.Repeat("a", 300), "1"); len(got) != MaxLength {
+		t.Errorf("New(long) length = %d, want %d", len(got), MaxLength)
+	}
+	if got := RunID(time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)); got != "20261018-153000" {
+		t.Errorf("RunID() = %q", got)
+	}
+}
+
+// TestValidate verifies disallowed characters and lengths are rejected.
+func TestValidate(t *testing.T) {
+	for _, tag := range []string{"has space", "under_score", "dot.ted", strings.Repeat("a", 256)} {
+		if err := Validate(tag); err == nil {
+			t.Errorf("Validate(%q) error = nil, want error", tag)
+		}
+	}
+	if err := Validate("momentum-2026"); err != nil {
+		t.Errorf("Validate(valid) error: %v", err)
+	}
+}